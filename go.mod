@@ -7,7 +7,9 @@ require (
 	contrib.go.opencensus.io/exporter/ocagent v0.6.0
 	contrib.go.opencensus.io/exporter/zipkin v0.1.1
 	github.com/UnnoTed/fileb0x v1.1.4
+	github.com/alecthomas/chroma v0.10.0
 	github.com/cespare/reflex v0.2.0
+	github.com/cs3org/go-cs3apis v0.0.0-20201118090759-87929f5bae21
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-chi/render v1.0.1
 	github.com/golang/protobuf v1.4.3
@@ -19,6 +21,7 @@ require (
 	github.com/micro/cli/v2 v2.1.2
 	github.com/micro/go-micro/v2 v2.9.1
 	github.com/micro/protoc-gen-micro/v2 v2.1.1 // indirect
+	github.com/microcosm-cc/bluemonday v1.0.16
 	github.com/mitchellh/gox v1.0.1
 	github.com/ogier/pflag v0.0.1 // indirect
 	github.com/oklog/run v1.1.0
@@ -27,12 +30,15 @@ require (
 	github.com/owncloud/ocis/settings v0.0.0-20210126115657-daceb0279a1c
 	github.com/prometheus/client_golang v1.7.1
 	github.com/restic/calens v0.2.0
+	github.com/santhosh-tekuri/jsonschema/v5 v5.0.0
 	github.com/spf13/viper v1.7.0
-	github.com/stretchr/testify v1.6.1
+	github.com/stretchr/testify v1.7.0
+	github.com/yuin/goldmark v1.4.12
 	go.opencensus.io v0.22.5
 	golang.org/x/lint v0.0.0-20200302205851-738671d3881b
-	golang.org/x/net v0.0.0-20200822124328-c89045814202
+	golang.org/x/net v0.0.0-20210614182718-04defd469f4e
 	google.golang.org/genproto v0.0.0-20200624020401-64a14ca9d1ad
+	google.golang.org/grpc v1.26.0
 	google.golang.org/protobuf v1.25.0
 	honnef.co/go/tools v0.0.1-2020.1.5
 )
@@ -0,0 +1,443 @@
+// Package ansi interprets the ANSI escape sequences written to terminals, as
+// they are found in stream and error outputs of notebooks. Text is processed
+// like a terminal displays it: carriage returns and backspaces overwrite
+// earlier characters, so progress bars collapse to their final state.
+package ansi
+
+import (
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	esc = '\x1b'
+	bel = '\a'
+)
+
+// colorNames are the CSS class names of the 16 basic colors, the same as
+// used by nbconvert and JupyterLab.
+var colorNames = []string{
+	"ansi-black",
+	"ansi-red",
+	"ansi-green",
+	"ansi-yellow",
+	"ansi-blue",
+	"ansi-magenta",
+	"ansi-cyan",
+	"ansi-white",
+	"ansi-black-intense",
+	"ansi-red-intense",
+	"ansi-green-intense",
+	"ansi-yellow-intense",
+	"ansi-blue-intense",
+	"ansi-magenta-intense",
+	"ansi-cyan-intense",
+	"ansi-white-intense",
+}
+
+// colorKind defines how a color is specified.
+type colorKind uint8
+
+const (
+	colorDefault colorKind = iota
+	colorIndex
+	colorRGB
+)
+
+// color is the default color, an index of the 256 color palette or an RGB value.
+type color struct {
+	kind    colorKind
+	index   int
+	r, g, b int
+}
+
+// css returns the class of basic colors or the RGB value of all others.
+func (c color) css() (class, rgb string) {
+	switch c.kind {
+	case colorIndex:
+		if c.index < len(colorNames) {
+			return colorNames[c.index], ""
+		}
+
+		return "", paletteRGB(c.index)
+	case colorRGB:
+		return "", fmt.Sprintf("rgb(%d, %d, %d)", c.r, c.g, c.b)
+	}
+
+	return "", ""
+}
+
+// paletteRGB returns the RGB value of the 6x6x6 color cube and the grayscale
+// ramp of the 256 color palette.
+func paletteRGB(n int) string {
+	if n >= 232 {
+		v := 8 + 10*(n-232)
+		return fmt.Sprintf("rgb(%d, %d, %d)", v, v, v)
+	}
+
+	n -= 16
+	level := func(v int) int {
+		if v == 0 {
+			return 0
+		}
+
+		return 55 + 40*v
+	}
+
+	return fmt.Sprintf("rgb(%d, %d, %d)", level(n/36), level(n/6%6), level(n%6))
+}
+
+// style defines the graphic rendition of characters.
+type style struct {
+	fg, bg    color
+	bold      bool
+	italic    bool
+	underline bool
+	inverse   bool
+}
+
+// span returns the opening tag for the style, empty for the default style.
+func (s style) span() string {
+	if s == (style{}) {
+		return ""
+	}
+
+	fg, bg := s.fg, s.bg
+	classes, styles := []string{}, []string{}
+
+	if s.inverse {
+		fg, bg = bg, fg
+
+		if fg.kind == colorDefault {
+			classes = append(classes, "ansi-default-inverse-fg")
+		}
+
+		if bg.kind == colorDefault {
+			classes = append(classes, "ansi-default-inverse-bg")
+		}
+	}
+
+	if class, rgb := fg.css(); class != "" {
+		classes = append(classes, class+"-fg")
+	} else if rgb != "" {
+		styles = append(styles, "color: "+rgb)
+	}
+
+	if class, rgb := bg.css(); class != "" {
+		classes = append(classes, class+"-bg")
+	} else if rgb != "" {
+		styles = append(styles, "background-color: "+rgb)
+	}
+
+	if s.bold {
+		classes = append(classes, "ansi-bold")
+	}
+
+	if s.italic {
+		classes = append(classes, "ansi-italic")
+	}
+
+	if s.underline {
+		classes = append(classes, "ansi-underline")
+	}
+
+	tag := "<span"
+
+	if len(classes) > 0 {
+		tag += ` class="` + strings.Join(classes, " ") + `"`
+	}
+
+	if len(styles) > 0 {
+		tag += ` style="` + strings.Join(styles, "; ") + `"`
+	}
+
+	return tag + ">"
+}
+
+// cell is a single character on the screen.
+type cell struct {
+	r rune
+	s style
+}
+
+// screen collects the characters written by a terminal program.
+type screen struct {
+	lines [][]cell
+	col   int
+	style style
+}
+
+// line returns the line the cursor is in.
+func (sc *screen) line() *[]cell {
+	return &sc.lines[len(sc.lines)-1]
+}
+
+// put writes a character at the cursor position.
+func (sc *screen) put(r rune) {
+	line := sc.line()
+
+	for len(*line) < sc.col {
+		*line = append(*line, cell{r: ' '})
+	}
+
+	if sc.col < len(*line) {
+		(*line)[sc.col] = cell{r: r, s: sc.style}
+	} else {
+		*line = append(*line, cell{r: r, s: sc.style})
+	}
+
+	sc.col++
+}
+
+// backspace removes the character before the cursor, like Jupyter does.
+func (sc *screen) backspace() {
+	if sc.col == 0 {
+		return
+	}
+
+	line := sc.line()
+
+	if sc.col <= len(*line) {
+		*line = append((*line)[:sc.col-1], (*line)[sc.col:]...)
+	}
+
+	sc.col--
+}
+
+// erase handles the erase in line sequence.
+func (sc *screen) erase(mode int) {
+	line := sc.line()
+
+	switch mode {
+	case 0:
+		if sc.col < len(*line) {
+			*line = (*line)[:sc.col]
+		}
+	case 1:
+		for i := 0; i < sc.col && i < len(*line); i++ {
+			(*line)[i] = cell{r: ' '}
+		}
+	case 2:
+		*line = (*line)[:0]
+	}
+}
+
+// parse interprets the text like a terminal.
+func parse(s string) *screen {
+	sc := &screen{
+		lines: [][]cell{{}},
+	}
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+
+		switch r {
+		case '\n':
+			sc.lines = append(sc.lines, []cell{})
+			sc.col = 0
+		case '\r':
+			sc.col = 0
+		case '\b':
+			sc.backspace()
+		case esc:
+			i += sc.escape(s[i:])
+		default:
+			sc.put(r)
+		}
+	}
+
+	return sc
+}
+
+// escape handles the sequence following an escape character and returns its length.
+func (sc *screen) escape(s string) int {
+	if s == "" {
+		return 0
+	}
+
+	switch s[0] {
+	case '[':
+		// Control sequence: parameters and intermediates up to a final byte.
+		for i := 1; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				sc.control(s[1:i], s[i])
+				return i + 1
+			}
+		}
+
+		return len(s)
+	case ']':
+		// Operating system command, terminated by BEL or ST.
+		for i := 1; i < len(s); i++ {
+			if s[i] == bel {
+				return i + 1
+			}
+
+			if s[i] == esc && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+
+		return len(s)
+	}
+
+	return 1
+}
+
+// control applies a control sequence, only graphic rendition and erase in
+// line have a visible effect.
+func (sc *screen) control(params string, final byte) {
+	switch final {
+	case 'm':
+		sc.graphics(numbers(params))
+	case 'K':
+		n := numbers(params)
+		sc.erase(n[0])
+	}
+}
+
+// numbers splits the parameters of a control sequence, missing ones are zero.
+func numbers(params string) []int {
+	fields := strings.FieldsFunc(strings.TrimLeft(params, "?"), func(r rune) bool {
+		return r == ';' || r == ':'
+	})
+
+	if len(fields) == 0 {
+		return []int{0}
+	}
+
+	res := make([]int, len(fields))
+
+	for i, f := range fields {
+		res[i], _ = strconv.Atoi(f)
+	}
+
+	return res
+}
+
+// graphics applies a select graphic rendition sequence.
+func (sc *screen) graphics(n []int) {
+	s := &sc.style
+
+	for i := 0; i < len(n); i++ {
+		switch p := n[i]; {
+		case p == 0:
+			*s = style{}
+		case p == 1:
+			s.bold = true
+		case p == 3:
+			s.italic = true
+		case p == 4:
+			s.underline = true
+		case p == 7:
+			s.inverse = true
+		case p == 22:
+			s.bold = false
+		case p == 23:
+			s.italic = false
+		case p == 24:
+			s.underline = false
+		case p == 27:
+			s.inverse = false
+		case p >= 30 && p <= 37:
+			s.fg = color{kind: colorIndex, index: p - 30}
+		case p == 38:
+			s.fg, i = extended(n, i)
+		case p == 39:
+			s.fg = color{}
+		case p >= 40 && p <= 47:
+			s.bg = color{kind: colorIndex, index: p - 40}
+		case p == 48:
+			s.bg, i = extended(n, i)
+		case p == 49:
+			s.bg = color{}
+		case p >= 90 && p <= 97:
+			s.fg = color{kind: colorIndex, index: p - 90 + 8}
+		case p >= 100 && p <= 107:
+			s.bg = color{kind: colorIndex, index: p - 100 + 8}
+		}
+	}
+}
+
+// extended parses a 256 color or truecolor parameter starting at n[i] and
+// returns the color and the index of its last parameter.
+func extended(n []int, i int) (color, int) {
+	if i+2 < len(n) && n[i+1] == 5 {
+		return color{kind: colorIndex, index: clamp(n[i+2])}, i + 2
+	}
+
+	if i+4 < len(n) && n[i+1] == 2 {
+		return color{kind: colorRGB, r: clamp(n[i+2]), g: clamp(n[i+3]), b: clamp(n[i+4])}, i + 4
+	}
+
+	return color{}, len(n)
+}
+
+func clamp(v int) int {
+	if v < 0 {
+		return 0
+	}
+
+	if v > 255 {
+		return 255
+	}
+
+	return v
+}
+
+// HTML converts text with escape sequences into escaped HTML, styled text is
+// wrapped into span elements. The result is meant for a pre element.
+func HTML(s string) template.HTML {
+	sc := parse(s)
+	buf := &strings.Builder{}
+
+	for i, line := range sc.lines {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+
+		for start := 0; start < len(line); {
+			end := start
+			text := &strings.Builder{}
+
+			for end < len(line) && line[end].s == line[start].s {
+				text.WriteRune(line[end].r)
+				end++
+			}
+
+			if tag := line[start].s.span(); tag != "" {
+				buf.WriteString(tag)
+				buf.WriteString(template.HTMLEscapeString(text.String()))
+				buf.WriteString("</span>")
+			} else {
+				buf.WriteString(template.HTMLEscapeString(text.String()))
+			}
+
+			start = end
+		}
+	}
+
+	return template.HTML(buf.String())
+}
+
+// Strip returns the text as displayed by a terminal, without escape sequences.
+func Strip(s string) string {
+	sc := parse(s)
+	lines := make([]string, len(sc.lines))
+
+	for i, line := range sc.lines {
+		runes := make([]rune, len(line))
+
+		for j, c := range line {
+			runes[j] = c.r
+		}
+
+		lines[i] = string(runes)
+	}
+
+	return strings.Join(lines, "\n")
+}
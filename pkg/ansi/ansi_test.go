@@ -0,0 +1,49 @@
+package ansi
+
+import (
+	"html/template"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected template.HTML
+	}{
+		{"plain", "a < b", "a &lt; b"},
+		{"basic colors", "\x1b[31mred\x1b[0m \x1b[42mgreen\x1b[49m", `<span class="ansi-red-fg">red</span> <span class="ansi-green-bg">green</span>`},
+		{"intense colors", "\x1b[94mblue\x1b[39m", `<span class="ansi-blue-intense-fg">blue</span>`},
+		{"bold and underline", "\x1b[1;4mx\x1b[22my\x1b[24mz", `<span class="ansi-bold ansi-underline">x</span><span class="ansi-underline">y</span>z`},
+		{"256 colors", "\x1b[38;5;9ma\x1b[38;5;196mb\x1b[48;5;244mc", `<span class="ansi-red-intense-fg">a</span><span style="color: rgb(255, 0, 0)">b</span><span style="color: rgb(255, 0, 0); background-color: rgb(128, 128, 128)">c</span>`},
+		{"truecolor", "\x1b[38;2;10;20;30mx", `<span style="color: rgb(10, 20, 30)">x</span>`},
+		{"inverse", "\x1b[7mx", `<span class="ansi-default-inverse-fg ansi-default-inverse-bg">x</span>`},
+		{"ipython traceback", "\x1b[0;31mValueError\x1b[0m: bad", `<span class="ansi-red-fg">ValueError</span>: bad`},
+		{"other sequences", "\x1b[?25lhidden\x1b]0;title\x07", "hidden"},
+		{"carriage return", "10%\r20%\r30%\n", "30%\n"},
+		{"overwrite prefix", "downloading\rdone", "doneloading"},
+		{"crlf", "a\r\nb", "a\nb"},
+		{"erase line", "progress\r\x1b[Kok", "ok"},
+		{"backspace", "ab\bc", "ac"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, HTML(tt.input))
+		})
+	}
+}
+
+func TestHTML_Progress(t *testing.T) {
+	// tqdm redraws the bar on the same line.
+	bar := " 50%|\x1b[32m█████     \x1b[0m| 5/10\r100%|\x1b[32m██████████\x1b[0m| 10/10\n"
+
+	assert.Equal(t, template.HTML(`100%|<span class="ansi-green-fg">██████████</span>| 10/10`+"\n"), HTML(bar))
+	assert.Equal(t, "100%|██████████| 10/10\n", Strip(bar))
+}
+
+func TestStrip(t *testing.T) {
+	assert.Equal(t, "ValueError: bad\nnext", Strip("\x1b[0;31mValueError\x1b[0m: bad\nnext"))
+	assert.Equal(t, "", Strip(""))
+}
@@ -0,0 +1,129 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/diff"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/flagset"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
+	"github.com/micro/cli/v2"
+)
+
+// Diff is the entrypoint for the diff command.
+func Diff(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "diff",
+		Usage:     "Show the changes between two notebooks",
+		ArgsUsage: "<old> <new>",
+		Flags:     flagset.DiffWithConfig(cfg),
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 2 {
+				return errors.New("expected the old and the new notebook file")
+			}
+
+			oldFile, newFile := c.Args().Get(0), c.Args().Get(1)
+
+			if c.String("format") == "json" {
+				return diffJSON(c, oldFile, newFile)
+			}
+
+			old, err := loadNotebook(oldFile)
+
+			if err != nil {
+				return err
+			}
+
+			new, err := loadNotebook(newFile)
+
+			if err != nil {
+				return err
+			}
+
+			d := diff.Notebooks(old, new)
+
+			switch c.String("format") {
+			case "text":
+				return d.WriteText(c.App.Writer, diff.TextOptions{
+					OldName: oldFile,
+					NewName: newFile,
+					Color:   c.Bool("color"),
+				})
+			case "patch":
+				ops := d.Patch()
+
+				if ops == nil {
+					ops = []*diff.Patch{}
+				}
+
+				b, err := json.MarshalIndent(ops, "", "  ")
+
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(c.App.Writer, string(b))
+				return nil
+			}
+
+			return fmt.Errorf("%w: %s", svc.ErrUnsupportedDiffFormat, c.String("format"))
+		},
+	}
+}
+
+// diffJSON writes the changes in the format of the diff endpoint.
+func diffJSON(c *cli.Context, oldFile, newFile string) error {
+	oldContent, err := ioutil.ReadFile(oldFile)
+
+	if err != nil {
+		return err
+	}
+
+	newContent, err := ioutil.ReadFile(newFile)
+
+	if err != nil {
+		return err
+	}
+
+	rsp := &v0proto.DiffResponse{}
+
+	if err := svc.NewService().Diff(context.Background(), &v0proto.DiffRequest{
+		OldContent: string(oldContent),
+		NewContent: string(newContent),
+	}, rsp); err != nil {
+		return err
+	}
+
+	b, err := rsp.MarshalJSON()
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.App.Writer, string(b))
+	return nil
+}
+
+// loadNotebook reads a notebook file, notebooks of an older nbformat are
+// upgraded to v4.
+func loadNotebook(file string) (*notebook.Notebook, error) {
+	b, err := ioutil.ReadFile(file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nb, _, err := notebook.Load(b)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	return nb, nil
+}
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/flagset"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/merge"
+	"github.com/micro/cli/v2"
+)
+
+// Merge is the entrypoint for the merge command.
+func Merge(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "merge",
+		Usage:     "Merge the changes of two notebooks based on a common version, e.g. as git merge driver",
+		ArgsUsage: "<base> <local> <remote>",
+		Flags:     flagset.MergeWithConfig(cfg),
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 3 {
+				return errors.New("expected the base, the local and the remote notebook file")
+			}
+
+			base, err := loadNotebook(c.Args().Get(0))
+
+			if err != nil {
+				return err
+			}
+
+			local, err := loadNotebook(c.Args().Get(1))
+
+			if err != nil {
+				return err
+			}
+
+			remote, err := loadNotebook(c.Args().Get(2))
+
+			if err != nil {
+				return err
+			}
+
+			res := merge.Notebooks(base, local, remote, merge.ConflictCells(c.Bool("conflict-cells")))
+
+			b, err := res.Notebook.Marshal()
+
+			if err != nil {
+				return err
+			}
+
+			output := c.String("output")
+
+			if output == "" {
+				output = c.Args().Get(1)
+			}
+
+			if err := ioutil.WriteFile(output, b, 0644); err != nil {
+				return err
+			}
+
+			if file := c.String("conflicts"); file != "" {
+				b, err := json.MarshalIndent(res.Conflicts, "", "  ")
+
+				if err != nil {
+					return err
+				}
+
+				if file == "-" {
+					fmt.Fprintln(c.App.Writer, string(b))
+				} else if err := ioutil.WriteFile(file, append(b, '\n'), 0644); err != nil {
+					return err
+				}
+			}
+
+			for _, conflict := range res.Conflicts {
+				fmt.Fprintf(c.App.ErrWriter, "Conflict: %s\n", conflict.Path)
+			}
+
+			if len(res.Conflicts) > 0 {
+				return fmt.Errorf("%d conflicts in %s", len(res.Conflicts), output)
+			}
+
+			return nil
+		},
+	}
+}
@@ -38,6 +38,9 @@ func Execute() error {
 		Commands: []*cli.Command{
 			Server(cfg),
 			Health(cfg),
+			Trust(cfg),
+			Diff(cfg),
+			Merge(cfg),
 		},
 	}
 
@@ -16,11 +16,13 @@ import (
 	zipkinhttp "github.com/openzipkin/zipkin-go/reporter/http"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/flagset"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernel"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/metrics"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/server/debug"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/server/grpc"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/server/http"
 	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/trace"
 )
@@ -38,7 +40,27 @@ func Server(cfg *config.Config) *cli.Command {
 
 			// When running on single binary mode the before hook from the root command won't get called. We manually
 			// call this before hook from ocis command, so the configuration can be loaded.
-			return ParseConfig(ctx, cfg)
+			if err := ParseConfig(ctx, cfg); err != nil {
+				return err
+			}
+
+			if ctx.IsSet("render-url-schemes") {
+				cfg.Render.URLSchemes = ctx.StringSlice("render-url-schemes")
+			}
+
+			if ctx.IsSet("render-mime-priority") {
+				cfg.Render.MimePriority = ctx.StringSlice("render-mime-priority")
+			}
+
+			if ctx.IsSet("render-disabled-mime-types") {
+				cfg.Render.DisabledMimeTypes = ctx.StringSlice("render-disabled-mime-types")
+			}
+
+			if ctx.IsSet("kernels-paths") {
+				cfg.Kernels.Paths = ctx.StringSlice("kernels-paths")
+			}
+
+			return nil
 		},
 		Action: func(c *cli.Context) error {
 			logger := NewLogger(cfg)
@@ -131,10 +153,25 @@ func Server(cfg *config.Config) *cli.Command {
 				gr          = run.Group{}
 				ctx, cancel = context.WithCancel(context.Background())
 				mtrcs       = metrics.New(metrics.Logger(logger))
+				cache       = svc.NewRenderCache(cfg.Render.CacheSize)
+				specs       = svc.NewKernelSpecs(cfg)
 			)
 
 			defer cancel()
 
+			drv, err := storage.New(cfg)
+
+			if err != nil {
+				logger.Error().
+					Err(err).
+					Str("driver", cfg.Storage.Driver).
+					Msg("Failed to initialize storage")
+
+				return err
+			}
+
+			files := svc.NewContents(cfg, drv)
+
 			// Flags have to be injected all the way down to the go-micro service
 			{
 				server := http.Server(
@@ -143,6 +180,10 @@ func Server(cfg *config.Config) *cli.Command {
 					http.Context(ctx),
 					http.Config(cfg),
 					http.Metrics(mtrcs),
+					http.Storage(drv),
+					http.Cache(cache),
+					http.Contents(files),
+					http.KernelSpecs(specs),
 					http.Flags(flagset.RootWithConfig(cfg)),
 					http.Flags(flagset.ServerWithConfig(cfg)),
 				)
@@ -165,11 +206,15 @@ func Server(cfg *config.Config) *cli.Command {
 					grpc.Context(ctx),
 					grpc.Config(cfg),
 					grpc.Metrics(mtrcs),
+					grpc.Storage(drv),
+					grpc.Cache(cache),
+					grpc.Contents(files),
+					grpc.KernelSpecs(specs),
 				)
 
 				gr.Add(func() error {
 					logger.Info().Str("service", server.Name()).Msg("Reporting settings bundles to settings service")
-					go svc.RegisterSettingsBundles(&logger)
+					go svc.RegisterSettingsBundles(&logger, cfg, specs.List())
 					return server.Run()
 				}, func(_ error) {
 					logger.Info().
@@ -180,6 +225,24 @@ func Server(cfg *config.Config) *cli.Command {
 				})
 			}
 
+			{
+				kernels := kernel.New(
+					kernel.Logger(logger),
+					kernel.Specs(specs),
+					kernel.RuntimeDir(cfg.Kernels.RuntimeDir),
+				)
+
+				gr.Add(func() error {
+					return kernels.Run(ctx)
+				}, func(_ error) {
+					logger.Info().
+						Str("service", "kernels").
+						Msg("Shutting down kernels")
+
+					cancel()
+				})
+			}
+
 			{
 				server, err := debug.Server(
 					debug.Logger(logger),
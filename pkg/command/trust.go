@@ -0,0 +1,92 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/flagset"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/trust"
+	"github.com/micro/cli/v2"
+)
+
+// Trust is the entrypoint for the trust command.
+func Trust(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "trust",
+		Usage:     "Sign notebooks, so their outputs are rendered as they are",
+		ArgsUsage: "<file>...",
+		Flags:     flagset.TrustWithConfig(cfg),
+		Before: func(ctx *cli.Context) error {
+			return ParseConfig(ctx, cfg)
+		},
+		Action: func(c *cli.Context) error {
+			if c.NArg() == 0 {
+				return errors.New("missing notebook file")
+			}
+
+			signer := trust.NewSigner(
+				cfg.TokenManager.TrustSecret,
+				trust.NewStore(cfg.Trust.Database),
+			)
+
+			untrusted := 0
+
+			for _, file := range c.Args().Slice() {
+				b, err := ioutil.ReadFile(file)
+
+				if err != nil {
+					return err
+				}
+
+				nb, report, err := notebook.Load(b)
+
+				if err != nil {
+					return fmt.Errorf("%s: %w", file, err)
+				}
+
+				if report != nil {
+					return fmt.Errorf("%s: %w", file, svc.ErrLegacyTrust)
+				}
+
+				if c.Bool("check") {
+					trusted, err := signer.Check(nb)
+
+					if err != nil {
+						return fmt.Errorf("%s: %w", file, err)
+					}
+
+					if trusted {
+						fmt.Fprintf(c.App.Writer, "Notebook is trusted: %s\n", file)
+					} else {
+						fmt.Fprintf(c.App.Writer, "Notebook is not trusted: %s\n", file)
+						untrusted++
+					}
+
+					continue
+				}
+
+				_, signed, err := signer.Sign(nb)
+
+				if err != nil {
+					return fmt.Errorf("%s: %w", file, err)
+				}
+
+				if signed {
+					fmt.Fprintf(c.App.Writer, "Notebook already signed: %s\n", file)
+				} else {
+					fmt.Fprintf(c.App.Writer, "Signing notebook: %s\n", file)
+				}
+			}
+
+			if untrusted > 0 {
+				return fmt.Errorf("%d of %d notebooks are not trusted", untrusted, c.NArg())
+			}
+
+			return nil
+		},
+	}
+}
@@ -37,14 +37,83 @@ type Tracing struct {
 	Service   string
 }
 
+// Reva defines the available reva configuration.
+type Reva struct {
+	Address string
+}
+
+// Storage defines the available storage configuration.
+type Storage struct {
+	Driver string
+	WebDAV WebDAV
+	Local  Local
+}
+
+// WebDAV defines the available WebDAV storage configuration.
+type WebDAV struct {
+	URL      string
+	Insecure bool
+}
+
+// Local defines the available local storage configuration.
+type Local struct {
+	Root string
+}
+
+// Checkpoints defines the available notebook checkpoint configuration.
+type Checkpoints struct {
+	Folder    string
+	Retention int
+}
+
+// Events defines the available storage events configuration.
+type Events struct {
+	Topics  Topics
+	Queue   string
+	Workers int
+}
+
+// Topics defines the broker topics of storage events.
+type Topics struct {
+	Created  string
+	Modified string
+	Moved    string
+	Deleted  string
+}
+
 // Asset defines the available asset configuration.
 type Asset struct {
 	Path string
 }
 
+// Render defines the available render configuration.
+type Render struct {
+	DisableSanitizer  bool
+	URLSchemes        []string
+	AllowStyles       bool
+	MimePriority      []string
+	DisabledMimeTypes []string
+	Theme             string
+	CacheSize         int
+}
+
+// Trust defines the available notebook trust configuration.
+type Trust struct {
+	Database string
+}
+
+// Kernels defines the available kernel configuration.
+type Kernels struct {
+	Paths         []string
+	Default       string
+	RuntimeDir    string
+	SystemAccount string
+}
+
 // TokenManager is the config for using the reva token manager
 type TokenManager struct {
-	JWTSecret string
+	JWTSecret   string
+	TrustSecret string
 }
 
 // Config combines all available configuration parts.
@@ -55,7 +124,14 @@ type Config struct {
 	HTTP         HTTP
 	GRPC         GRPC
 	Tracing      Tracing
+	Reva         Reva
+	Storage      Storage
+	Checkpoints  Checkpoints
+	Events       Events
 	Asset        Asset
+	Render       Render
+	Trust        Trust
+	Kernels      Kernels
 	TokenManager TokenManager
 }
 
@@ -0,0 +1,317 @@
+package contents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+// Checkpoint describes a snapshot of a file, e.g. taken by "Save and
+// Checkpoint" in JupyterLab.
+type Checkpoint struct {
+	ID           string    `json:"id"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// CreateCheckpoint takes a snapshot of a file. If more checkpoints than the
+// retention allows exist afterwards, the oldest ones are removed.
+func (m *Manager) CreateCheckpoint(ctx context.Context, p string) (*Checkpoint, error) {
+	checkpoints, err := m.ListCheckpoints(ctx, p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := m.storage.Read(ctx, reference(p))
+
+	if err != nil {
+		return nil, err
+	}
+
+	id := 1
+
+	if len(checkpoints) > 0 {
+		last, _ := strconv.Atoi(checkpoints[len(checkpoints)-1].ID)
+		id = last + 1
+	}
+
+	if err := m.makeDirs(ctx, m.checkpointDir(p)); err != nil {
+		return nil, err
+	}
+
+	ref := m.checkpointRef(p, id)
+
+	if _, err := m.storage.Write(ctx, ref, b, ""); err != nil {
+		return nil, err
+	}
+
+	info, err := m.storage.Stat(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if retention := m.options.CheckpointRetention; retention > 0 && len(checkpoints) >= retention {
+		for _, c := range checkpoints[:len(checkpoints)-retention+1] {
+			if err := m.DeleteCheckpoint(ctx, p, c.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &Checkpoint{
+		ID:           strconv.Itoa(id),
+		LastModified: info.Modified,
+	}, nil
+}
+
+// ListCheckpoints returns the checkpoints of a file, the latest last.
+func (m *Manager) ListCheckpoints(ctx context.Context, p string) ([]*Checkpoint, error) {
+	info, err := m.stat(ctx, p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir {
+		return nil, fmt.Errorf("%w: folders have no checkpoints", ErrInvalidType)
+	}
+
+	infos, err := m.storage.List(ctx, reference(m.checkpointDir(p)))
+
+	if errors.Is(err, storage.ErrNotFound) {
+		return []*Checkpoint{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoints := []*Checkpoint{}
+
+	for _, info := range infos {
+		if id, ok := m.checkpointID(p, info); ok {
+			checkpoints = append(checkpoints, &Checkpoint{
+				ID:           strconv.Itoa(id),
+				LastModified: info.Modified,
+			})
+		}
+	}
+
+	sort.Slice(checkpoints, func(i, j int) bool {
+		a, _ := strconv.Atoi(checkpoints[i].ID)
+		b, _ := strconv.Atoi(checkpoints[j].ID)
+
+		return a < b
+	})
+
+	return checkpoints, nil
+}
+
+// RestoreCheckpoint replaces the content of a file with the one of a
+// checkpoint.
+func (m *Manager) RestoreCheckpoint(ctx context.Context, p, id string) error {
+	ref, err := m.lookupCheckpoint(p, id)
+
+	if err != nil {
+		return err
+	}
+
+	b, err := m.storage.Read(ctx, ref)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = m.storage.Write(ctx, reference(p), b, "")
+	return err
+}
+
+// DeleteCheckpoint removes a checkpoint of a file.
+func (m *Manager) DeleteCheckpoint(ctx context.Context, p, id string) error {
+	ref, err := m.lookupCheckpoint(p, id)
+
+	if err != nil {
+		return err
+	}
+
+	return m.storage.Delete(ctx, ref)
+}
+
+// moveCheckpoints moves the checkpoints of a renamed file or folder along.
+// Checkpoints in a folder next to the files of a renamed folder move with
+// it anyway.
+func (m *Manager) moveCheckpoints(ctx context.Context, info *storage.Info, to string) error {
+	if info.IsDir {
+		if !path.IsAbs(m.options.CheckpointFolder) {
+			return nil
+		}
+
+		err := m.storage.Move(ctx, reference(path.Join(m.options.CheckpointFolder, info.Path)), reference(path.Join(m.options.CheckpointFolder, to)))
+
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	infos, err := m.storage.List(ctx, reference(m.checkpointDir(info.Path)))
+
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, child := range infos {
+		id, ok := m.checkpointID(info.Path, child)
+
+		if !ok {
+			continue
+		}
+
+		if err := m.makeDirs(ctx, m.checkpointDir(to)); err != nil {
+			return err
+		}
+
+		if err := m.storage.Move(ctx, storage.Reference{Path: child.Path}, m.checkpointRef(to, id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteCheckpoints removes the checkpoints of a deleted file or folder.
+func (m *Manager) deleteCheckpoints(ctx context.Context, info *storage.Info) error {
+	var refs []storage.Reference
+
+	switch {
+	case info.IsDir && path.IsAbs(m.options.CheckpointFolder):
+		refs = append(refs, reference(path.Join(m.options.CheckpointFolder, info.Path)))
+	case !info.IsDir:
+		infos, err := m.storage.List(ctx, reference(m.checkpointDir(info.Path)))
+
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+
+		for _, child := range infos {
+			if _, ok := m.checkpointID(info.Path, child); ok {
+				refs = append(refs, storage.Reference{Path: child.Path})
+			}
+		}
+	}
+
+	for _, ref := range refs {
+		if err := m.storage.Delete(ctx, ref); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lookupCheckpoint returns the reference of a checkpoint. Only ids as
+// returned by CreateCheckpoint are accepted, so they can not point anywhere
+// else.
+func (m *Manager) lookupCheckpoint(p, id string) (storage.Reference, error) {
+	if m.storage == nil {
+		return storage.Reference{}, ErrNoStorage
+	}
+
+	n, err := strconv.Atoi(id)
+
+	if err != nil || n < 1 || strconv.Itoa(n) != id {
+		return storage.Reference{}, fmt.Errorf("%w: checkpoint %s of %s", storage.ErrNotFound, id, apiPath(p))
+	}
+
+	return m.checkpointRef(p, n), nil
+}
+
+// checkpointDir returns the folder of the checkpoints of a file.
+func (m *Manager) checkpointDir(p string) string {
+	dir := path.Dir("/" + apiPath(p))
+
+	if path.IsAbs(m.options.CheckpointFolder) {
+		return path.Join(m.options.CheckpointFolder, dir)
+	}
+
+	return path.Join(dir, m.options.CheckpointFolder)
+}
+
+// checkpointRef returns the reference of a checkpoint. Like the Jupyter
+// Server it is named after the file, e.g. analysis-checkpoint-1.ipynb.
+func (m *Manager) checkpointRef(p string, id int) storage.Reference {
+	name := path.Base("/" + apiPath(p))
+	ext := path.Ext(name)
+
+	return reference(path.Join(
+		m.checkpointDir(p),
+		strings.TrimSuffix(name, ext)+"-checkpoint-"+strconv.Itoa(id)+ext,
+	))
+}
+
+// checkpointID returns the id of a checkpoint of the file, it returns false
+// for other files in the checkpoint folder.
+func (m *Manager) checkpointID(p string, info *storage.Info) (int, bool) {
+	if info.IsDir {
+		return 0, false
+	}
+
+	name := path.Base("/" + apiPath(p))
+	ext := path.Ext(name)
+	prefix := strings.TrimSuffix(name, ext) + "-checkpoint-"
+
+	if !strings.HasPrefix(info.Name(), prefix) || !strings.HasSuffix(info.Name(), ext) {
+		return 0, false
+	}
+
+	id := strings.TrimSuffix(strings.TrimPrefix(info.Name(), prefix), ext)
+	n, err := strconv.Atoi(id)
+
+	if err != nil || n < 1 || strconv.Itoa(n) != id {
+		return 0, false
+	}
+
+	return n, true
+}
+
+// makeDirs creates a folder and all of its parents which do not exist yet.
+func (m *Manager) makeDirs(ctx context.Context, dir string) error {
+	current := "/"
+
+	for _, segment := range strings.Split(strings.Trim(dir, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+
+		current = path.Join(current, segment)
+		info, err := m.storage.Stat(ctx, storage.Reference{Path: current})
+
+		switch {
+		case errors.Is(err, storage.ErrNotFound):
+			err = m.storage.MakeDir(ctx, storage.Reference{Path: current})
+
+			if err != nil && !errors.Is(err, storage.ErrExists) {
+				return err
+			}
+		case err != nil:
+			return err
+		case !info.IsDir:
+			return fmt.Errorf("%w: %s is not a %s", ErrInvalidType, apiPath(current), TypeDirectory)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package contents
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager_Checkpoints(t *testing.T) {
+	_, drv := newManager(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+	})
+
+	m := New(drv, CheckpointRetention(2))
+	ctx := context.Background()
+	ref := storage.Reference{Path: "/Notebooks/analysis.ipynb"}
+
+	checkpoints, err := m.ListCheckpoints(ctx, "Notebooks/analysis.ipynb")
+	assert.Nil(t, err)
+	assert.Empty(t, checkpoints)
+
+	first, err := m.CreateCheckpoint(ctx, "Notebooks/analysis.ipynb")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", first.ID)
+
+	b, err := drv.Read(ctx, storage.Reference{Path: "/Notebooks/.ipynb_checkpoints/analysis-checkpoint-1.ipynb"})
+	assert.Nil(t, err)
+	assert.Equal(t, testNotebook, string(b))
+
+	_, err = drv.Write(ctx, ref, []byte("changed"), "")
+	assert.Nil(t, err)
+
+	assert.Nil(t, m.RestoreCheckpoint(ctx, "Notebooks/analysis.ipynb", first.ID))
+
+	b, err = drv.Read(ctx, ref)
+	assert.Nil(t, err)
+	assert.Equal(t, testNotebook, string(b))
+
+	_, err = m.CreateCheckpoint(ctx, "Notebooks/analysis.ipynb")
+	assert.Nil(t, err)
+
+	third, err := m.CreateCheckpoint(ctx, "Notebooks/analysis.ipynb")
+	assert.Nil(t, err)
+	assert.Equal(t, "3", third.ID)
+
+	checkpoints, err = m.ListCheckpoints(ctx, "Notebooks/analysis.ipynb")
+	assert.Nil(t, err)
+
+	if assert.Len(t, checkpoints, 2) {
+		assert.Equal(t, "2", checkpoints[0].ID)
+		assert.Equal(t, "3", checkpoints[1].ID)
+	}
+
+	err = m.RestoreCheckpoint(ctx, "Notebooks/analysis.ipynb", first.ID)
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+
+	err = m.RestoreCheckpoint(ctx, "Notebooks/analysis.ipynb", "../../analysis")
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+
+	assert.Nil(t, m.DeleteCheckpoint(ctx, "Notebooks/analysis.ipynb", "2"))
+
+	err = m.DeleteCheckpoint(ctx, "Notebooks/analysis.ipynb", "2")
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+
+	model, err := m.Get(ctx, "Notebooks", GetOptions{Content: true})
+	assert.Nil(t, err)
+	assert.Len(t, model.Content, 1)
+
+	_, err = m.ListCheckpoints(ctx, "Notebooks")
+	assert.True(t, errors.Is(err, ErrInvalidType))
+
+	_, err = m.CreateCheckpoint(ctx, "Notebooks/missing.ipynb")
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+}
+
+func TestManager_CheckpointsRenameDelete(t *testing.T) {
+	_, drv := newManager(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+	})
+
+	ctx := context.Background()
+
+	for _, folder := range []string{".ipynb_checkpoints", "/.checkpoints"} {
+		m := New(drv, CheckpointFolder(folder))
+
+		_, err := m.CreateCheckpoint(ctx, "Notebooks/analysis.ipynb")
+		assert.Nil(t, err)
+
+		_, err = m.Rename(ctx, "Notebooks/analysis.ipynb", "report.ipynb")
+		assert.Nil(t, err)
+
+		checkpoints, err := m.ListCheckpoints(ctx, "report.ipynb")
+		assert.Nil(t, err)
+		assert.Len(t, checkpoints, 1)
+
+		_, err = m.Rename(ctx, "report.ipynb", "Notebooks/analysis.ipynb")
+		assert.Nil(t, err)
+
+		_, err = m.Rename(ctx, "Notebooks", "Archive")
+		assert.Nil(t, err)
+
+		checkpoints, err = m.ListCheckpoints(ctx, "Archive/analysis.ipynb")
+		assert.Nil(t, err)
+		assert.Len(t, checkpoints, 1)
+
+		_, err = m.Rename(ctx, "Archive", "Notebooks")
+		assert.Nil(t, err)
+
+		_, err = m.CreateCheckpoint(ctx, "Notebooks/analysis.ipynb")
+		assert.Nil(t, err)
+
+		b, err := drv.Read(ctx, storage.Reference{Path: "/Notebooks/analysis.ipynb"})
+		assert.Nil(t, err)
+
+		assert.Nil(t, m.Delete(ctx, "Notebooks/analysis.ipynb"))
+
+		_, err = drv.Write(ctx, storage.Reference{Path: "/Notebooks/analysis.ipynb"}, b, "")
+		assert.Nil(t, err)
+
+		checkpoints, err = m.ListCheckpoints(ctx, "Notebooks/analysis.ipynb")
+		assert.Nil(t, err)
+		assert.Empty(t, checkpoints)
+	}
+
+	_, err := drv.Stat(ctx, storage.Reference{Path: "/.checkpoints/Notebooks"})
+	assert.Nil(t, err)
+}
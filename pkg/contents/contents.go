@@ -0,0 +1,592 @@
+// Package contents implements the Contents API of the Jupyter Server on top
+// of the notebook storage, so stock Jupyter clients like JupyterLab or VS Code
+// can open and save files which live in oCIS.
+package contents
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+const (
+	// TypeNotebook defines the type of notebooks.
+	TypeNotebook = "notebook"
+
+	// TypeFile defines the type of all other files.
+	TypeFile = "file"
+
+	// TypeDirectory defines the type of folders.
+	TypeDirectory = "directory"
+
+	// FormatJSON defines the format of notebooks and folders.
+	FormatJSON = "json"
+
+	// FormatText defines the format of UTF-8 encoded files.
+	FormatText = "text"
+
+	// FormatBase64 defines the format of binary files.
+	FormatBase64 = "base64"
+)
+
+var (
+	// ErrNoStorage defines the error if no storage is configured.
+	ErrNoStorage = errors.New("no notebook storage configured")
+
+	// ErrInvalidType defines the error if the type does not match the file.
+	ErrInvalidType = errors.New("invalid type")
+
+	// ErrInvalidFormat defines the error if the content can not be served or
+	// stored in the requested format.
+	ErrInvalidFormat = errors.New("invalid format")
+
+	// ErrInvalidContent defines the error if the content is missing or can
+	// not be decoded.
+	ErrInvalidContent = errors.New("invalid content")
+)
+
+// copySuffix matches the suffix of copies, so copies of copies are numbered
+// like copies of the original.
+var copySuffix = regexp.MustCompile(`-Copy\d*$`)
+
+// Model describes a file the same way the Jupyter Server does. Size and
+// mimetype are null for folders, content and format are null unless the
+// content was requested.
+type Model struct {
+	Name         string      `json:"name"`
+	Path         string      `json:"path"`
+	Type         string      `json:"type"`
+	Writable     bool        `json:"writable"`
+	Created      time.Time   `json:"created"`
+	LastModified time.Time   `json:"last_modified"`
+	Size         *uint64     `json:"size"`
+	Mimetype     *string     `json:"mimetype"`
+	Content      interface{} `json:"content"`
+	Format       *string     `json:"format"`
+}
+
+// Request is the body of requests to save, create, copy or rename files.
+// Clients send the whole model, only the fields below are used.
+type Request struct {
+	Path     string          `json:"path"`
+	Type     string          `json:"type"`
+	Format   string          `json:"format"`
+	Content  json.RawMessage `json:"content"`
+	Ext      string          `json:"ext"`
+	CopyFrom string          `json:"copy_from"`
+}
+
+// GetOptions defines how a file is returned.
+type GetOptions struct {
+	// Type of the file, it is detected if empty.
+	Type string
+
+	// Format of the content, it is detected if empty.
+	Format string
+
+	// Content defines if the content is returned, for folders these are the
+	// models of the files in it.
+	Content bool
+}
+
+// Manager serves files of the storage as models. Paths are relative to the
+// root of the storage, with or without a leading slash.
+type Manager struct {
+	storage storage.Driver
+	options Options
+}
+
+// New initializes a new manager on top of the storage.
+func New(driver storage.Driver, opts ...Option) *Manager {
+	return &Manager{
+		storage: driver,
+		options: newOptions(opts...),
+	}
+}
+
+// Get returns the model of a file.
+func (m *Manager) Get(ctx context.Context, p string, opts GetOptions) (*Model, error) {
+	info, err := m.stat(ctx, p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	typ := opts.Type
+
+	switch {
+	case typ == "":
+		typ = detectType(info)
+	case !validType(typ):
+		return nil, fmt.Errorf("%w: %s", ErrInvalidType, typ)
+	case (typ == TypeDirectory) != info.IsDir:
+		return nil, fmt.Errorf("%w: %s is not a %s", ErrInvalidType, apiPath(p), typ)
+	}
+
+	model := newModel(info, typ)
+
+	if !opts.Content {
+		return model, nil
+	}
+
+	switch typ {
+	case TypeDirectory:
+		err = m.listContent(ctx, model, info)
+	case TypeNotebook:
+		err = m.notebookContent(ctx, model, info)
+	default:
+		err = m.fileContent(ctx, model, info, opts.Format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+// Save writes a file or creates a folder, it returns the model without
+// content and if the file was created. Existing files are replaced
+// unconditionally, as the Jupyter Server does.
+func (m *Manager) Save(ctx context.Context, p string, req *Request) (*Model, bool, error) {
+	if m.storage == nil {
+		return nil, false, ErrNoStorage
+	}
+
+	if req.Type == "" {
+		return nil, false, fmt.Errorf("%w: missing type", ErrInvalidType)
+	}
+
+	if !validType(req.Type) {
+		return nil, false, fmt.Errorf("%w: %s", ErrInvalidType, req.Type)
+	}
+
+	ref := reference(p)
+	current, err := m.storage.Stat(ctx, ref)
+
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		current = nil
+	case err != nil:
+		return nil, false, err
+	case current.IsDir != (req.Type == TypeDirectory):
+		return nil, false, fmt.Errorf("%w: %s is not a %s", ErrInvalidType, apiPath(p), req.Type)
+	}
+
+	if req.Type == TypeDirectory {
+		if current == nil {
+			err = m.storage.MakeDir(ctx, ref)
+		}
+	} else {
+		var b []byte
+
+		if b, err = decodeContent(req); err == nil {
+			_, err = m.storage.Write(ctx, ref, b, "")
+		}
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	model, err := m.Get(ctx, p, GetOptions{})
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return model, current == nil, nil
+}
+
+// Create adds an untitled notebook, file or folder to a folder, or copies a
+// file into it if the request names one to copy from. It returns the model
+// of the new file without content.
+func (m *Manager) Create(ctx context.Context, dir string, req *Request) (*Model, error) {
+	info, err := m.stat(ctx, dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir {
+		return nil, fmt.Errorf("%w: %s is not a %s, use PUT to save files", ErrInvalidType, apiPath(dir), TypeDirectory)
+	}
+
+	if req.CopyFrom != "" {
+		return m.copy(ctx, req.CopyFrom, dir)
+	}
+
+	typ, ext := req.Type, req.Ext
+
+	if typ == "" {
+		typ = TypeFile
+
+		if ext == ".ipynb" {
+			typ = TypeNotebook
+		}
+	}
+
+	switch typ {
+	case TypeNotebook:
+		name, err := m.uniqueName(ctx, dir, "Untitled", ".ipynb", "", 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := notebook.New().Marshal()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := m.storage.Write(ctx, reference(name), b, ""); err != nil {
+			return nil, err
+		}
+
+		return m.Get(ctx, name, GetOptions{})
+	case TypeFile:
+		name, err := m.uniqueName(ctx, dir, "untitled", ext, "", 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := m.storage.Write(ctx, reference(name), []byte{}, ""); err != nil {
+			return nil, err
+		}
+
+		return m.Get(ctx, name, GetOptions{})
+	case TypeDirectory:
+		name, err := m.uniqueName(ctx, dir, "Untitled Folder", "", " ", 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := m.storage.MakeDir(ctx, reference(name)); err != nil {
+			return nil, err
+		}
+
+		return m.Get(ctx, name, GetOptions{})
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrInvalidType, typ)
+}
+
+// Rename moves a file or a folder along with its checkpoints, it returns the
+// model of the moved file without content.
+func (m *Manager) Rename(ctx context.Context, from, to string) (*Model, error) {
+	info, err := m.stat(ctx, from)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.storage.Move(ctx, reference(from), reference(to)); err != nil {
+		return nil, err
+	}
+
+	if err := m.moveCheckpoints(ctx, info, to); err != nil {
+		return nil, err
+	}
+
+	return m.Get(ctx, to, GetOptions{})
+}
+
+// Delete removes a file or a folder with all files in it, along with its
+// checkpoints.
+func (m *Manager) Delete(ctx context.Context, p string) error {
+	info, err := m.stat(ctx, p)
+
+	if err != nil {
+		return err
+	}
+
+	if err := m.storage.Delete(ctx, reference(p)); err != nil {
+		return err
+	}
+
+	return m.deleteCheckpoints(ctx, info)
+}
+
+// copy duplicates a file into a folder, the copy is named like the Jupyter
+// Server does, e.g. analysis-Copy1.ipynb.
+func (m *Manager) copy(ctx context.Context, from, dir string) (*Model, error) {
+	info, err := m.stat(ctx, from)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir {
+		return nil, fmt.Errorf("%w: folders can not be copied", ErrInvalidType)
+	}
+
+	b, err := m.storage.Read(ctx, reference(from))
+
+	if err != nil {
+		return nil, err
+	}
+
+	ext := path.Ext(info.Name())
+	base := copySuffix.ReplaceAllString(strings.TrimSuffix(info.Name(), ext), "")
+
+	name, err := m.uniqueName(ctx, dir, base+"-Copy", ext, "", 1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := m.storage.Write(ctx, reference(name), b, ""); err != nil {
+		return nil, err
+	}
+
+	return m.Get(ctx, name, GetOptions{})
+}
+
+// uniqueName returns the path of the first name not taken in a folder. The
+// names are tried with a number between base and extension counting up from
+// start, 0 leaves the number out, e.g. Untitled.ipynb, Untitled1.ipynb and so
+// on.
+func (m *Manager) uniqueName(ctx context.Context, dir, base, ext, sep string, start int) (string, error) {
+	for i := start; ; i++ {
+		name := base + ext
+
+		if i > 0 {
+			name = base + sep + fmt.Sprint(i) + ext
+		}
+
+		p := path.Join(apiPath(dir), name)
+
+		_, err := m.storage.Stat(ctx, reference(p))
+
+		if errors.Is(err, storage.ErrNotFound) {
+			return p, nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// stat returns the info of a file.
+func (m *Manager) stat(ctx context.Context, p string) (*storage.Info, error) {
+	if m.storage == nil {
+		return nil, ErrNoStorage
+	}
+
+	return m.storage.Stat(ctx, reference(p))
+}
+
+// listContent sets the models of the files in a folder as content. Like the
+// Jupyter Server, hidden files are left out, including the checkpoints.
+func (m *Manager) listContent(ctx context.Context, model *Model, info *storage.Info) error {
+	infos, err := m.storage.List(ctx, storage.Reference{Path: info.Path})
+
+	if err != nil {
+		return err
+	}
+
+	models := make([]*Model, 0, len(infos))
+
+	for _, child := range infos {
+		if strings.HasPrefix(child.Name(), ".") {
+			continue
+		}
+
+		models = append(models, newModel(child, detectType(child)))
+	}
+
+	model.Content = models
+	model.Format = stringPtr(FormatJSON)
+
+	return nil
+}
+
+// notebookContent sets the notebook as content. Notebooks in older format
+// versions are upgraded, as the Jupyter Server does.
+func (m *Manager) notebookContent(ctx context.Context, model *Model, info *storage.Info) error {
+	b, err := m.storage.Read(ctx, storage.Reference{Path: info.Path})
+
+	if err != nil {
+		return err
+	}
+
+	nb, _, err := notebook.Load(b)
+
+	if err != nil {
+		return fmt.Errorf("%w: unreadable notebook %s: %s", ErrInvalidContent, model.Path, err)
+	}
+
+	model.Content = nb
+	model.Format = stringPtr(FormatJSON)
+
+	return nil
+}
+
+// fileContent sets the content of a file, as text if it is UTF-8 encoded
+// and as base64 otherwise.
+func (m *Manager) fileContent(ctx context.Context, model *Model, info *storage.Info, format string) error {
+	b, err := m.storage.Read(ctx, storage.Reference{Path: info.Path})
+
+	if err != nil {
+		return err
+	}
+
+	if format == "" {
+		format = FormatBase64
+
+		if utf8.Valid(b) {
+			format = FormatText
+		}
+	}
+
+	switch format {
+	case FormatText:
+		if !utf8.Valid(b) {
+			return fmt.Errorf("%w: %s is not UTF-8 encoded", ErrInvalidFormat, model.Path)
+		}
+
+		model.Content = string(b)
+
+		if model.Mimetype == nil {
+			model.Mimetype = stringPtr("text/plain")
+		}
+	case FormatBase64:
+		model.Content = base64.StdEncoding.EncodeToString(b)
+
+		if model.Mimetype == nil {
+			model.Mimetype = stringPtr("application/octet-stream")
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidFormat, format)
+	}
+
+	model.Format = stringPtr(format)
+
+	return nil
+}
+
+// decodeContent returns the content of a file to save. Notebooks are
+// validated and written the same way nbformat does.
+func decodeContent(req *Request) ([]byte, error) {
+	if len(req.Content) == 0 || string(req.Content) == "null" {
+		return nil, fmt.Errorf("%w: missing content", ErrInvalidContent)
+	}
+
+	if req.Type == TypeNotebook {
+		if req.Format != "" && req.Format != FormatJSON {
+			return nil, fmt.Errorf("%w: notebooks must be saved as %s", ErrInvalidFormat, FormatJSON)
+		}
+
+		nb, _, err := notebook.Load(req.Content)
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidContent, err)
+		}
+
+		return nb.Marshal()
+	}
+
+	var content string
+
+	if err := json.Unmarshal(req.Content, &content); err != nil {
+		return nil, fmt.Errorf("%w: the content of files must be a string", ErrInvalidContent)
+	}
+
+	switch req.Format {
+	case FormatText:
+		return []byte(content), nil
+	case FormatBase64:
+		b, err := base64.StdEncoding.DecodeString(content)
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidContent, err)
+		}
+
+		return b, nil
+	case "":
+		return nil, fmt.Errorf("%w: missing format", ErrInvalidFormat)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrInvalidFormat, req.Format)
+}
+
+// newModel returns the model of a file without content.
+func newModel(info *storage.Info, typ string) *Model {
+	p := apiPath(info.Path)
+
+	model := &Model{
+		Name:         p[strings.LastIndex(p, "/")+1:],
+		Path:         p,
+		Type:         typ,
+		Writable:     true,
+		Created:      info.Modified,
+		LastModified: info.Modified,
+	}
+
+	if typ == TypeFile {
+		size := info.Size
+		model.Size = &size
+
+		if info.MimeType != "" {
+			model.Mimetype = stringPtr(info.MimeType)
+		} else if t := mime.TypeByExtension(path.Ext(info.Path)); t != "" {
+			model.Mimetype = stringPtr(t)
+		}
+	}
+
+	if typ == TypeNotebook {
+		size := info.Size
+		model.Size = &size
+	}
+
+	return model
+}
+
+// detectType returns the type of a file by its info.
+func detectType(info *storage.Info) string {
+	switch {
+	case info.IsDir:
+		return TypeDirectory
+	case path.Ext(info.Path) == ".ipynb":
+		return TypeNotebook
+	}
+
+	return TypeFile
+}
+
+// reference returns the storage reference of an API path.
+func reference(p string) storage.Reference {
+	return storage.Reference{Path: "/" + apiPath(p)}
+}
+
+// apiPath returns a path the way the Contents API uses it, relative to the
+// root without a leading or trailing slash.
+func apiPath(p string) string {
+	return strings.Trim(path.Clean("/"+p), "/")
+}
+
+// validType returns true for the types of the Contents API.
+func validType(typ string) bool {
+	return typ == TypeNotebook || typ == TypeFile || typ == TypeDirectory
+}
+
+// stringPtr returns a pointer to the string, for the nullable members of a
+// model.
+func stringPtr(s string) *string {
+	return &s
+}
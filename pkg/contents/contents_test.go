@@ -0,0 +1,269 @@
+package contents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+const testNotebook = `{"cells": [{"cell_type": "markdown", "id": "intro", "metadata": {}, "source": "# Analysis"}], "metadata": {}, "nbformat": 4, "nbformat_minor": 5}`
+
+// newManager returns a manager on top of a memory storage with the given
+// files.
+func newManager(t *testing.T, files map[string]string) (*Manager, storage.Driver) {
+	drv := storage.NewMemory()
+
+	for p, content := range files {
+		_, err := drv.Write(context.Background(), storage.Reference{Path: p}, []byte(content), "")
+		assert.Nil(t, err)
+	}
+
+	return New(drv), drv
+}
+
+func TestManager_Get(t *testing.T) {
+	m, _ := newManager(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+		"/Notebooks/data.csv":       "a,b\n1,2\n",
+		"/Notebooks/logo.bin":       "\xff\xfe",
+	})
+
+	ctx := context.Background()
+
+	model, err := m.Get(ctx, "Notebooks/analysis.ipynb", GetOptions{Content: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "analysis.ipynb", model.Name)
+	assert.Equal(t, "Notebooks/analysis.ipynb", model.Path)
+	assert.Equal(t, TypeNotebook, model.Type)
+	assert.Equal(t, FormatJSON, *model.Format)
+	assert.Nil(t, model.Mimetype)
+
+	if nb, ok := model.Content.(*notebook.Notebook); assert.True(t, ok) {
+		assert.Len(t, nb.Cells, 1)
+	}
+
+	model, err = m.Get(ctx, "/Notebooks/analysis.ipynb", GetOptions{})
+	assert.Nil(t, err)
+	assert.Nil(t, model.Content)
+	assert.Nil(t, model.Format)
+
+	model, err = m.Get(ctx, "Notebooks/analysis.ipynb", GetOptions{Type: TypeFile, Content: true})
+	assert.Nil(t, err)
+	assert.Equal(t, TypeFile, model.Type)
+	assert.Equal(t, testNotebook, model.Content)
+
+	model, err = m.Get(ctx, "Notebooks/data.csv", GetOptions{Content: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "a,b\n1,2\n", model.Content)
+	assert.Equal(t, FormatText, *model.Format)
+	assert.Equal(t, uint64(8), *model.Size)
+
+	model, err = m.Get(ctx, "Notebooks/data.csv", GetOptions{Format: FormatBase64, Content: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "YSxiCjEsMgo=", model.Content)
+
+	model, err = m.Get(ctx, "Notebooks/logo.bin", GetOptions{Content: true})
+	assert.Nil(t, err)
+	assert.Equal(t, FormatBase64, *model.Format)
+	assert.Equal(t, "//4=", model.Content)
+
+	_, err = m.Get(ctx, "Notebooks/logo.bin", GetOptions{Format: FormatText, Content: true})
+	assert.True(t, errors.Is(err, ErrInvalidFormat))
+
+	model, err = m.Get(ctx, "", GetOptions{Content: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "", model.Name)
+	assert.Equal(t, "", model.Path)
+	assert.Equal(t, TypeDirectory, model.Type)
+
+	if models, ok := model.Content.([]*Model); assert.True(t, ok) && assert.Len(t, models, 1) {
+		assert.Equal(t, "Notebooks", models[0].Path)
+		assert.Equal(t, TypeDirectory, models[0].Type)
+		assert.Nil(t, models[0].Content)
+	}
+
+	model, err = m.Get(ctx, "Notebooks", GetOptions{Type: TypeDirectory, Content: true})
+	assert.Nil(t, err)
+	assert.Len(t, model.Content, 3)
+
+	_, err = m.Get(ctx, "Notebooks", GetOptions{Type: TypeNotebook})
+	assert.True(t, errors.Is(err, ErrInvalidType))
+
+	_, err = m.Get(ctx, "Notebooks/data.csv", GetOptions{Type: TypeDirectory})
+	assert.True(t, errors.Is(err, ErrInvalidType))
+
+	_, err = m.Get(ctx, "Notebooks/missing.ipynb", GetOptions{})
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+
+	_, err = New(nil).Get(ctx, "Notebooks/analysis.ipynb", GetOptions{})
+	assert.Equal(t, ErrNoStorage, err)
+}
+
+func TestManager_Save(t *testing.T) {
+	m, drv := newManager(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+	})
+
+	ctx := context.Background()
+
+	model, created, err := m.Save(ctx, "Notebooks/analysis.ipynb", &Request{
+		Type:    TypeNotebook,
+		Format:  FormatJSON,
+		Content: json.RawMessage(testNotebook),
+	})
+	assert.Nil(t, err)
+	assert.False(t, created)
+	assert.Equal(t, TypeNotebook, model.Type)
+	assert.Nil(t, model.Content)
+
+	b, err := drv.Read(ctx, storage.Reference{Path: "/Notebooks/analysis.ipynb"})
+	assert.Nil(t, err)
+
+	nb, err := notebook.Parse(b)
+	assert.Nil(t, err)
+	assert.Len(t, nb.Cells, 1)
+
+	_, created, err = m.Save(ctx, "Notebooks/notes.txt", &Request{
+		Type:    TypeFile,
+		Format:  FormatText,
+		Content: json.RawMessage(`"notes"`),
+	})
+	assert.Nil(t, err)
+	assert.True(t, created)
+
+	_, _, err = m.Save(ctx, "Notebooks/logo.bin", &Request{
+		Type:    TypeFile,
+		Format:  FormatBase64,
+		Content: json.RawMessage(`"//4="`),
+	})
+	assert.Nil(t, err)
+
+	b, err = drv.Read(ctx, storage.Reference{Path: "/Notebooks/logo.bin"})
+	assert.Nil(t, err)
+	assert.Equal(t, "\xff\xfe", string(b))
+
+	model, created, err = m.Save(ctx, "Notebooks/Archive", &Request{
+		Type: TypeDirectory,
+	})
+	assert.Nil(t, err)
+	assert.True(t, created)
+	assert.Equal(t, TypeDirectory, model.Type)
+
+	_, created, err = m.Save(ctx, "Notebooks/Archive", &Request{
+		Type: TypeDirectory,
+	})
+	assert.Nil(t, err)
+	assert.False(t, created)
+
+	_, _, err = m.Save(ctx, "Notebooks/Archive", &Request{
+		Type:    TypeFile,
+		Format:  FormatText,
+		Content: json.RawMessage(`"notes"`),
+	})
+	assert.True(t, errors.Is(err, ErrInvalidType))
+
+	_, _, err = m.Save(ctx, "Notebooks/broken.ipynb", &Request{
+		Type:    TypeNotebook,
+		Content: json.RawMessage(`{"cells": []}`),
+	})
+	assert.True(t, errors.Is(err, ErrInvalidContent))
+
+	_, _, err = m.Save(ctx, "Notebooks/notes.txt", &Request{
+		Type:    TypeFile,
+		Content: json.RawMessage(`"notes"`),
+	})
+	assert.True(t, errors.Is(err, ErrInvalidFormat))
+
+	_, _, err = m.Save(ctx, "Notebooks/notes.txt", &Request{
+		Type:   TypeFile,
+		Format: FormatText,
+	})
+	assert.True(t, errors.Is(err, ErrInvalidContent))
+
+	_, _, err = m.Save(ctx, "Notebooks/notes.txt", &Request{})
+	assert.True(t, errors.Is(err, ErrInvalidType))
+}
+
+func TestManager_Create(t *testing.T) {
+	m, _ := newManager(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+	})
+
+	ctx := context.Background()
+
+	model, err := m.Create(ctx, "Notebooks", &Request{Type: TypeNotebook})
+	assert.Nil(t, err)
+	assert.Equal(t, "Notebooks/Untitled.ipynb", model.Path)
+
+	model, err = m.Create(ctx, "Notebooks", &Request{Ext: ".ipynb"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Notebooks/Untitled1.ipynb", model.Path)
+
+	_, err = m.Get(ctx, model.Path, GetOptions{Content: true})
+	assert.Nil(t, err)
+
+	model, err = m.Create(ctx, "Notebooks", &Request{Type: TypeFile, Ext: ".py"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Notebooks/untitled.py", model.Path)
+
+	model, err = m.Create(ctx, "Notebooks", &Request{Type: TypeDirectory})
+	assert.Nil(t, err)
+	assert.Equal(t, "Notebooks/Untitled Folder", model.Path)
+
+	model, err = m.Create(ctx, "Notebooks", &Request{Type: TypeDirectory})
+	assert.Nil(t, err)
+	assert.Equal(t, "Notebooks/Untitled Folder 1", model.Path)
+
+	model, err = m.Create(ctx, "Notebooks", &Request{CopyFrom: "Notebooks/analysis.ipynb"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Notebooks/analysis-Copy1.ipynb", model.Path)
+
+	model, err = m.Create(ctx, "", &Request{CopyFrom: "Notebooks/analysis-Copy1.ipynb"})
+	assert.Nil(t, err)
+	assert.Equal(t, "analysis-Copy1.ipynb", model.Path)
+
+	model, err = m.Create(ctx, "Notebooks", &Request{CopyFrom: "Notebooks/analysis-Copy1.ipynb"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Notebooks/analysis-Copy2.ipynb", model.Path)
+
+	_, err = m.Create(ctx, "Notebooks", &Request{CopyFrom: "Notebooks/Untitled Folder"})
+	assert.True(t, errors.Is(err, ErrInvalidType))
+
+	_, err = m.Create(ctx, "Notebooks/analysis.ipynb", &Request{Type: TypeNotebook})
+	assert.True(t, errors.Is(err, ErrInvalidType))
+
+	_, err = m.Create(ctx, "Missing", &Request{Type: TypeNotebook})
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+}
+
+func TestManager_RenameDelete(t *testing.T) {
+	m, _ := newManager(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+		"/Notebooks/data.csv":       "a,b\n1,2\n",
+	})
+
+	ctx := context.Background()
+
+	model, err := m.Rename(ctx, "Notebooks/analysis.ipynb", "Notebooks/report.ipynb")
+	assert.Nil(t, err)
+	assert.Equal(t, "Notebooks/report.ipynb", model.Path)
+	assert.Equal(t, "report.ipynb", model.Name)
+
+	_, err = m.Rename(ctx, "Notebooks/report.ipynb", "Notebooks/data.csv")
+	assert.True(t, errors.Is(err, storage.ErrExists))
+
+	assert.Nil(t, m.Delete(ctx, "Notebooks/report.ipynb"))
+
+	err = m.Delete(ctx, "Notebooks/report.ipynb")
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+
+	assert.Nil(t, m.Delete(ctx, "Notebooks"))
+
+	_, err = m.Get(ctx, "Notebooks", GetOptions{})
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+}
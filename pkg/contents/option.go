@@ -0,0 +1,46 @@
+package contents
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	// CheckpointFolder is the folder checkpoints are kept in. A relative
+	// folder is placed next to each file, an absolute one holds the
+	// checkpoints of all files below their paths.
+	CheckpointFolder string
+
+	// CheckpointRetention is the number of checkpoints kept per file, 0
+	// keeps all.
+	CheckpointRetention int
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		CheckpointFolder: ".ipynb_checkpoints",
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// CheckpointFolder provides a function to set the checkpoint folder option.
+func CheckpointFolder(val string) Option {
+	return func(o *Options) {
+		if val != "" {
+			o.CheckpointFolder = val
+		}
+	}
+}
+
+// CheckpointRetention provides a function to set the checkpoint retention
+// option.
+func CheckpointRetention(val int) Option {
+	return func(o *Options) {
+		o.CheckpointRetention = val
+	}
+}
@@ -0,0 +1,251 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+// similarity is the share of common source lines from which two cells of
+// the same type are considered the same cell.
+const similarity = 0.5
+
+// alignCells returns the pairs of matching cell indexes in order, an index
+// is -1 if the cell has no match.
+func alignCells(old, new []*notebook.Cell) [][2]int {
+	sameID := func(i, j int) bool {
+		return old[i].ID != "" && old[i].ID == new[j].ID
+	}
+
+	sameSource := func(i, j int) bool {
+		return old[i].CellType == new[j].CellType && old[i].Source.String() == new[j].Source.String()
+	}
+
+	sameType := func(i, j int) bool {
+		return old[i].CellType == new[j].CellType
+	}
+
+	oldLines, newLines := make([][]string, len(old)), make([][]string, len(new))
+
+	for i, c := range old {
+		oldLines[i] = sourceLines(c)
+	}
+
+	for j, c := range new {
+		newLines[j] = sourceLines(c)
+	}
+
+	similar := func(i, j int) bool {
+		return sameType(i, j) && ratio(oldLines[i], newLines[j]) >= similarity
+	}
+
+	a := &aligner{
+		similar:  similar,
+		sameType: sameType,
+	}
+	a.run(0, len(old), 0, len(new), []func(i, j int) bool{sameID, sameSource})
+
+	return a.pairs
+}
+
+// alignOutputs returns the pairs of matching output indexes in order.
+// Outputs are matched if they are equal apart from the execution count, or
+// else if they are of the same type.
+func alignOutputs(old, new []*notebook.Output) [][2]int {
+	oldJSON, newJSON := make([]string, len(old)), make([]string, len(new))
+
+	for i, o := range old {
+		oldJSON[i] = canonical(marshalOutput(o))
+	}
+
+	for j, o := range new {
+		newJSON[j] = canonical(marshalOutput(o))
+	}
+
+	equal := func(i, j int) bool {
+		return oldJSON[i] == newJSON[j]
+	}
+
+	sameType := func(i, j int) bool {
+		return old[i].OutputType == new[j].OutputType && old[i].Name == new[j].Name
+	}
+
+	a := &aligner{
+		similar:  sameType,
+		sameType: sameType,
+	}
+	a.run(0, len(old), 0, len(new), []func(i, j int) bool{equal})
+
+	return a.pairs
+}
+
+// aligner collects the pairs of matching indexes of two sequences.
+type aligner struct {
+	// similar and sameType match the items which are left after exact
+	// matching.
+	similar  func(i, j int) bool
+	sameType func(i, j int) bool
+
+	pairs [][2]int
+}
+
+// run aligns the ranges [i, k) and [j, l). The items matched by the first
+// of the exact matchers keep their order, the ranges in between are aligned
+// with the remaining matchers. Finally the items in between are paired.
+func (a *aligner) run(i, k, j, l int, exact []func(i, j int) bool) {
+	if len(exact) == 0 {
+		a.pair(i, k, j, l)
+		return
+	}
+
+	x, y := i, j
+
+	equal := func(m, n int) bool {
+		return exact[0](x+m, y+n)
+	}
+
+	for _, match := range lcs(k-i, l-j, equal) {
+		a.run(i, x+match[0], j, y+match[1], exact[1:])
+		a.pairs = append(a.pairs, [2]int{x + match[0], y + match[1]})
+		i, j = x+match[0]+1, y+match[1]+1
+	}
+
+	a.run(i, k, j, l, exact[1:])
+}
+
+// pair pairs every item of [i, k) with the next similar item of [j, l).
+// Without a similar one, an item is paired in place if the same number of
+// items is left in both ranges and both are of the same type, as it was
+// most likely rewritten. All other items are unmatched.
+func (a *aligner) pair(i, k, j, l int) {
+	for ; i < k; i++ {
+		match := -1
+
+		for y := j; y < l && match < 0; y++ {
+			if a.similar(i, y) {
+				match = y
+			}
+		}
+
+		if match < 0 && k-i == l-j && a.sameType(i, j) {
+			match = j
+		}
+
+		if match < 0 {
+			a.pairs = append(a.pairs, [2]int{i, -1})
+			continue
+		}
+
+		for ; j < match; j++ {
+			a.pairs = append(a.pairs, [2]int{-1, j})
+		}
+
+		a.pairs = append(a.pairs, [2]int{i, match})
+		j++
+	}
+
+	for ; j < l; j++ {
+		a.pairs = append(a.pairs, [2]int{-1, j})
+	}
+}
+
+// ratio returns the share of common lines of two texts, 1 if both are
+// empty.
+func ratio(a, b []string) float64 {
+	if len(a)+len(b) == 0 {
+		return 1
+	}
+
+	common := lcs(len(a), len(b), func(i, j int) bool {
+		return a[i] == b[j]
+	})
+
+	return 2 * float64(len(common)) / float64(len(a)+len(b))
+}
+
+// lcs returns the index pairs of a longest common subsequence of two
+// sequences of the given lengths.
+func lcs(n, m int, equal func(i, j int) bool) [][2]int {
+	lengths := make([][]int, n+1)
+
+	for i := range lengths {
+		lengths[i] = make([]int, m+1)
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case equal(i, j):
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	matches := [][2]int{}
+
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case equal(i, j):
+			matches = append(matches, [2]int{i, j})
+			i, j = i+1, j+1
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return matches
+}
+
+// marshal returns the JSON of a value, null if it can not be encoded.
+func marshal(v interface{}) json.RawMessage {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return json.RawMessage("null")
+	}
+
+	return b
+}
+
+// marshalOutput returns the JSON of an output without its execution count.
+func marshalOutput(o *notebook.Output) json.RawMessage {
+	c := *o
+	c.ExecutionCount = nil
+
+	return marshal(c)
+}
+
+// canonical returns a JSON document with sorted keys and without
+// whitespace, so equal values have equal documents.
+func canonical(raw json.RawMessage) string {
+	var v interface{}
+
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return string(raw)
+	}
+
+	return string(marshal(v))
+}
+
+// equalJSON returns true if both documents encode the same value.
+func equalJSON(a, b json.RawMessage) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+
+	var x, y interface{}
+
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,406 @@
+// Package diff compares notebooks cell by cell, so the changes between two
+// versions can be shown without comparing the JSON documents. Cells are
+// aligned by their ids and the similarity of their sources, sources are
+// compared line by line, outputs by MIME type and metadata as JSON.
+package diff
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/ansi"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+// Op defines the change of a cell, an output or a line.
+type Op string
+
+const (
+	// OpUnchanged defines an item which is the same in both versions.
+	OpUnchanged Op = "unchanged"
+
+	// OpAdded defines an item which only exists in the new version.
+	OpAdded Op = "added"
+
+	// OpRemoved defines an item which only exists in the old version.
+	OpRemoved Op = "removed"
+
+	// OpModified defines an item which exists in both versions with changes.
+	OpModified Op = "modified"
+)
+
+// Diff describes the changes between two versions of a notebook.
+type Diff struct {
+	// Metadata is the change of the notebook metadata, the paths are
+	// relative to the metadata.
+	Metadata []*Patch `json:"metadata,omitempty"`
+
+	// Cells are the changes of all cells in the order of both versions.
+	Cells []*Cell `json:"cells"`
+
+	old, new *notebook.Notebook
+}
+
+// Line is a line of a text diff, without its trailing newline.
+type Line struct {
+	Op   Op     `json:"op"`
+	Text string `json:"text"`
+}
+
+// Cell describes the change of a cell.
+type Cell struct {
+	Op Op `json:"op"`
+
+	// OldIndex and NewIndex are the positions of the cell in the old and in
+	// the new version, -1 if it does not exist in one of them.
+	OldIndex int `json:"old_index"`
+	NewIndex int `json:"new_index"`
+
+	// ID of the cell in the new version, or in the old one if removed.
+	ID       string            `json:"id,omitempty"`
+	CellType notebook.CellType `json:"cell_type"`
+
+	// Source is the line diff of the cell source.
+	Source []Line `json:"source"`
+
+	// Outputs are the changes of all outputs of a code cell with changed
+	// outputs.
+	Outputs []*Output `json:"outputs,omitempty"`
+
+	// Metadata is the change of the cell metadata, the paths are relative
+	// to the metadata.
+	Metadata []*Patch `json:"metadata,omitempty"`
+
+	// AttachmentsChanged is set if attachments of a markdown or raw cell
+	// were added, removed or replaced.
+	AttachmentsChanged bool `json:"attachments_changed,omitempty"`
+}
+
+// Output describes the change of an output of a code cell.
+type Output struct {
+	Op Op `json:"op"`
+
+	// OldIndex and NewIndex are the positions of the output in the cell.
+	OldIndex int `json:"old_index"`
+	NewIndex int `json:"new_index"`
+
+	OutputType notebook.OutputType `json:"output_type"`
+
+	// Text is the line diff of streams, and of the exception and traceback
+	// of errors.
+	Text []Line `json:"text,omitempty"`
+
+	// Data are the changes of the representations of display data and
+	// execution results by MIME type.
+	Data []*Data `json:"data,omitempty"`
+
+	// Metadata is the change of the output metadata.
+	Metadata []*Patch `json:"metadata,omitempty"`
+}
+
+// Data describes the change of the representation of an output in a MIME
+// type.
+type Data struct {
+	Op       Op     `json:"op"`
+	MimeType string `json:"mime_type"`
+
+	// Lines is the line diff of text based representations, it is empty
+	// for binary ones like images.
+	Lines []Line `json:"lines,omitempty"`
+}
+
+// Notebooks returns the changes from the old to the new version of a
+// notebook. Cells with the same id are matched first, then cells with the
+// same source and finally cells of the same type with similar sources.
+// Unmatched cells are added or removed.
+func Notebooks(old, new *notebook.Notebook) *Diff {
+	d := &Diff{
+		Metadata: Metadata(old.Metadata, new.Metadata),
+		Cells:    []*Cell{},
+		old:      old,
+		new:      new,
+	}
+
+	for _, pair := range alignCells(old.Cells, new.Cells) {
+		switch {
+		case pair[0] < 0:
+			c := new.Cells[pair[1]]
+			d.Cells = append(d.Cells, &Cell{
+				Op:       OpAdded,
+				OldIndex: -1,
+				NewIndex: pair[1],
+				ID:       c.ID,
+				CellType: c.CellType,
+				Source:   Lines(nil, sourceLines(c)),
+			})
+		case pair[1] < 0:
+			c := old.Cells[pair[0]]
+			d.Cells = append(d.Cells, &Cell{
+				Op:       OpRemoved,
+				OldIndex: pair[0],
+				NewIndex: -1,
+				ID:       c.ID,
+				CellType: c.CellType,
+				Source:   Lines(sourceLines(c), nil),
+			})
+		default:
+			d.Cells = append(d.Cells, compareCells(old.Cells[pair[0]], new.Cells[pair[1]], pair[0], pair[1]))
+		}
+	}
+
+	return d
+}
+
+// Changed returns true if any cell or the notebook metadata changed.
+func (d *Diff) Changed() bool {
+	if len(d.Metadata) > 0 {
+		return true
+	}
+
+	for _, c := range d.Cells {
+		if c.Op != OpUnchanged {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Lines returns the line diff of two texts split into lines.
+func Lines(old, new []string) []Line {
+	lines := []Line{}
+	i, j := 0, 0
+
+	flush := func(k, l int) {
+		for ; i < k; i++ {
+			lines = append(lines, Line{Op: OpRemoved, Text: strings.TrimSuffix(old[i], "\n")})
+		}
+
+		for ; j < l; j++ {
+			lines = append(lines, Line{Op: OpAdded, Text: strings.TrimSuffix(new[j], "\n")})
+		}
+	}
+
+	for _, match := range Matches(old, new) {
+		flush(match[0], match[1])
+		lines = append(lines, Line{Op: OpUnchanged, Text: strings.TrimSuffix(old[i], "\n")})
+		i, j = i+1, j+1
+	}
+
+	flush(len(old), len(new))
+
+	return lines
+}
+
+// Matches returns the index pairs of the common lines of two texts in order,
+// lines are compared without their trailing newline.
+func Matches(old, new []string) [][2]int {
+	return lcs(len(old), len(new), func(i, j int) bool {
+		return strings.TrimSuffix(old[i], "\n") == strings.TrimSuffix(new[j], "\n")
+	})
+}
+
+// Metadata returns the change of metadata as JSON Patch, the paths are
+// relative to the metadata.
+func Metadata(old, new notebook.Metadata) []*Patch {
+	return JSON("", marshal(old), marshal(new))
+}
+
+// compareCells returns the change of a cell which exists in both versions.
+// Execution counts are ignored.
+func compareCells(old, new *notebook.Cell, oldIndex, newIndex int) *Cell {
+	c := &Cell{
+		Op:                 OpUnchanged,
+		OldIndex:           oldIndex,
+		NewIndex:           newIndex,
+		ID:                 new.ID,
+		CellType:           new.CellType,
+		Source:             Lines(sourceLines(old), sourceLines(new)),
+		Metadata:           Metadata(old.Metadata, new.Metadata),
+		AttachmentsChanged: !equalJSON(marshal(old.Attachments), marshal(new.Attachments)),
+	}
+
+	if old.CellType != new.CellType || len(c.Metadata) > 0 || c.AttachmentsChanged || changedLines(c.Source) {
+		c.Op = OpModified
+	}
+
+	outputs := compareOutputs(old.Outputs, new.Outputs)
+
+	for _, o := range outputs {
+		if o.Op != OpUnchanged {
+			c.Op = OpModified
+			c.Outputs = outputs
+			break
+		}
+	}
+
+	return c
+}
+
+// compareOutputs returns the changes of the outputs of a cell.
+func compareOutputs(old, new []*notebook.Output) []*Output {
+	outputs := []*Output{}
+
+	for _, pair := range alignOutputs(old, new) {
+		switch {
+		case pair[0] < 0:
+			o := new[pair[1]]
+			outputs = append(outputs, &Output{
+				Op:         OpAdded,
+				OldIndex:   -1,
+				NewIndex:   pair[1],
+				OutputType: o.OutputType,
+				Text:       nilIfEmpty(Lines(nil, outputLines(o))),
+				Data:       compareData(notebook.MimeBundle{}, o.Data),
+			})
+		case pair[1] < 0:
+			o := old[pair[0]]
+			outputs = append(outputs, &Output{
+				Op:         OpRemoved,
+				OldIndex:   pair[0],
+				NewIndex:   -1,
+				OutputType: o.OutputType,
+				Text:       nilIfEmpty(Lines(outputLines(o), nil)),
+				Data:       compareData(o.Data, notebook.MimeBundle{}),
+			})
+		default:
+			outputs = append(outputs, compareOutput(old[pair[0]], new[pair[1]], pair[0], pair[1]))
+		}
+	}
+
+	return outputs
+}
+
+// compareOutput returns the change of an output which exists in both
+// versions.
+func compareOutput(old, new *notebook.Output, oldIndex, newIndex int) *Output {
+	o := &Output{
+		Op:         OpUnchanged,
+		OldIndex:   oldIndex,
+		NewIndex:   newIndex,
+		OutputType: new.OutputType,
+		Text:       nilIfEmpty(Lines(outputLines(old), outputLines(new))),
+		Data:       compareData(old.Data, new.Data),
+		Metadata:   Metadata(old.Metadata, new.Metadata),
+	}
+
+	if old.OutputType != new.OutputType || old.Name != new.Name || len(o.Metadata) > 0 || changedLines(o.Text) {
+		o.Op = OpModified
+	}
+
+	for _, d := range o.Data {
+		if d.Op != OpUnchanged {
+			o.Op = OpModified
+		}
+	}
+
+	return o
+}
+
+// compareData returns the changes of all MIME types of two bundles, in the
+// order of the new bundle followed by removed types.
+func compareData(old, new notebook.MimeBundle) []*Data {
+	var data []*Data
+
+	for _, mime := range new.Types() {
+		d := &Data{
+			Op:       OpAdded,
+			MimeType: mime,
+		}
+
+		if old.Has(mime) {
+			d.Op = OpUnchanged
+
+			if !equalJSON(rawData(old, mime), rawData(new, mime)) {
+				d.Op = OpModified
+			}
+		}
+
+		if textMime(mime) {
+			d.Lines = Lines(dataLines(old, mime), dataLines(new, mime))
+		}
+
+		data = append(data, d)
+	}
+
+	for _, mime := range old.Types() {
+		if new.Has(mime) {
+			continue
+		}
+
+		d := &Data{
+			Op:       OpRemoved,
+			MimeType: mime,
+		}
+
+		if textMime(mime) {
+			d.Lines = Lines(dataLines(old, mime), nil)
+		}
+
+		data = append(data, d)
+	}
+
+	return data
+}
+
+// changedLines returns true if any line was added or removed.
+func changedLines(lines []Line) bool {
+	for _, l := range lines {
+		if l.Op != OpUnchanged {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nilIfEmpty returns nil for an empty line diff, so it is omitted.
+func nilIfEmpty(lines []Line) []Line {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	return lines
+}
+
+// sourceLines returns the source of a cell split into lines.
+func sourceLines(c *notebook.Cell) []string {
+	return notebook.SplitLines(c.Source.String())
+}
+
+// outputLines returns the text of streams and errors split into lines.
+func outputLines(o *notebook.Output) []string {
+	switch o.OutputType {
+	case notebook.OutputTypeStream:
+		return notebook.SplitLines(o.Text.String())
+	case notebook.OutputTypeError:
+		lines := []string{o.EName + ": " + o.EValue}
+
+		for _, l := range o.Traceback {
+			lines = append(lines, notebook.SplitLines(ansi.Strip(l))...)
+		}
+
+		return lines
+	}
+
+	return nil
+}
+
+// dataLines returns a text based representation split into lines.
+func dataLines(b notebook.MimeBundle, mime string) []string {
+	text, _ := b.Text(mime)
+	return notebook.SplitLines(text)
+}
+
+// rawData returns the representation of a MIME type as JSON.
+func rawData(b notebook.MimeBundle, mime string) json.RawMessage {
+	raw, _ := b.Raw(mime)
+	return raw
+}
+
+// textMime returns true for MIME types which are compared line by line.
+func textMime(mime string) bool {
+	return strings.HasPrefix(mime, "text/") ||
+		strings.HasSuffix(mime, "+xml") ||
+		notebook.IsJSONMime(mime)
+}
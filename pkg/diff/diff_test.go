@@ -0,0 +1,198 @@
+package diff
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	oldNotebook = `{
+ "cells": [
+  {"cell_type": "markdown", "id": "intro", "metadata": {}, "source": ["# Title"]},
+  {"cell_type": "code", "id": "plot", "execution_count": 1, "metadata": {"tags": ["a"]},
+   "source": ["x = 1\n", "plot(x)"],
+   "outputs": [
+    {"output_type": "stream", "name": "stdout", "text": ["1\n"]},
+    {"output_type": "execute_result", "execution_count": 1, "metadata": {},
+     "data": {"text/plain": ["<Figure>"], "image/png": "aGVsbG8="}}
+   ]},
+  {"cell_type": "code", "id": "gone", "execution_count": 2, "metadata": {}, "source": ["removed()"], "outputs": []}
+ ],
+ "metadata": {"kernelspec": {"name": "python3", "display_name": "Python 3", "language": "python"}},
+ "nbformat": 4,
+ "nbformat_minor": 5
+}`
+
+	newNotebook = `{
+ "cells": [
+  {"cell_type": "markdown", "id": "intro", "metadata": {}, "source": ["# Title"]},
+  {"cell_type": "code", "id": "plot", "execution_count": 3, "metadata": {"tags": ["a", "b"], "collapsed": true},
+   "source": ["x = 2\n", "plot(x)"],
+   "outputs": [
+    {"output_type": "stream", "name": "stdout", "text": ["2\n"]},
+    {"output_type": "execute_result", "execution_count": 3, "metadata": {},
+     "data": {"text/plain": ["<Figure>"], "image/png": "d29ybGQ="}}
+   ]},
+  {"cell_type": "raw", "id": "new", "metadata": {}, "source": ["added"]}
+ ],
+ "metadata": {"kernelspec": {"name": "python3", "display_name": "Python 3.9", "language": "python"}},
+ "nbformat": 4,
+ "nbformat_minor": 5
+}`
+)
+
+func parse(t *testing.T, s string) *notebook.Notebook {
+	nb, err := notebook.Parse([]byte(s))
+	assert.Nil(t, err)
+
+	return nb
+}
+
+func TestLines(t *testing.T) {
+	lines := Lines(
+		[]string{"a\n", "b\n", "c"},
+		[]string{"a\n", "c\n", "d"},
+	)
+
+	assert.Equal(t, []Line{
+		{Op: OpUnchanged, Text: "a"},
+		{Op: OpRemoved, Text: "b"},
+		{Op: OpUnchanged, Text: "c"},
+		{Op: OpAdded, Text: "d"},
+	}, lines)
+
+	assert.Empty(t, Lines(nil, nil))
+}
+
+func TestNotebooks(t *testing.T) {
+	d := Notebooks(parse(t, oldNotebook), parse(t, newNotebook))
+	assert.True(t, d.Changed())
+
+	assert.Equal(t, []*Patch{
+		{Op: "replace", Path: "/kernelspec/display_name", Value: json.RawMessage(`"Python 3.9"`)},
+	}, d.Metadata)
+
+	if !assert.Len(t, d.Cells, 4) {
+		return
+	}
+
+	assert.Equal(t, OpUnchanged, d.Cells[0].Op)
+
+	c := d.Cells[1]
+	assert.Equal(t, OpModified, c.Op)
+	assert.Equal(t, "plot", c.ID)
+	assert.Equal(t, []Line{
+		{Op: OpRemoved, Text: "x = 1"},
+		{Op: OpAdded, Text: "x = 2"},
+		{Op: OpUnchanged, Text: "plot(x)"},
+	}, c.Source)
+	assert.Equal(t, []*Patch{
+		{Op: "add", Path: "/collapsed", Value: json.RawMessage(`true`)},
+		{Op: "replace", Path: "/tags", Value: json.RawMessage(`["a","b"]`)},
+	}, c.Metadata)
+
+	if assert.Len(t, c.Outputs, 2) {
+		assert.Equal(t, OpModified, c.Outputs[0].Op)
+		assert.Equal(t, []Line{
+			{Op: OpRemoved, Text: "1"},
+			{Op: OpAdded, Text: "2"},
+		}, c.Outputs[0].Text)
+
+		assert.Equal(t, OpModified, c.Outputs[1].Op)
+		assert.Equal(t, []*Data{
+			{Op: OpUnchanged, MimeType: "text/plain", Lines: []Line{{Op: OpUnchanged, Text: "<Figure>"}}},
+			{Op: OpModified, MimeType: "image/png"},
+		}, c.Outputs[1].Data)
+	}
+
+	assert.Equal(t, OpRemoved, d.Cells[2].Op)
+	assert.Equal(t, "gone", d.Cells[2].ID)
+	assert.Equal(t, -1, d.Cells[2].NewIndex)
+
+	assert.Equal(t, OpAdded, d.Cells[3].Op)
+	assert.Equal(t, -1, d.Cells[3].OldIndex)
+	assert.Equal(t, notebook.CellTypeRaw, d.Cells[3].CellType)
+
+	assert.False(t, Notebooks(parse(t, oldNotebook), parse(t, oldNotebook)).Changed())
+}
+
+func TestNotebooks_Alignment(t *testing.T) {
+	old := notebook.New()
+	old.Cells = []*notebook.Cell{
+		notebook.NewMarkdownCell("# Title"),
+		notebook.NewCodeCell("import os\nimport sys\nprint(os.name)"),
+		notebook.NewCodeCell("removed()"),
+		notebook.NewMarkdownCell("Done"),
+	}
+
+	new := notebook.New()
+	new.Cells = []*notebook.Cell{
+		notebook.NewMarkdownCell("# Title"),
+		notebook.NewCodeCell("inserted()"),
+		notebook.NewCodeCell("import os\nimport sys\nprint(sys.argv)"),
+		notebook.NewMarkdownCell("Done"),
+		notebook.NewMarkdownCell("Rewritten"),
+	}
+
+	ops := func(d *Diff) []Op {
+		var ops []Op
+
+		for _, c := range d.Cells {
+			ops = append(ops, c.Op)
+		}
+
+		return ops
+	}
+
+	d := Notebooks(old, new)
+	assert.Equal(t, []Op{OpUnchanged, OpAdded, OpModified, OpRemoved, OpUnchanged, OpAdded}, ops(d))
+	assert.Equal(t, 1, d.Cells[2].OldIndex)
+	assert.Equal(t, 2, d.Cells[2].NewIndex)
+
+	// Cells rewritten in place are paired without similar sources.
+	old.Cells[2] = notebook.NewCodeCell("a()")
+	new.Cells = append(new.Cells[:1], new.Cells[2:]...)
+	new.Cells = append(new.Cells[:2], append([]*notebook.Cell{notebook.NewCodeCell("b()")}, new.Cells[2:]...)...)
+
+	d = Notebooks(old, new)
+	assert.Equal(t, []Op{OpUnchanged, OpModified, OpModified, OpUnchanged, OpAdded}, ops(d))
+}
+
+func TestNotebooks_IDs(t *testing.T) {
+	old := notebook.New()
+	old.Cells = []*notebook.Cell{
+		notebook.NewCodeCell("a()"),
+		notebook.NewCodeCell("b()"),
+	}
+	old.Cells[0].ID = "first"
+	old.Cells[1].ID = "second"
+
+	new := notebook.New()
+	new.Cells = []*notebook.Cell{
+		notebook.NewCodeCell("b()"),
+		notebook.NewCodeCell("a()"),
+		notebook.NewCodeCell("b()"),
+	}
+	new.Cells[0].ID = "second"
+	new.Cells[1].ID = "first"
+	new.Cells[2].ID = "third"
+
+	d := Notebooks(old, new)
+
+	if assert.Len(t, d.Cells, 4) {
+		assert.Equal(t, OpRemoved, d.Cells[0].Op)
+		assert.Equal(t, "first", d.Cells[0].ID)
+
+		assert.Equal(t, OpUnchanged, d.Cells[1].Op)
+		assert.Equal(t, "second", d.Cells[1].ID)
+
+		assert.Equal(t, OpAdded, d.Cells[2].Op)
+		assert.Equal(t, "first", d.Cells[2].ID)
+
+		assert.Equal(t, OpAdded, d.Cells[3].Op)
+		assert.Equal(t, "third", d.Cells[3].ID)
+	}
+}
@@ -0,0 +1,158 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+// Patch is an operation of a JSON Patch as defined by RFC 6902.
+type Patch struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSON returns the operations which turn the old into the new document,
+// prefixed by path. Objects are compared member by member, all other values
+// are replaced as a whole.
+func JSON(path string, old, new json.RawMessage) []*Patch {
+	if equalJSON(old, new) {
+		return nil
+	}
+
+	var a, b map[string]json.RawMessage
+
+	if json.Unmarshal(old, &a) != nil || json.Unmarshal(new, &b) != nil || a == nil || b == nil {
+		return []*Patch{replace(path, new)}
+	}
+
+	keys := make([]string, 0, len(a)+len(b))
+
+	for key := range a {
+		keys = append(keys, key)
+	}
+
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	var ops []*Patch
+
+	for _, key := range keys {
+		x, inOld := a[key]
+		y, inNew := b[key]
+		p := path + "/" + escape(key)
+
+		switch {
+		case !inNew:
+			ops = append(ops, &Patch{Op: "remove", Path: p})
+		case !inOld:
+			ops = append(ops, &Patch{Op: "add", Path: p, Value: compact(y)})
+		default:
+			ops = append(ops, JSON(p, x, y)...)
+		}
+	}
+
+	return ops
+}
+
+// Patch returns the JSON Patch which turns the old into the new version of
+// the notebook. Unlike the diff it includes changed execution counts.
+func (d *Diff) Patch() []*Patch {
+	ops := JSON("", without(marshal(d.old), "cells"), without(marshal(d.new), "cells"))
+
+	// k is the index of the next cell in the patched document.
+	k := 0
+
+	for _, c := range d.Cells {
+		path := "/cells/" + strconv.Itoa(k)
+
+		switch c.Op {
+		case OpRemoved:
+			ops = append(ops, &Patch{Op: "remove", Path: path})
+			continue
+		case OpAdded:
+			ops = append(ops, &Patch{Op: "add", Path: path, Value: compact(marshal(d.new.Cells[c.NewIndex]))})
+		default:
+			ops = append(ops, cellPatch(path, d.old.Cells[c.OldIndex], d.new.Cells[c.NewIndex])...)
+		}
+
+		k++
+	}
+
+	return ops
+}
+
+// cellPatch returns the operations which turn the old into the new version
+// of a cell. Outputs of code cells are patched one by one.
+func cellPatch(path string, old, new *notebook.Cell) []*Patch {
+	if old.CellType != notebook.CellTypeCode || new.CellType != notebook.CellTypeCode {
+		return JSON(path, marshal(old), marshal(new))
+	}
+
+	ops := JSON(path, without(marshal(old), "outputs"), without(marshal(new), "outputs"))
+
+	// k is the index of the next output in the patched document.
+	k := 0
+
+	for _, pair := range alignOutputs(old.Outputs, new.Outputs) {
+		p := path + "/outputs/" + strconv.Itoa(k)
+
+		switch {
+		case pair[1] < 0:
+			ops = append(ops, &Patch{Op: "remove", Path: p})
+			continue
+		case pair[0] < 0:
+			ops = append(ops, &Patch{Op: "add", Path: p, Value: compact(marshal(new.Outputs[pair[1]]))})
+		default:
+			ops = append(ops, JSON(p, marshal(old.Outputs[pair[0]]), marshal(new.Outputs[pair[1]]))...)
+		}
+
+		k++
+	}
+
+	return ops
+}
+
+// replace returns the operation which replaces the value at path, the
+// whole document if the path is empty.
+func replace(path string, value json.RawMessage) *Patch {
+	return &Patch{Op: "replace", Path: path, Value: compact(value)}
+}
+
+// without returns an object document without a member.
+func without(raw json.RawMessage, key string) json.RawMessage {
+	var o map[string]json.RawMessage
+
+	if err := json.Unmarshal(raw, &o); err != nil || o == nil {
+		return raw
+	}
+
+	delete(o, key)
+	return marshal(o)
+}
+
+// compact removes insignificant whitespace of a JSON document.
+func compact(raw json.RawMessage) json.RawMessage {
+	buf := &bytes.Buffer{}
+
+	if err := json.Compact(buf, raw); err != nil {
+		return raw
+	}
+
+	return buf.Bytes()
+}
+
+// escape encodes a member name as reference token of a JSON Pointer.
+func escape(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
@@ -0,0 +1,157 @@
+package diff
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/stretchr/testify/assert"
+)
+
+// apply applies the add, remove and replace operations of a JSON Patch.
+func apply(t *testing.T, doc interface{}, ops []*Patch) interface{} {
+	for _, op := range ops {
+		var value interface{}
+
+		if op.Op != "remove" {
+			assert.Nil(t, json.Unmarshal(op.Value, &value))
+		}
+
+		if op.Path == "" {
+			doc = value
+			continue
+		}
+
+		tokens := strings.Split(op.Path, "/")[1:]
+		parent := doc
+
+		for _, token := range tokens[:len(tokens)-1] {
+			parent = child(t, parent, unescape(token))
+		}
+
+		key := unescape(tokens[len(tokens)-1])
+
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			if op.Op == "remove" {
+				delete(p, key)
+			} else {
+				p[key] = value
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			assert.Nil(t, err)
+
+			// Arrays are updated through their parent, which keeps the slice.
+			var updated []interface{}
+
+			switch op.Op {
+			case "add":
+				updated = append(append(append([]interface{}{}, p[:i]...), value), p[i:]...)
+			case "remove":
+				updated = append(append([]interface{}{}, p[:i]...), p[i+1:]...)
+			default:
+				p[i] = value
+				continue
+			}
+
+			doc = replaceAt(t, doc, tokens[:len(tokens)-1], updated)
+		default:
+			t.Fatalf("invalid path %s", op.Path)
+		}
+	}
+
+	return doc
+}
+
+// child returns the member or element of a value.
+func child(t *testing.T, v interface{}, token string) interface{} {
+	switch p := v.(type) {
+	case map[string]interface{}:
+		return p[token]
+	case []interface{}:
+		i, err := strconv.Atoi(token)
+		assert.Nil(t, err)
+
+		return p[i]
+	}
+
+	t.Fatalf("invalid token %s", token)
+	return nil
+}
+
+// replaceAt replaces the value at the path given as tokens.
+func replaceAt(t *testing.T, doc interface{}, tokens []string, value interface{}) interface{} {
+	if len(tokens) == 0 {
+		return value
+	}
+
+	parent := doc
+
+	for _, token := range tokens[:len(tokens)-1] {
+		parent = child(t, parent, unescape(token))
+	}
+
+	key := unescape(tokens[len(tokens)-1])
+
+	switch p := parent.(type) {
+	case map[string]interface{}:
+		p[key] = value
+	case []interface{}:
+		i, _ := strconv.Atoi(key)
+		p[i] = value
+	}
+
+	return doc
+}
+
+func unescape(token string) string {
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+}
+
+func decode(t *testing.T, nb *notebook.Notebook) interface{} {
+	b, err := nb.Marshal()
+	assert.Nil(t, err)
+
+	var v interface{}
+	assert.Nil(t, json.Unmarshal(b, &v))
+
+	return v
+}
+
+func TestDiff_Patch(t *testing.T) {
+	old, new := parse(t, oldNotebook), parse(t, newNotebook)
+
+	ops := Notebooks(old, new).Patch()
+	assert.Contains(t, ops, &Patch{Op: "replace", Path: "/cells/1/outputs/1/data/image~1png", Value: json.RawMessage(`"d29ybGQ="`)})
+	assert.Contains(t, ops, &Patch{Op: "replace", Path: "/cells/1/execution_count", Value: json.RawMessage(`3`)})
+	assert.Contains(t, ops, &Patch{Op: "remove", Path: "/cells/2"})
+
+	assert.Equal(t, decode(t, new), apply(t, decode(t, old), ops))
+
+	// Both directions, with cells changing their type.
+	new.Cells[0].CellType = notebook.CellTypeCode
+	new.Cells = append(new.Cells, notebook.NewMarkdownCell("end"))
+
+	assert.Equal(t, decode(t, new), apply(t, decode(t, old), Notebooks(old, new).Patch()))
+	assert.Equal(t, decode(t, old), apply(t, decode(t, new), Notebooks(new, old).Patch()))
+
+	assert.Empty(t, Notebooks(old, old).Patch())
+}
+
+func TestJSON(t *testing.T) {
+	ops := JSON("/metadata",
+		json.RawMessage(`{"a": 1, "b": {"c": [1, 2]}, "d/e": true}`),
+		json.RawMessage(`{"a": 1, "b": {"c": [1, 3]}, "f": null}`),
+	)
+
+	assert.Equal(t, []*Patch{
+		{Op: "replace", Path: "/metadata/b/c", Value: json.RawMessage(`[1,3]`)},
+		{Op: "remove", Path: "/metadata/d~1e"},
+		{Op: "add", Path: "/metadata/f", Value: json.RawMessage(`null`)},
+	}, ops)
+
+	assert.Nil(t, JSON("", json.RawMessage(`{"a": 1}`), json.RawMessage(`{ "a" : 1 }`)))
+}
@@ -0,0 +1,159 @@
+package diff
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// ANSI escape sequences of the colors of changes.
+const (
+	colorReset  = "\x1b[0m"
+	colorBold   = "\x1b[1m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+	colorCyan   = "\x1b[36m"
+)
+
+// textIndent indents the details of a change.
+const textIndent = "  "
+
+// TextOptions defines how a diff is written for terminals.
+type TextOptions struct {
+	// OldName and NewName label both versions in the header.
+	OldName string
+	NewName string
+
+	// Color highlights the changes with ANSI escape sequences.
+	Color bool
+}
+
+// WriteText writes the changes in a unified diff like format for terminals.
+// Unchanged cells are left out, changed cells are shown with their whole
+// source.
+func (d *Diff) WriteText(w io.Writer, opts TextOptions) error {
+	t := &textWriter{
+		w:     bufio.NewWriter(w),
+		color: opts.Color,
+	}
+
+	t.line(colorBold, "", "--- "+opts.OldName)
+	t.line(colorBold, "", "+++ "+opts.NewName)
+
+	if len(d.Metadata) > 0 {
+		t.line(colorCyan, "", "@@ notebook metadata")
+		t.patches(textIndent, d.Metadata)
+	}
+
+	for _, c := range d.Cells {
+		if c.Op == OpUnchanged {
+			continue
+		}
+
+		t.line(colorCyan, "", "@@ "+cellHeader(c))
+		t.lines(textIndent, c.Source)
+
+		if c.AttachmentsChanged {
+			t.line(colorYellow, textIndent, "~ attachments")
+		}
+
+		if len(c.Metadata) > 0 {
+			t.line(colorYellow, textIndent, "~ metadata")
+			t.patches(textIndent+textIndent, c.Metadata)
+		}
+
+		for _, o := range c.Outputs {
+			if o.Op != OpUnchanged {
+				t.output(o)
+			}
+		}
+	}
+
+	return t.w.Flush()
+}
+
+// textWriter writes the lines of a diff, errors are reported on flush.
+type textWriter struct {
+	w     *bufio.Writer
+	color bool
+}
+
+// output writes the change of an output.
+func (t *textWriter) output(o *Output) {
+	index := o.NewIndex
+
+	if o.Op == OpRemoved {
+		index = o.OldIndex
+	}
+
+	t.line(colorYellow, textIndent, fmt.Sprintf("~ output %d %s %s", index+1, o.OutputType, o.Op))
+	t.lines(textIndent+textIndent, o.Text)
+
+	for _, d := range o.Data {
+		if d.Op == OpUnchanged {
+			continue
+		}
+
+		t.line(colorYellow, textIndent+textIndent, fmt.Sprintf("~ %s %s", d.MimeType, d.Op))
+		t.lines(textIndent+textIndent+textIndent, d.Lines)
+	}
+
+	if len(o.Metadata) > 0 {
+		t.line(colorYellow, textIndent+textIndent, "~ metadata")
+		t.patches(textIndent+textIndent+textIndent, o.Metadata)
+	}
+}
+
+// lines writes a line diff.
+func (t *textWriter) lines(indent string, lines []Line) {
+	for _, l := range lines {
+		switch l.Op {
+		case OpAdded:
+			t.line(colorGreen, indent, "+ "+l.Text)
+		case OpRemoved:
+			t.line(colorRed, indent, "- "+l.Text)
+		default:
+			t.line("", indent, "  "+l.Text)
+		}
+	}
+}
+
+// patches writes JSON Patch operations.
+func (t *textWriter) patches(indent string, ops []*Patch) {
+	for _, op := range ops {
+		switch op.Op {
+		case "add":
+			t.line(colorGreen, indent, "+ "+op.Path+" "+string(op.Value))
+		case "remove":
+			t.line(colorRed, indent, "- "+op.Path)
+		default:
+			t.line(colorYellow, indent, "~ "+op.Path+" "+string(op.Value))
+		}
+	}
+}
+
+// line writes a line in the given color.
+func (t *textWriter) line(color, indent, text string) {
+	if t.color && color != "" {
+		text = color + text + colorReset
+	}
+
+	_, _ = t.w.WriteString(indent + text + "\n")
+}
+
+// cellHeader describes a changed cell, positions are counted from 1.
+func cellHeader(c *Cell) string {
+	switch c.Op {
+	case OpAdded:
+		return fmt.Sprintf("cell %d %s added", c.NewIndex+1, c.CellType)
+	case OpRemoved:
+		return fmt.Sprintf("cell %d %s removed", c.OldIndex+1, c.CellType)
+	}
+
+	if c.OldIndex != c.NewIndex {
+		return fmt.Sprintf("cell %d %s modified, was cell %d", c.NewIndex+1, c.CellType, c.OldIndex+1)
+	}
+
+	return fmt.Sprintf("cell %d %s modified", c.NewIndex+1, c.CellType)
+}
@@ -0,0 +1,44 @@
+package diff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff_WriteText(t *testing.T) {
+	d := Notebooks(parse(t, oldNotebook), parse(t, newNotebook))
+
+	buf := &bytes.Buffer{}
+	assert.Nil(t, d.WriteText(buf, TextOptions{OldName: "a.ipynb", NewName: "b.ipynb"}))
+
+	assert.Equal(t, `--- a.ipynb
++++ b.ipynb
+@@ notebook metadata
+  ~ /kernelspec/display_name "Python 3.9"
+@@ cell 2 code modified
+  - x = 1
+  + x = 2
+    plot(x)
+  ~ metadata
+    + /collapsed true
+    ~ /tags ["a","b"]
+  ~ output 1 stream modified
+    - 1
+    + 2
+  ~ output 2 execute_result modified
+    ~ image/png modified
+@@ cell 3 code removed
+  - removed()
+@@ cell 3 raw added
+  + added
+`, buf.String())
+
+	buf.Reset()
+	assert.Nil(t, d.WriteText(buf, TextOptions{Color: true}))
+	assert.True(t, strings.HasPrefix(buf.String(), colorBold+"--- "+colorReset+"\n"))
+	assert.Contains(t, buf.String(), "  "+colorRed+"- x = 1"+colorReset+"\n")
+	assert.Contains(t, buf.String(), "  "+colorGreen+"+ x = 2"+colorReset+"\n")
+}
@@ -0,0 +1,80 @@
+// Package events subscribes to storage events of notebooks, so data derived
+// from them like rendered pages gets invalidated or rebuilt in the
+// background when a notebook changes.
+package events
+
+import (
+	"context"
+	"path"
+	"strings"
+)
+
+// Type defines the kind of change of a file.
+type Type string
+
+const (
+	// Created defines the event of a new file.
+	Created Type = "created"
+
+	// Modified defines the event of a file which has been written.
+	Modified Type = "modified"
+
+	// Moved defines the event of a file which has been moved or renamed.
+	Moved Type = "moved"
+
+	// Deleted defines the event of a file which has been removed.
+	Deleted Type = "deleted"
+)
+
+// Event describes the change of a file. It is published as JSON, the type
+// is given by the topic it is published to.
+type Event struct {
+	Type Type `json:"-"`
+
+	// Path of the file, the new one for moved files.
+	Path string `json:"path"`
+
+	// ResourceID is the CS3 resource id of the file, if known.
+	ResourceID string `json:"resource_id,omitempty"`
+
+	// OldPath of a moved file.
+	OldPath string `json:"old_path,omitempty"`
+
+	// ETag of the file after the change, empty for deleted files.
+	ETag string `json:"etag,omitempty"`
+}
+
+// Paths returns all paths the event is about, the old one of moved files
+// included.
+func (e *Event) Paths() []string {
+	if e.OldPath == "" || e.OldPath == e.Path {
+		return []string{e.Path}
+	}
+
+	return []string{e.Path, e.OldPath}
+}
+
+// IsNotebook returns true if the event is about a notebook, a file renamed
+// from or to .ipynb is one as well.
+func (e *Event) IsNotebook() bool {
+	for _, p := range e.Paths() {
+		if strings.EqualFold(path.Ext(p), ".ipynb") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Handler processes events of notebooks.
+type Handler interface {
+	Handle(ctx context.Context, e *Event) error
+}
+
+// HandlerFunc is an adapter to use functions as handlers.
+type HandlerFunc func(ctx context.Context, e *Event) error
+
+// Handle calls f(ctx, e).
+func (f HandlerFunc) Handle(ctx context.Context, e *Event) error {
+	return f(ctx, e)
+}
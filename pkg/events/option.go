@@ -0,0 +1,98 @@
+package events
+
+import (
+	"context"
+
+	"github.com/owncloud/ocis/ocis-pkg/log"
+)
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	Logger  log.Logger
+	Context context.Context
+
+	// Topics are the broker topics subscribed to by event type.
+	Topics map[Type]string
+
+	// Queue is the name of the queue the subscriptions share, so each
+	// event is only handled by one of several instances of the service.
+	Queue string
+
+	// Workers is the number of events handled at the same time.
+	Workers int
+
+	// Handlers are called with every event of a notebook.
+	Handlers []Handler
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		Context: context.Background(),
+		Topics: map[Type]string{
+			Created:  "io.owncloud.storage.file.created",
+			Modified: "io.owncloud.storage.file.modified",
+			Moved:    "io.owncloud.storage.file.moved",
+			Deleted:  "io.owncloud.storage.file.deleted",
+		},
+		Workers: 1,
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// Logger provides a function to set the logger option.
+func Logger(val log.Logger) Option {
+	return func(o *Options) {
+		o.Logger = val
+	}
+}
+
+// Context provides a function to set the context option, handlers are
+// called with it.
+func Context(val context.Context) Option {
+	return func(o *Options) {
+		if val != nil {
+			o.Context = val
+		}
+	}
+}
+
+// Topic provides a function to set the topic of an event type. An empty
+// topic disables the events of the type.
+func Topic(t Type, val string) Option {
+	return func(o *Options) {
+		o.Topics[t] = val
+	}
+}
+
+// Queue provides a function to set the queue option.
+func Queue(val string) Option {
+	return func(o *Options) {
+		o.Queue = val
+	}
+}
+
+// Workers provides a function to set the workers option, values below 1
+// are ignored.
+func Workers(val int) Option {
+	return func(o *Options) {
+		if val > 0 {
+			o.Workers = val
+		}
+	}
+}
+
+// Handlers provides a function to add handlers.
+func Handlers(val ...Handler) Option {
+	return func(o *Options) {
+		o.Handlers = append(o.Handlers, val...)
+	}
+}
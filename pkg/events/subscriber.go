@@ -0,0 +1,190 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/micro/go-micro/v2/broker"
+)
+
+var (
+	// ErrStarted defines the error if a subscriber is started twice.
+	ErrStarted = errors.New("events subscriber already started")
+)
+
+// Subscriber receives the events of notebooks from a broker and hands them
+// to the handlers in the background, so publishers are not blocked by slow
+// handlers. Events are dropped while the queue is full.
+type Subscriber struct {
+	broker  broker.Broker
+	options Options
+
+	mu            sync.Mutex
+	subscriptions []broker.Subscriber
+	queue         chan *Event
+	cancel        context.CancelFunc
+	workers       *sync.WaitGroup
+}
+
+// NewSubscriber returns a subscriber of the given broker.
+func NewSubscriber(b broker.Broker, opts ...Option) *Subscriber {
+	return &Subscriber{
+		broker:  b,
+		options: newOptions(opts...),
+	}
+}
+
+// Start subscribes to the topics and starts the workers. The broker has to
+// be connected. Without handlers nothing is subscribed.
+func (s *Subscriber) Start() error {
+	s.mu.Lock()
+
+	if s.queue != nil {
+		s.mu.Unlock()
+		return ErrStarted
+	}
+
+	if len(s.options.Handlers) == 0 {
+		s.mu.Unlock()
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(s.options.Context)
+	s.queue = make(chan *Event, 16*s.options.Workers)
+	s.cancel = cancel
+	s.workers = &sync.WaitGroup{}
+
+	for i := 0; i < s.options.Workers; i++ {
+		s.workers.Add(1)
+		go s.work(ctx, s.queue, s.workers)
+	}
+
+	var opts []broker.SubscribeOption
+
+	if s.options.Queue != "" {
+		opts = append(opts, broker.Queue(s.options.Queue))
+	}
+
+	for _, t := range []Type{Created, Modified, Moved, Deleted} {
+		topic := s.options.Topics[t]
+
+		if topic == "" {
+			continue
+		}
+
+		sub, err := s.broker.Subscribe(topic, s.receive(t), opts...)
+
+		if err != nil {
+			stop := s.detach()
+			s.mu.Unlock()
+
+			_ = stop()
+			return fmt.Errorf("failed to subscribe to %s: %w", topic, err)
+		}
+
+		s.subscriptions = append(s.subscriptions, sub)
+	}
+
+	s.mu.Unlock()
+	return nil
+}
+
+// Stop unsubscribes from all topics, cancels the context of the handlers
+// and waits until the queued events have been handed to them.
+func (s *Subscriber) Stop() error {
+	s.mu.Lock()
+	stop := s.detach()
+	s.mu.Unlock()
+
+	return stop()
+}
+
+// detach resets the subscriber with the lock held and returns the function
+// stopping what was running. It has to be called without the lock, brokers
+// may wait for running handlers to unsubscribe and those take the lock.
+func (s *Subscriber) detach() func() error {
+	subscriptions, queue, cancel, workers := s.subscriptions, s.queue, s.cancel, s.workers
+	s.subscriptions, s.queue, s.cancel, s.workers = nil, nil, nil, nil
+
+	return func() error {
+		var err error
+
+		for _, sub := range subscriptions {
+			if e := sub.Unsubscribe(); e != nil && err == nil {
+				err = e
+			}
+		}
+
+		if queue != nil {
+			cancel()
+			close(queue)
+			workers.Wait()
+		}
+
+		return err
+	}
+}
+
+// receive returns the broker handler of the events of a type. Events of
+// other files than notebooks are dropped, so are malformed ones as they
+// would fail again if redelivered.
+func (s *Subscriber) receive(t Type) broker.Handler {
+	return func(p broker.Event) error {
+		e := &Event{}
+
+		if err := json.Unmarshal(p.Message().Body, e); err != nil {
+			s.options.Logger.Warn().
+				Err(err).
+				Str("topic", p.Topic()).
+				Msg("Dropping malformed storage event")
+
+			return nil
+		}
+
+		e.Type = t
+
+		if !e.IsNotebook() {
+			return nil
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if s.queue == nil {
+			return nil
+		}
+
+		// The lock is held so the queue is not closed meanwhile, the send
+		// must not block.
+		select {
+		case s.queue <- e:
+		default:
+			s.options.Logger.Warn().
+				Str("type", string(e.Type)).
+				Str("path", e.Path).
+				Msg("Dropping storage event, the queue is full")
+		}
+
+		return nil
+	}
+}
+
+// work hands queued events to the handlers until the queue is closed.
+func (s *Subscriber) work(ctx context.Context, queue <-chan *Event, workers *sync.WaitGroup) {
+	defer workers.Done()
+
+	for e := range queue {
+		for _, h := range s.options.Handlers {
+			if err := h.Handle(ctx, e); err != nil {
+				s.options.Logger.Error().
+					Err(err).
+					Str("type", string(e.Type)).
+					Str("path", e.Path).
+					Msg("Failed to handle storage event")
+			}
+		}
+	}
+}
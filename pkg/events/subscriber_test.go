@@ -0,0 +1,259 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/micro/go-micro/v2/broker"
+	"github.com/micro/go-micro/v2/broker/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder collects the handled events.
+type recorder struct {
+	mu     sync.Mutex
+	events []*Event
+}
+
+func (r *recorder) Handle(ctx context.Context, e *Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, e)
+	return nil
+}
+
+func newBroker(t *testing.T) broker.Broker {
+	b := memory.NewBroker()
+	assert.Nil(t, b.Connect())
+	return b
+}
+
+func publish(t *testing.T, b broker.Broker, topic, body string) {
+	assert.Nil(t, b.Publish(topic, &broker.Message{
+		Header: map[string]string{"Content-Type": "application/json"},
+		Body:   []byte(body),
+	}))
+}
+
+func TestSubscriber(t *testing.T) {
+	b := newBroker(t)
+	defer b.Disconnect()
+
+	rec := &recorder{}
+
+	s := NewSubscriber(b,
+		Topic(Modified, "test.modified"),
+		Topic(Deleted, ""),
+		Workers(2),
+		Handlers(rec),
+	)
+
+	assert.Nil(t, s.Start())
+	assert.Equal(t, ErrStarted, s.Start())
+
+	publish(t, b, "io.owncloud.storage.file.created", `{"path": "/home/analysis.ipynb", "etag": "1"}`)
+	publish(t, b, "test.modified", `{"path": "/home/Analysis.IPYNB", "resource_id": "storage:opaque"}`)
+	publish(t, b, "io.owncloud.storage.file.moved", `{"path": "/home/report.txt", "old_path": "/home/report.ipynb"}`)
+	publish(t, b, "io.owncloud.storage.file.modified", `{"path": "/home/ignored.ipynb"}`)
+	publish(t, b, "io.owncloud.storage.file.deleted", `{"path": "/home/ignored.ipynb"}`)
+	publish(t, b, "test.modified", `{"path": "/home/data.csv"}`)
+	publish(t, b, "test.modified", `not json`)
+
+	assert.Nil(t, s.Stop())
+
+	publish(t, b, "test.modified", `{"path": "/home/stopped.ipynb"}`)
+
+	byType := map[Type]*Event{}
+
+	for _, e := range rec.events {
+		byType[e.Type] = e
+	}
+
+	assert.Len(t, rec.events, 3)
+	assert.Equal(t, &Event{Type: Created, Path: "/home/analysis.ipynb", ETag: "1"}, byType[Created])
+	assert.Equal(t, &Event{Type: Modified, Path: "/home/Analysis.IPYNB", ResourceID: "storage:opaque"}, byType[Modified])
+	assert.Equal(t, &Event{Type: Moved, Path: "/home/report.txt", OldPath: "/home/report.ipynb"}, byType[Moved])
+}
+
+// blocker blocks the handling of events until it is released.
+type blocker struct {
+	recorder
+
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+}
+
+func (b *blocker) Handle(ctx context.Context, e *Event) error {
+	b.once.Do(func() {
+		close(b.started)
+	})
+
+	<-b.release
+	return b.recorder.Handle(ctx, e)
+}
+
+func TestSubscriber_FullQueue(t *testing.T) {
+	b := newBroker(t)
+	defer b.Disconnect()
+
+	block := &blocker{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+
+	s := NewSubscriber(b,
+		Topic(Modified, "test.modified"),
+		Workers(1),
+		Handlers(block),
+	)
+
+	assert.Nil(t, s.Start())
+
+	publish(t, b, "test.modified", `{"path": "/home/first.ipynb"}`)
+	<-block.started
+
+	// The worker is blocked, 16 events fit into the queue and the others
+	// are dropped without blocking the publisher.
+	for i := 0; i < 20; i++ {
+		publish(t, b, "test.modified", `{"path": "/home/analysis.ipynb"}`)
+	}
+
+	close(block.release)
+	assert.Nil(t, s.Stop())
+	assert.Len(t, block.events, 17)
+}
+
+// waiting blocks every event until its context is cancelled.
+type waiting struct {
+	started chan struct{}
+	once    sync.Once
+}
+
+func (w *waiting) Handle(ctx context.Context, e *Event) error {
+	w.once.Do(func() {
+		close(w.started)
+	})
+
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestSubscriber_StopCancels(t *testing.T) {
+	b := newBroker(t)
+	defer b.Disconnect()
+
+	wait := &waiting{
+		started: make(chan struct{}),
+	}
+
+	s := NewSubscriber(b,
+		Topic(Modified, "test.modified"),
+		Workers(1),
+		Handlers(wait),
+	)
+
+	assert.Nil(t, s.Start())
+
+	publish(t, b, "test.modified", `{"path": "/home/analysis.ipynb"}`)
+	<-wait.started
+
+	// The running handler is cancelled, otherwise Stop would not return.
+	assert.Nil(t, s.Stop())
+}
+
+// lateBroker delivers one more event while a subscription is unsubscribed,
+// like brokers which wait for the running handlers do.
+type lateBroker struct {
+	broker.Broker
+}
+
+func (b *lateBroker) Subscribe(topic string, h broker.Handler, opts ...broker.SubscribeOption) (broker.Subscriber, error) {
+	sub, err := b.Broker.Subscribe(topic, h, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &lateSubscriber{Subscriber: sub, handler: h}, nil
+}
+
+type lateSubscriber struct {
+	broker.Subscriber
+
+	handler broker.Handler
+}
+
+func (s *lateSubscriber) Unsubscribe() error {
+	done := make(chan error)
+
+	go func() {
+		done <- s.handler(&lateEvent{topic: s.Topic()})
+	}()
+
+	if err := <-done; err != nil {
+		return err
+	}
+
+	return s.Subscriber.Unsubscribe()
+}
+
+// lateEvent is an event of a notebook delivered during unsubscribing.
+type lateEvent struct {
+	topic string
+}
+
+func (e *lateEvent) Topic() string {
+	return e.topic
+}
+
+func (e *lateEvent) Message() *broker.Message {
+	return &broker.Message{Body: []byte(`{"path": "/home/late.ipynb"}`)}
+}
+
+func (e *lateEvent) Ack() error {
+	return nil
+}
+
+func (e *lateEvent) Error() error {
+	return nil
+}
+
+func TestSubscriber_StopWhileReceiving(t *testing.T) {
+	b := newBroker(t)
+	defer b.Disconnect()
+
+	rec := &recorder{}
+
+	s := NewSubscriber(&lateBroker{Broker: b},
+		Topic(Modified, "test.modified"),
+		Workers(1),
+		Handlers(rec),
+	)
+
+	assert.Nil(t, s.Start())
+
+	publish(t, b, "test.modified", `{"path": "/home/analysis.ipynb"}`)
+
+	// The late event is dropped as the subscriber is stopping.
+	assert.Nil(t, s.Stop())
+
+	if assert.Len(t, rec.events, 1) {
+		assert.Equal(t, "/home/analysis.ipynb", rec.events[0].Path)
+	}
+}
+
+func TestSubscriber_NotConnected(t *testing.T) {
+	s := NewSubscriber(memory.NewBroker(), Handlers(&recorder{}))
+
+	assert.NotNil(t, s.Start())
+	assert.Nil(t, s.Stop())
+}
+
+func TestEvent_Paths(t *testing.T) {
+	assert.Equal(t, []string{"/a.ipynb"}, (&Event{Path: "/a.ipynb"}).Paths())
+	assert.Equal(t, []string{"/a.ipynb"}, (&Event{Path: "/a.ipynb", OldPath: "/a.ipynb"}).Paths())
+	assert.Equal(t, []string{"/b.ipynb", "/a.ipynb"}, (&Event{Path: "/b.ipynb", OldPath: "/a.ipynb"}).Paths())
+}
@@ -148,6 +148,96 @@ func ServerWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"OCIS_JUPYTER_GRPC_ADDR"},
 			Destination: &cfg.GRPC.Addr,
 		},
+		&cli.StringFlag{
+			Name:        "reva-gateway-addr",
+			Value:       "127.0.0.1:9142",
+			Usage:       "Address of the reva gateway to read notebooks from, stored notebooks are not available if empty",
+			EnvVars:     []string{"OCIS_JUPYTER_REVA_GATEWAY_ADDR", "REVA_GATEWAY_ADDR"},
+			Destination: &cfg.Reva.Address,
+		},
+		&cli.StringFlag{
+			Name:        "storage-driver",
+			Value:       "cs3",
+			Usage:       "Storage driver to read notebooks with, one of cs3, webdav, local or memory",
+			EnvVars:     []string{"OCIS_JUPYTER_STORAGE_DRIVER"},
+			Destination: &cfg.Storage.Driver,
+		},
+		&cli.StringFlag{
+			Name:        "storage-webdav-url",
+			Value:       "https://localhost:9200/remote.php/dav/files",
+			Usage:       "WebDAV endpoint of the webdav storage driver, paths are relative to it",
+			EnvVars:     []string{"OCIS_JUPYTER_STORAGE_WEBDAV_URL"},
+			Destination: &cfg.Storage.WebDAV.URL,
+		},
+		&cli.BoolFlag{
+			Name:        "storage-webdav-insecure",
+			Usage:       "Skip the certificate verification of the WebDAV endpoint",
+			EnvVars:     []string{"OCIS_JUPYTER_STORAGE_WEBDAV_INSECURE"},
+			Destination: &cfg.Storage.WebDAV.Insecure,
+		},
+		&cli.StringFlag{
+			Name:        "storage-local-root",
+			Value:       "/var/tmp/ocis/jupyter/notebooks",
+			Usage:       "Root directory of the local storage driver, paths are relative to it",
+			EnvVars:     []string{"OCIS_JUPYTER_STORAGE_LOCAL_ROOT"},
+			Destination: &cfg.Storage.Local.Root,
+		},
+		&cli.StringFlag{
+			Name:        "checkpoints-folder",
+			Value:       ".ipynb_checkpoints",
+			Usage:       "Folder for checkpoints, next to the file or, if absolute, one for all files",
+			EnvVars:     []string{"OCIS_JUPYTER_CHECKPOINTS_FOLDER"},
+			Destination: &cfg.Checkpoints.Folder,
+		},
+		&cli.IntFlag{
+			Name:        "checkpoints-retention",
+			Value:       5,
+			Usage:       "Number of checkpoints kept per file, older ones are removed, 0 keeps all",
+			EnvVars:     []string{"OCIS_JUPYTER_CHECKPOINTS_RETENTION"},
+			Destination: &cfg.Checkpoints.Retention,
+		},
+		&cli.StringFlag{
+			Name:        "events-topic-created",
+			Value:       "io.owncloud.storage.file.created",
+			Usage:       "Broker topic of created files, disabled if empty",
+			EnvVars:     []string{"OCIS_JUPYTER_EVENTS_TOPIC_CREATED"},
+			Destination: &cfg.Events.Topics.Created,
+		},
+		&cli.StringFlag{
+			Name:        "events-topic-modified",
+			Value:       "io.owncloud.storage.file.modified",
+			Usage:       "Broker topic of modified files, disabled if empty",
+			EnvVars:     []string{"OCIS_JUPYTER_EVENTS_TOPIC_MODIFIED"},
+			Destination: &cfg.Events.Topics.Modified,
+		},
+		&cli.StringFlag{
+			Name:        "events-topic-moved",
+			Value:       "io.owncloud.storage.file.moved",
+			Usage:       "Broker topic of moved files, disabled if empty",
+			EnvVars:     []string{"OCIS_JUPYTER_EVENTS_TOPIC_MOVED"},
+			Destination: &cfg.Events.Topics.Moved,
+		},
+		&cli.StringFlag{
+			Name:        "events-topic-deleted",
+			Value:       "io.owncloud.storage.file.deleted",
+			Usage:       "Broker topic of deleted files, disabled if empty",
+			EnvVars:     []string{"OCIS_JUPYTER_EVENTS_TOPIC_DELETED"},
+			Destination: &cfg.Events.Topics.Deleted,
+		},
+		&cli.StringFlag{
+			Name:        "events-queue",
+			Value:       "ocis-jupyter",
+			Usage:       "Queue shared by all instances, so each event is handled once",
+			EnvVars:     []string{"OCIS_JUPYTER_EVENTS_QUEUE"},
+			Destination: &cfg.Events.Queue,
+		},
+		&cli.IntFlag{
+			Name:        "events-workers",
+			Value:       2,
+			Usage:       "Number of events handled at the same time",
+			EnvVars:     []string{"OCIS_JUPYTER_EVENTS_WORKERS"},
+			Destination: &cfg.Events.Workers,
+		},
 		&cli.StringFlag{
 			Name:        "asset-path",
 			Value:       "",
@@ -155,6 +245,85 @@ func ServerWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"OCIS_JUPYTER_ASSET_PATH"},
 			Destination: &cfg.Asset.Path,
 		},
+		&cli.BoolFlag{
+			Name:        "render-disable-sanitizer",
+			Usage:       "Render untrusted HTML, SVG and JavaScript outputs without sanitizing them",
+			EnvVars:     []string{"OCIS_JUPYTER_RENDER_DISABLE_SANITIZER"},
+			Destination: &cfg.Render.DisableSanitizer,
+		},
+		&cli.StringSliceFlag{
+			Name:    "render-url-schemes",
+			Value:   cli.NewStringSlice(),
+			Usage:   "URL schemes allowed in untrusted content besides http, https and mailto",
+			EnvVars: []string{"OCIS_JUPYTER_RENDER_URL_SCHEMES"},
+		},
+		&cli.BoolFlag{
+			Name:        "render-allow-styles",
+			Value:       true,
+			Usage:       "Keep inline styles of untrusted HTML outputs",
+			EnvVars:     []string{"OCIS_JUPYTER_RENDER_ALLOW_STYLES"},
+			Destination: &cfg.Render.AllowStyles,
+		},
+		&cli.StringSliceFlag{
+			Name:    "render-mime-priority",
+			Value:   cli.NewStringSlice(),
+			Usage:   "MIME types of outputs in the order they are preferred, all registered types if empty",
+			EnvVars: []string{"OCIS_JUPYTER_RENDER_MIME_PRIORITY"},
+		},
+		&cli.StringSliceFlag{
+			Name:    "render-disabled-mime-types",
+			Value:   cli.NewStringSlice(),
+			Usage:   "MIME types of outputs which are never displayed",
+			EnvVars: []string{"OCIS_JUPYTER_RENDER_DISABLED_MIME_TYPES"},
+		},
+		&cli.StringFlag{
+			Name:        "render-theme",
+			Value:       "pygments",
+			Usage:       "Highlighting theme of code cells, unless requested otherwise or set by the user",
+			EnvVars:     []string{"OCIS_JUPYTER_RENDER_THEME"},
+			Destination: &cfg.Render.Theme,
+		},
+		&cli.IntFlag{
+			Name:        "render-cache-size",
+			Value:       100,
+			Usage:       "Number of rendered notebooks kept in memory, 0 disables the cache",
+			EnvVars:     []string{"OCIS_JUPYTER_RENDER_CACHE_SIZE"},
+			Destination: &cfg.Render.CacheSize,
+		},
+		&cli.StringFlag{
+			Name:        "trust-database",
+			Value:       "/var/tmp/ocis/jupyter/signatures.json",
+			Usage:       "Path to the signature database of trusted notebooks, in memory only if empty",
+			EnvVars:     []string{"OCIS_JUPYTER_TRUST_DATABASE"},
+			Destination: &cfg.Trust.Database,
+		},
+		&cli.StringSliceFlag{
+			Name:    "kernels-paths",
+			Value:   cli.NewStringSlice(),
+			Usage:   "Directories with kernel specs searched before the Jupyter data directories",
+			EnvVars: []string{"OCIS_JUPYTER_KERNELS_PATHS"},
+		},
+		&cli.StringFlag{
+			Name:        "kernels-default",
+			Value:       "python3",
+			Usage:       "Kernel of new notebooks, the first allowed kernel if it is not available",
+			EnvVars:     []string{"OCIS_JUPYTER_KERNELS_DEFAULT"},
+			Destination: &cfg.Kernels.Default,
+		},
+		&cli.StringFlag{
+			Name:        "kernels-runtime-dir",
+			Value:       "/var/tmp/ocis/jupyter/runtime",
+			Usage:       "Directory the connection files of running kernels are written to",
+			EnvVars:     []string{"OCIS_JUPYTER_KERNELS_RUNTIME_DIR"},
+			Destination: &cfg.Kernels.RuntimeDir,
+		},
+		&cli.StringFlag{
+			Name:        "kernels-system-account",
+			Value:       "",
+			Usage:       "Account the allowed kernels are stored for in the settings, the id of the kernels bundle if empty",
+			EnvVars:     []string{"OCIS_JUPYTER_KERNELS_SYSTEM_ACCOUNT"},
+			Destination: &cfg.Kernels.SystemAccount,
+		},
 		&cli.StringFlag{
 			Name:        "jwt-secret",
 			Value:       "Pive-Fumkiu4",
@@ -162,5 +331,70 @@ func ServerWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"OCIS_JUPYTER_JWT_SECRET"},
 			Destination: &cfg.TokenManager.JWTSecret,
 		},
+		&cli.StringFlag{
+			Name:        "trust-secret",
+			Value:       "",
+			Usage:       "Used to sign trusted notebooks, notebooks are never trusted if empty",
+			EnvVars:     []string{"OCIS_JUPYTER_TRUST_SECRET"},
+			Destination: &cfg.TokenManager.TrustSecret,
+		},
+	}
+}
+
+// TrustWithConfig applies cfg to the trust command flagset.
+func TrustWithConfig(cfg *config.Config) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "trust-database",
+			Value:       "/var/tmp/ocis/jupyter/signatures.json",
+			Usage:       "Path to the signature database of trusted notebooks",
+			EnvVars:     []string{"OCIS_JUPYTER_TRUST_DATABASE"},
+			Destination: &cfg.Trust.Database,
+		},
+		&cli.StringFlag{
+			Name:        "trust-secret",
+			Value:       "",
+			Usage:       "Used to sign trusted notebooks, has to equal the secret of the server",
+			EnvVars:     []string{"OCIS_JUPYTER_TRUST_SECRET"},
+			Destination: &cfg.TokenManager.TrustSecret,
+		},
+		&cli.BoolFlag{
+			Name:  "check",
+			Usage: "Only report whether the notebooks are trusted",
+		},
+	}
+}
+
+// DiffWithConfig applies cfg to the diff command flagset.
+func DiffWithConfig(cfg *config.Config) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Value: "text",
+			Usage: "Output format of the diff, one of text, patch or json",
+		},
+		&cli.BoolFlag{
+			Name:  "color",
+			Usage: "Highlight the changes of the text format",
+		},
+	}
+}
+
+// MergeWithConfig applies cfg to the merge command flagset.
+func MergeWithConfig(cfg *config.Config) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "File to write the merged notebook to, defaults to the local notebook",
+		},
+		&cli.BoolFlag{
+			Name:  "conflict-cells",
+			Value: true,
+			Usage: "Keep both versions of conflicting cells in the merged notebook",
+		},
+		&cli.StringFlag{
+			Name:  "conflicts",
+			Usage: "File to write the conflicts to as JSON, - for stdout",
+		},
 	}
 }
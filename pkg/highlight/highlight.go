@@ -0,0 +1,359 @@
+// Package highlight colors the source of code cells. The lexer is chosen by
+// the language metadata of the notebook, IPython magics switch the lexer for
+// single lines or whole cells.
+package highlight
+
+import (
+	"bytes"
+	"html/template"
+	"strings"
+	"sync"
+
+	"github.com/alecthomas/chroma"
+	"github.com/alecthomas/chroma/formatters/html"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/alecthomas/chroma/styles"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+// DefaultTheme is the theme of the classic notebook.
+const DefaultTheme = "pygments"
+
+// Theme is a highlighting theme offered to users.
+type Theme struct {
+	Name        string
+	DisplayName string
+}
+
+// Themes lists the themes offered in the settings, all other themes of
+// chroma can be requested by name as well.
+var Themes = []Theme{
+	{DefaultTheme, "Jupyter"},
+	{"github", "GitHub"},
+	{"vs", "Visual Studio"},
+	{"xcode", "Xcode"},
+	{"friendly", "Friendly"},
+	{"tango", "Tango"},
+	{"solarized-light", "Solarized Light"},
+	{"solarized-dark", "Solarized Dark"},
+	{"monokai", "Monokai"},
+	{"dracula", "Dracula"},
+	{"nord", "Nord"},
+	{"native", "Native"},
+}
+
+var (
+	formatter = html.New(
+		html.WithClasses(true),
+		html.PreventSurroundingPre(true),
+	)
+
+	// stylesheets caches the CSS of themes.
+	stylesheets sync.Map
+)
+
+// HasTheme returns true if a theme of the given name exists.
+func HasTheme(name string) bool {
+	_, ok := styles.Registry[name]
+	return ok
+}
+
+// CSS returns the stylesheet of a theme, unknown themes fall back to the
+// default theme. The rules apply to elements of the chroma class.
+func CSS(theme string) template.CSS {
+	if !HasTheme(theme) {
+		theme = DefaultTheme
+	}
+
+	if css, ok := stylesheets.Load(theme); ok {
+		return css.(template.CSS)
+	}
+
+	buf := &bytes.Buffer{}
+
+	// Writing to a buffer does not fail.
+	_ = formatter.WriteCSS(buf, styles.Registry[theme])
+
+	css := template.CSS(buf.String())
+	stylesheets.Store(theme, css)
+
+	return css
+}
+
+// Highlighter highlights the code cells of a notebook.
+type Highlighter struct {
+	lexer  chroma.Lexer
+	magics bool
+}
+
+// ForNotebook returns a highlighter for the language of the notebook. The
+// lexer is looked up by the language_info metadata, the kernelspec is used
+// if it is missing. Unknown languages are not highlighted.
+func ForNotebook(nb *notebook.Notebook) *Highlighter {
+	lexer := lookup(nb)
+
+	if lexer == nil {
+		lexer = lexers.Fallback
+	}
+
+	name := lexer.Config().Name
+
+	return &Highlighter{
+		lexer:  chroma.Coalesce(lexer),
+		magics: name == "Python" || name == "Python 2",
+	}
+}
+
+// lookup finds the lexer for the language of a notebook.
+func lookup(nb *notebook.Notebook) chroma.Lexer {
+	if info := nb.LanguageInfo(); info != nil {
+		for _, name := range []string{info.Name, info.PygmentsLexer} {
+			if lexer := get(name); lexer != nil {
+				return lexer
+			}
+		}
+
+		if lexer := lexers.MatchMimeType(info.MimeType); info.MimeType != "" && lexer != nil {
+			return lexer
+		}
+
+		if lexer := lexers.Match("cell" + info.FileExtension); info.FileExtension != "" && lexer != nil {
+			return lexer
+		}
+	}
+
+	if spec := nb.KernelSpec(); spec != nil {
+		return get(spec.Language)
+	}
+
+	return nil
+}
+
+// get returns the lexer of a language name, nil if it is unknown.
+func get(name string) chroma.Lexer {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if name == "" {
+		return nil
+	}
+
+	// IPython is highlighted as Python with magics.
+	if strings.HasPrefix(name, "ipython") {
+		name = "python"
+	}
+
+	return lexers.Get(name)
+}
+
+// Code returns the highlighted source of a code cell, for a pre element.
+func (h *Highlighter) Code(source string) (template.HTML, error) {
+	tokens, err := h.tokens(source)
+
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+
+	// Only classes are written, the theme applies through the stylesheet.
+	if err := formatter.Format(buf, styles.Registry[DefaultTheme], chroma.Literator(tokens...)); err != nil {
+		return "", err
+	}
+
+	return template.HTML(buf.String()), nil
+}
+
+// tokens splits the source into tokens, the lexer changes for magics.
+func (h *Highlighter) tokens(source string) ([]chroma.Token, error) {
+	if !h.magics {
+		return tokenise(h.lexer, source)
+	}
+
+	if strings.HasPrefix(source, "%%") {
+		line, rest := splitLine(source)
+		tokens := []chroma.Token{{Type: chroma.CommentPreproc, Value: line}}
+
+		lexer, magics := h.cellMagic(line)
+
+		if magics {
+			more, err := h.lines(rest)
+			return append(tokens, more...), err
+		}
+
+		more, err := tokenise(lexer, rest)
+		return append(tokens, more...), err
+	}
+
+	return h.lines(source)
+}
+
+// lines tokenises source which may contain line magics and shell commands.
+// Consecutive lines of code are tokenised together.
+func (h *Highlighter) lines(source string) ([]chroma.Token, error) {
+	tokens := []chroma.Token{}
+	code := &strings.Builder{}
+
+	flush := func() error {
+		more, err := tokenise(h.lexer, code.String())
+		tokens = append(tokens, more...)
+		code.Reset()
+
+		return err
+	}
+
+	for source != "" {
+		var line string
+		line, source = splitLine(source)
+
+		trimmed := strings.TrimLeft(line, " \t")
+
+		if !strings.HasPrefix(trimmed, "%") && !strings.HasPrefix(trimmed, "!") {
+			code.WriteString(line)
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return nil, err
+		}
+
+		more, err := h.lineMagic(line)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, more...)
+	}
+
+	return tokens, flush()
+}
+
+// lineMagic tokenises a line magic or a shell command.
+func (h *Highlighter) lineMagic(line string) ([]chroma.Token, error) {
+	indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+	line = line[len(indent):]
+
+	tokens := []chroma.Token{}
+
+	if indent != "" {
+		tokens = append(tokens, chroma.Token{Type: chroma.Text, Value: indent})
+	}
+
+	if strings.HasPrefix(line, "!") {
+		tokens = append(tokens, chroma.Token{Type: chroma.CommentPreproc, Value: "!"})
+		more, err := tokenise(lexers.Get("bash"), line[1:])
+
+		return append(tokens, more...), err
+	}
+
+	name := line
+
+	if i := strings.IndexAny(line, " \t\n"); i >= 0 {
+		name = line[:i]
+	}
+
+	tokens = append(tokens, chroma.Token{Type: chroma.CommentPreproc, Value: name})
+
+	// Magics which run their argument as code.
+	switch strings.TrimPrefix(name, "%") {
+	case "time", "timeit", "prun", "debug", "capture":
+		more, err := tokenise(h.lexer, line[len(name):])
+		return append(tokens, more...), err
+	}
+
+	return append(tokens, chroma.Token{Type: chroma.Text, Value: line[len(name):]}), nil
+}
+
+// cellMagics maps cell magics to the language of the cell body.
+var cellMagics = map[string]string{
+	"bash":       "bash",
+	"sh":         "bash",
+	"html":       "html",
+	"javascript": "javascript",
+	"js":         "javascript",
+	"latex":      "tex",
+	"markdown":   "markdown",
+	"perl":       "perl",
+	"ruby":       "ruby",
+	"sql":        "sql",
+	"svg":        "xml",
+	"R":          "r",
+	"python":     "python",
+	"python2":    "python2",
+	"python3":    "python",
+	"pypy":       "python",
+}
+
+// cellMagic returns the lexer for the body of a cell magic. Magics which run
+// the body as code of the notebook return true instead.
+func (h *Highlighter) cellMagic(line string) (chroma.Lexer, bool) {
+	fields := strings.Fields(strings.TrimPrefix(line, "%%"))
+
+	if len(fields) == 0 {
+		return nil, true
+	}
+
+	switch fields[0] {
+	case "script":
+		if len(fields) > 1 {
+			if lexer := get(fields[1][strings.LastIndex(fields[1], "/")+1:]); lexer != nil {
+				return lexer, false
+			}
+		}
+
+		return lexers.Fallback, false
+	case "writefile", "file":
+		if len(fields) > 1 {
+			if lexer := lexers.Match(fields[len(fields)-1]); lexer != nil {
+				return lexer, false
+			}
+		}
+
+		return lexers.Fallback, false
+	}
+
+	if name, ok := cellMagics[fields[0]]; ok {
+		if lexer := lexers.Get(name); lexer != nil {
+			return lexer, false
+		}
+	}
+
+	return nil, true
+}
+
+// tokenise splits text into tokens. Lexers may append a newline to the text,
+// it is removed again so tokens of several parts can be joined.
+func tokenise(lexer chroma.Lexer, text string) ([]chroma.Token, error) {
+	if text == "" {
+		return nil, nil
+	}
+
+	it, err := lexer.Tokenise(nil, text)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := it.Tokens()
+	length := 0
+
+	for _, t := range tokens {
+		length += len(t.Value)
+	}
+
+	if n := len(tokens); n > 0 && length > len(text) && strings.HasSuffix(tokens[n-1].Value, "\n") {
+		tokens[n-1].Value = strings.TrimSuffix(tokens[n-1].Value, "\n")
+	}
+
+	return tokens, nil
+}
+
+// splitLine returns the first line including its newline and the rest.
+func splitLine(s string) (string, string) {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i+1], s[i+1:]
+	}
+
+	return s, ""
+}
@@ -0,0 +1,121 @@
+package highlight
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+func notebookFor(t *testing.T, metadata string) *notebook.Notebook {
+	nb, err := notebook.Parse([]byte(`{"nbformat": 4, "nbformat_minor": 4, "cells": [], "metadata": ` + metadata + `}`))
+	assert.Nil(t, err)
+
+	return nb
+}
+
+func TestForNotebook(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata string
+		expected string
+	}{
+		{"python", `{"language_info": {"name": "python"}}`, "Python"},
+		{"r", `{"language_info": {"name": "R"}}`, "R"},
+		{"julia", `{"language_info": {"name": "julia"}}`, "Julia"},
+		{"scala", `{"language_info": {"name": "scala"}}`, "Scala"},
+		{"sql", `{"language_info": {"name": "sql"}}`, "SQL"},
+		{"pygments lexer", `{"language_info": {"name": "unknown", "pygments_lexer": "ipython3"}}`, "Python"},
+		{"file extension", `{"language_info": {"name": "unknown", "file_extension": ".jl"}}`, "Julia"},
+		{"kernelspec", `{"kernelspec": {"name": "ir", "display_name": "R", "language": "R"}}`, "R"},
+		{"unknown", `{}`, "fallback"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := ForNotebook(notebookFor(t, tt.metadata))
+			assert.Equal(t, tt.expected, h.lexer.Config().Name)
+		})
+	}
+}
+
+func TestHighlighter_Code(t *testing.T) {
+	h := ForNotebook(notebookFor(t, `{"language_info": {"name": "python"}}`))
+
+	html, err := h.Code("if a < b:\n    print('<b>')")
+	assert.Nil(t, err)
+	assert.Contains(t, string(html), `<span class="k">if</span> <span class="n">a</span> <span class="o">&lt;</span>`)
+	assert.Contains(t, string(html), `<span class="s1">&#39;&lt;b&gt;&#39;</span>`)
+	assert.NotContains(t, string(html), "<pre")
+}
+
+func TestHighlighter_Magics(t *testing.T) {
+	h := ForNotebook(notebookFor(t, `{"language_info": {"name": "python"}}`))
+
+	tests := []struct {
+		name     string
+		source   string
+		expected []string
+	}{
+		{
+			"cell magic",
+			"%%bash\nfor f in *.csv; do echo $f; done",
+			[]string{`<span class="cp">%%bash
+</span>`, `<span class="k">for</span>`, `<span class="nv">$f</span>`},
+		},
+		{
+			"timing cell magic",
+			"%%time\nimport os",
+			[]string{`<span class="cp">%%time
+</span>`, `<span class="kn">import</span>`},
+		},
+		{
+			"line magic",
+			"%time x = len(y)\nimport os",
+			[]string{`<span class="cp">%time</span>`, `<span class="nb">len</span>`, `<span class="kn">import</span>`},
+		},
+		{
+			"other line magic",
+			"%matplotlib inline",
+			[]string{`<span class="cp">%matplotlib</span> inline`},
+		},
+		{
+			"shell command",
+			"!pip install numpy",
+			[]string{`<span class="cp">!</span>`},
+		},
+		{
+			"writefile",
+			"%%writefile query.sql\nSELECT 1",
+			[]string{`<span class="k">SELECT</span>`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, err := h.Code(tt.source)
+			assert.Nil(t, err)
+
+			for _, e := range tt.expected {
+				assert.Contains(t, string(html), e)
+			}
+		})
+	}
+
+	// Magics are specific to IPython.
+	r := ForNotebook(notebookFor(t, `{"language_info": {"name": "R"}}`))
+	html, err := r.Code("%%bash\nx")
+	assert.Nil(t, err)
+	assert.NotContains(t, string(html), `class="cp"`)
+}
+
+func TestCSS(t *testing.T) {
+	assert.True(t, HasTheme("monokai"))
+	assert.False(t, HasTheme("unknown"))
+
+	assert.Contains(t, string(CSS("monokai")), "/* Keyword */ .chroma .k { color: #66d9ef }")
+	assert.Equal(t, CSS(DefaultTheme), CSS("unknown"))
+
+	for _, theme := range Themes {
+		assert.True(t, HasTheme(theme.Name), theme.Name)
+	}
+}
@@ -0,0 +1,522 @@
+// Package kernel starts and supervises Jupyter kernels. Kernels are started
+// from the argv of their kernel spec with a connection file, which tells
+// them the ports to listen on and the key to sign messages with. The state
+// of every kernel is followed through the status messages it publishes.
+package kernel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/messaging"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/zmtp"
+)
+
+// StateDead is the state of kernels whose process exited.
+const StateDead = "dead"
+
+// interruptMessage is the interrupt mode of kernels which are interrupted
+// with an interrupt_request instead of SIGINT.
+const interruptMessage = "message"
+
+var (
+	// ErrNotFound defines the error if a kernel id is unknown.
+	ErrNotFound = errors.New("kernel not found")
+
+	// ErrDead defines the error if a kernel process is not running.
+	ErrDead = errors.New("kernel is dead")
+
+	// ErrClosed defines the error if kernels are started after the manager
+	// was closed.
+	ErrClosed = errors.New("kernel manager closed")
+
+	// ErrInterrupt defines the error if a kernel does not confirm an
+	// interrupt_request.
+	ErrInterrupt = errors.New("kernel refused interrupt")
+)
+
+// Kernel is a kernel process started from a kernel spec.
+type Kernel struct {
+	id      string
+	spec    *kernelspec.KernelSpec
+	info    messaging.ConnectionInfo
+	file    string
+	session *messaging.Session
+	options Options
+
+	// ops serializes restarts and the shutdown.
+	ops      sync.Mutex
+	shutdown bool
+
+	mu      sync.Mutex
+	state   string
+	process *process
+}
+
+// process is one run of the kernel, a restart starts a new one with the
+// same connection file.
+type process struct {
+	cmd *exec.Cmd
+
+	// exited is closed when the process exited, ready when the kernel
+	// answered the kernel_info_request or err tells why it did not.
+	exited chan struct{}
+	ready  chan struct{}
+	err    error
+
+	shell   *zmtp.Conn
+	control *zmtp.Conn
+
+	mu      sync.Mutex
+	closed  bool
+	conns   []*zmtp.Conn
+	pending map[string]chan *messaging.Message
+}
+
+// ID returns the id of the kernel.
+func (k *Kernel) ID() string {
+	return k.id
+}
+
+// Name returns the name of the kernel spec.
+func (k *Kernel) Name() string {
+	return k.spec.Name
+}
+
+// ConnectionInfo returns the content of the connection file.
+func (k *Kernel) ConnectionInfo() messaging.ConnectionInfo {
+	return k.info
+}
+
+// ConnectionFile returns the path of the connection file.
+func (k *Kernel) ConnectionFile() string {
+	return k.file
+}
+
+// State returns starting, idle, busy or dead.
+func (k *Kernel) State() string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.state
+}
+
+// Pid returns the id of the kernel process.
+func (k *Kernel) Pid() int {
+	return k.current().cmd.Process.Pid
+}
+
+// Ready waits until the kernel answers after it was started or restarted.
+func (k *Kernel) Ready(ctx context.Context) error {
+	return k.waitReady(ctx, k.current())
+}
+
+// Interrupt interrupts the code the kernel executes, with SIGINT or with an
+// interrupt_request depending on the interrupt mode of the kernel spec.
+func (k *Kernel) Interrupt(ctx context.Context) error {
+	p := k.current()
+
+	select {
+	case <-p.exited:
+		return ErrDead
+	default:
+	}
+
+	if k.spec.InterruptMode != interruptMessage {
+		return interruptProcess(p.cmd.Process)
+	}
+
+	if err := k.waitReady(ctx, p); err != nil {
+		return err
+	}
+
+	reply, err := k.request(ctx, p, p.control, messaging.MsgInterruptRequest, &messaging.InterruptRequest{})
+
+	if err != nil {
+		return err
+	}
+
+	content := &messaging.InterruptReply{}
+
+	if err := reply.DecodeContent(content); err != nil {
+		return err
+	}
+
+	if content.Status != messaging.StatusOK {
+		return fmt.Errorf("%w: %s %s", ErrInterrupt, content.Status, content.EValue)
+	}
+
+	return nil
+}
+
+// Restart stops the kernel process and starts a new one with the same
+// connection file.
+func (k *Kernel) Restart(ctx context.Context) error {
+	k.ops.Lock()
+	defer k.ops.Unlock()
+
+	if k.shutdown {
+		return ErrDead
+	}
+
+	k.stop(ctx, k.current(), true)
+
+	return k.launch()
+}
+
+// stopAndRemove stops the kernel for good and removes its connection file.
+func (k *Kernel) stopAndRemove(ctx context.Context) error {
+	k.ops.Lock()
+	defer k.ops.Unlock()
+
+	k.shutdown = true
+	k.stop(ctx, k.current(), false)
+
+	if err := os.Remove(k.file); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// current returns the current process.
+func (k *Kernel) current() *process {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.process
+}
+
+// launch starts a new process of the kernel.
+func (k *Kernel) launch() error {
+	replacer := strings.NewReplacer(
+		"{connection_file}", k.file,
+		"{resource_dir}", k.spec.ResourceDir,
+	)
+
+	argv := make([]string, 0, len(k.spec.Argv))
+
+	for _, arg := range k.spec.Argv {
+		argv = append(argv, replacer.Replace(arg))
+	}
+
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Env = os.Environ()
+	newProcessGroup(cmd)
+
+	for key, value := range k.spec.Env {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+
+	if k.options.Output != nil {
+		cmd.Stdout, cmd.Stderr = k.options.Output, k.options.Output
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	p := &process{
+		cmd:     cmd,
+		exited:  make(chan struct{}),
+		ready:   make(chan struct{}),
+		pending: map[string]chan *messaging.Message{},
+	}
+
+	k.mu.Lock()
+	k.process = p
+	k.state = messaging.StateStarting
+	k.mu.Unlock()
+
+	go k.wait(p)
+	go k.connect(p)
+
+	return nil
+}
+
+// wait marks the kernel as dead when its process exits.
+func (k *Kernel) wait(p *process) {
+	err := p.cmd.Wait()
+	p.close()
+
+	k.mu.Lock()
+
+	if k.process == p {
+		k.state = StateDead
+	}
+
+	k.mu.Unlock()
+
+	close(p.exited)
+
+	k.options.Logger.Debug().
+		Str("kernel", k.id).
+		Str("name", k.spec.Name).
+		Str("exit", fmt.Sprint(err)).
+		Msg("Kernel process exited")
+}
+
+// connect connects to the shell, control and IOPub ports of a process and
+// waits for the kernel to answer a kernel_info_request.
+func (k *Kernel) connect(p *process) {
+	ctx, cancel := context.WithTimeout(context.Background(), k.options.StartTimeout)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-p.exited:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	defer close(p.ready)
+
+	iopub, err := k.dial(ctx, p, k.info.IOPubPort, zmtp.Sub)
+
+	if err == nil {
+		err = iopub.Subscribe(nil)
+	}
+
+	if err == nil {
+		p.shell, err = k.dial(ctx, p, k.info.ShellPort, zmtp.Dealer)
+	}
+
+	if err == nil {
+		p.control, err = k.dial(ctx, p, k.info.ControlPort, zmtp.Dealer)
+	}
+
+	if err != nil {
+		p.err = fmt.Errorf("connect: %w", err)
+		return
+	}
+
+	go k.readIOPub(p, iopub)
+	go k.readReplies(p, p.shell)
+	go k.readReplies(p, p.control)
+
+	if _, err := k.request(ctx, p, p.shell, messaging.MsgKernelInfoRequest, &messaging.KernelInfoRequest{}); err != nil {
+		p.err = fmt.Errorf("kernel info: %w", err)
+
+		k.options.Logger.Warn().
+			Err(err).
+			Str("kernel", k.id).
+			Msg("Kernel did not answer")
+
+		return
+	}
+
+	k.setState(p, messaging.StateIdle, true)
+}
+
+// dial connects to a port of the kernel, until the kernel listens on it.
+func (k *Kernel) dial(ctx context.Context, p *process, port int, socketType string) (*zmtp.Conn, error) {
+	for {
+		c, err := zmtp.Dial(ctx, k.info.Address(port), socketType)
+
+		if err == nil {
+			if !p.add(c) {
+				return nil, ErrDead
+			}
+
+			return c, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// readIOPub follows the execution state the kernel publishes.
+func (k *Kernel) readIOPub(p *process, c *zmtp.Conn) {
+	for {
+		frames, err := c.Recv()
+
+		if err != nil {
+			return
+		}
+
+		m, err := k.session.Decode(frames)
+
+		if err != nil || m.Header.MsgType != messaging.MsgStatus {
+			continue
+		}
+
+		status := &messaging.Status{}
+
+		if err := m.DecodeContent(status); err == nil {
+			k.setState(p, status.ExecutionState, false)
+		}
+	}
+}
+
+// readReplies passes the replies of a shell or control connection to the
+// requests waiting for them.
+func (k *Kernel) readReplies(p *process, c *zmtp.Conn) {
+	for {
+		frames, err := c.Recv()
+
+		if err != nil {
+			return
+		}
+
+		m, err := k.session.Decode(frames)
+
+		if err != nil || m.ParentHeader == nil {
+			continue
+		}
+
+		p.mu.Lock()
+		ch, ok := p.pending[m.ParentHeader.MsgID]
+		p.mu.Unlock()
+
+		if ok {
+			ch <- m
+		}
+	}
+}
+
+// request sends a request to the kernel and waits for the reply.
+func (k *Kernel) request(ctx context.Context, p *process, c *zmtp.Conn, msgType string, content interface{}) (*messaging.Message, error) {
+	m, err := k.session.NewMessage(msgType, content, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	frames, err := k.session.Encode(m)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *messaging.Message, 1)
+
+	p.mu.Lock()
+	p.pending[m.Header.MsgID] = ch
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		delete(p.pending, m.Header.MsgID)
+		p.mu.Unlock()
+	}()
+
+	if err := c.Send(frames...); err != nil {
+		return nil, err
+	}
+
+	select {
+	case reply := <-ch:
+		return reply, nil
+	case <-p.exited:
+		return nil, ErrDead
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// setState updates the state of the kernel if the process is still the
+// current one, onlyStarting updates only kernels which are starting.
+func (k *Kernel) setState(p *process, state string, onlyStarting bool) {
+	switch state {
+	case messaging.StateStarting, messaging.StateIdle, messaging.StateBusy:
+	default:
+		return
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.process != p || k.state == StateDead || onlyStarting && k.state != messaging.StateStarting {
+		return
+	}
+
+	k.state = state
+}
+
+// waitReady waits until a process answered.
+func (k *Kernel) waitReady(ctx context.Context, p *process) error {
+	select {
+	case <-p.ready:
+	case <-p.exited:
+		return ErrDead
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-p.exited:
+		return ErrDead
+	default:
+		return p.err
+	}
+}
+
+// stop asks the kernel to shut down and kills the process if it does not
+// exit within the shutdown timeout.
+func (k *Kernel) stop(ctx context.Context, p *process, restart bool) {
+	ctx, cancel := context.WithTimeout(ctx, k.options.ShutdownTimeout)
+	defer cancel()
+
+	// Kernels which are starting are asked once they answer.
+	select {
+	case <-p.ready:
+		if p.err == nil {
+			_, _ = k.request(ctx, p, p.control, messaging.MsgShutdownRequest, &messaging.ShutdownRequest{Restart: restart})
+		}
+	case <-p.exited:
+	case <-ctx.Done():
+	}
+
+	select {
+	case <-p.exited:
+		return
+	case <-ctx.Done():
+	}
+
+	k.options.Logger.Warn().
+		Str("kernel", k.id).
+		Msg("Killing kernel which did not shut down")
+
+	_ = killProcess(p.cmd.Process)
+	<-p.exited
+}
+
+// add keeps a connection to close it with the process, it returns false
+// and closes the connection if the process already exited.
+func (p *process) add(c *zmtp.Conn) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		_ = c.Close()
+		return false
+	}
+
+	p.conns = append(p.conns, c)
+
+	return true
+}
+
+// close closes the connections to the process.
+func (p *process) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+
+	for _, c := range p.conns {
+		_ = c.Close()
+	}
+}
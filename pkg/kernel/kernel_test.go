@@ -0,0 +1,415 @@
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/messaging"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/zmtp"
+	"github.com/owncloud/ocis/ocis-pkg/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeKernelEnv selects the script of the fake kernel, the test binary runs
+// as kernel if it is set.
+const fakeKernelEnv = "OCIS_JUPYTER_FAKE_KERNEL"
+
+// Scripts of the fake kernel.
+const (
+	// scriptIdle answers requests and exits on shutdown_request.
+	scriptIdle = "idle"
+
+	// scriptBusy publishes that it is busy after answering the
+	// kernel_info_request.
+	scriptBusy = "busy"
+
+	// scriptStubborn never exits on its own.
+	scriptStubborn = "stubborn"
+
+	// scriptCrash exits right away.
+	scriptCrash = "crash"
+)
+
+func TestMain(m *testing.M) {
+	if script := os.Getenv(fakeKernelEnv); script != "" {
+		fakeKernel(script, os.Args[len(os.Args)-1])
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+// fakeKernel serves the shell, control and IOPub ports of the connection
+// file. Interrupts are recorded in a file next to the connection file.
+func fakeKernel(script, file string) {
+	if script == scriptCrash {
+		os.Exit(1)
+	}
+
+	info, err := messaging.ReadConnectionFile(file)
+
+	if err != nil {
+		os.Exit(2)
+	}
+
+	session, err := info.Session()
+
+	if err != nil {
+		os.Exit(2)
+	}
+
+	interrupted := func() {
+		_ = ioutil.WriteFile(file+".interrupted", nil, 0600)
+	}
+
+	sigint := make(chan os.Signal, 1)
+	signal.Notify(sigint, os.Interrupt)
+
+	go func() {
+		for range sigint {
+			interrupted()
+		}
+	}()
+
+	iopub := make(chan *zmtp.Conn, 1)
+
+	publish := func(state string, parent *messaging.Message) {
+		c := <-iopub
+		defer func() { iopub <- c }()
+
+		m, _ := session.NewMessage(messaging.MsgStatus, &messaging.Status{ExecutionState: state}, parent)
+		frames, _ := session.Encode(m)
+		_ = c.Send(frames...)
+	}
+
+	serve := func(port int, socketType string, handle func(c *zmtp.Conn)) {
+		l, err := zmtp.Listen(info.Address(port), socketType)
+
+		if err != nil {
+			os.Exit(2)
+		}
+
+		go func() {
+			for {
+				c, err := l.Accept()
+
+				if err != nil {
+					return
+				}
+
+				go handle(c)
+			}
+		}()
+	}
+
+	serve(info.IOPubPort, zmtp.Pub, func(c *zmtp.Conn) {
+		iopub <- c
+	})
+
+	answer := func(c *zmtp.Conn) {
+		for {
+			frames, err := c.Recv()
+
+			if err != nil {
+				return
+			}
+
+			m, err := session.Decode(frames)
+
+			if err != nil {
+				continue
+			}
+
+			var (
+				msgType string
+				content interface{}
+			)
+
+			switch m.Header.MsgType {
+			case messaging.MsgKernelInfoRequest:
+				msgType, content = messaging.MsgKernelInfoReply, &messaging.KernelInfoReply{Reply: messaging.Reply{Status: messaging.StatusOK}}
+			case messaging.MsgInterruptRequest:
+				interrupted()
+				msgType, content = messaging.MsgInterruptReply, &messaging.InterruptReply{Reply: messaging.Reply{Status: messaging.StatusOK}}
+			case messaging.MsgShutdownRequest:
+				msgType, content = messaging.MsgShutdownReply, &messaging.ShutdownReply{Reply: messaging.Reply{Status: messaging.StatusOK}}
+			default:
+				continue
+			}
+
+			reply, _ := session.NewMessage(msgType, content, m)
+			frames, _ = session.Encode(reply)
+			_ = c.Send(frames...)
+
+			switch {
+			case msgType == messaging.MsgShutdownReply && script != scriptStubborn:
+				os.Exit(0)
+			case msgType == messaging.MsgKernelInfoReply && script == scriptBusy:
+				publish(messaging.StateBusy, m)
+			}
+		}
+	}
+
+	serve(info.ShellPort, zmtp.Router, answer)
+	serve(info.ControlPort, zmtp.Router, answer)
+
+	select {}
+}
+
+// manager returns a kernel manager with a kernel spec per script. The
+// returned function removes the temporary directory.
+func manager(t *testing.T, opts ...Option) (*Manager, func()) {
+	dir, err := ioutil.TempDir("", "kernel")
+	assert.Nil(t, err)
+
+	executable, err := os.Executable()
+	assert.Nil(t, err)
+
+	for _, script := range []string{scriptIdle, scriptBusy, scriptStubborn, scriptCrash} {
+		spec := filepath.Join(dir, "kernels", script)
+		assert.Nil(t, os.MkdirAll(spec, 0700))
+
+		b, _ := json.Marshal(map[string]interface{}{
+			"argv":         []string{executable, "{connection_file}"},
+			"display_name": "Fake " + script,
+			"language":     "python",
+			"env":          map[string]string{fakeKernelEnv: script},
+		})
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(spec, kernelspec.File), b, 0600))
+	}
+
+	// A kernel spec of the first script which is interrupted with messages.
+	spec := filepath.Join(dir, "kernels", "message")
+	assert.Nil(t, os.MkdirAll(spec, 0700))
+
+	b, _ := json.Marshal(map[string]interface{}{
+		"argv":           []string{executable, "{connection_file}"},
+		"display_name":   "Fake message",
+		"language":       "python",
+		"interrupt_mode": interruptMessage,
+		"env":            map[string]string{fakeKernelEnv: scriptIdle},
+	})
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(spec, kernelspec.File), b, 0600))
+
+	m := New(append([]Option{
+		Logger(log.NewLogger(log.Level("error"))),
+		Specs(kernelspec.New(kernelspec.DataPaths(dir))),
+		RuntimeDir(filepath.Join(dir, "runtime")),
+		StartTimeout(10 * time.Second),
+		ShutdownTimeout(5 * time.Second),
+	}, opts...)...)
+
+	return m, func() {
+		_ = m.Close()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func ready(t *testing.T, k *Kernel) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	assert.Nil(t, k.Ready(ctx))
+}
+
+// eventually waits until the condition is met or fails the test.
+func eventually(t *testing.T, condition func() bool) {
+	assert.Eventually(t, condition, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestManager_Start(t *testing.T) {
+	m, cleanup := manager(t)
+	defer cleanup()
+
+	k, err := m.Start(scriptIdle)
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	ready(t, k)
+	assert.Equal(t, messaging.StateIdle, k.State())
+	assert.Equal(t, scriptIdle, k.Name())
+
+	info, err := messaging.ReadConnectionFile(k.ConnectionFile())
+	assert.Nil(t, err)
+	assert.Equal(t, k.ConnectionInfo(), *info)
+	assert.Equal(t, scriptIdle, info.KernelName)
+	assert.Len(t, info.Key, 64)
+
+	ports := map[int]bool{}
+
+	for _, port := range []int{info.ShellPort, info.IOPubPort, info.StdinPort, info.ControlPort, info.HBPort} {
+		ports[port] = true
+	}
+
+	assert.Len(t, ports, 5)
+
+	got, err := m.Get(k.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, k, got)
+	assert.Equal(t, []*Kernel{k}, m.List())
+
+	_, err = m.Get("unknown")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = m.Start("unknown")
+	assert.True(t, errors.Is(err, kernelspec.ErrNotFound))
+}
+
+func TestManager_Shutdown(t *testing.T) {
+	m, cleanup := manager(t)
+	defer cleanup()
+
+	k, err := m.Start(scriptIdle)
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	ready(t, k)
+
+	assert.Nil(t, m.Shutdown(context.Background(), k.ID()))
+	assert.Equal(t, StateDead, k.State())
+	assert.Empty(t, m.List())
+
+	_, err = os.Stat(k.ConnectionFile())
+	assert.True(t, os.IsNotExist(err))
+
+	assert.True(t, errors.Is(m.Shutdown(context.Background(), k.ID()), ErrNotFound))
+	assert.True(t, errors.Is(k.Restart(context.Background()), ErrDead))
+}
+
+func TestManager_ShutdownKill(t *testing.T) {
+	m, cleanup := manager(t, ShutdownTimeout(200*time.Millisecond))
+	defer cleanup()
+
+	k, err := m.Start(scriptStubborn)
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	ready(t, k)
+
+	assert.Nil(t, m.Shutdown(context.Background(), k.ID()))
+	assert.Equal(t, StateDead, k.State())
+}
+
+func TestManager_Run(t *testing.T) {
+	m, cleanup := manager(t)
+	defer cleanup()
+
+	kernels := []*Kernel{}
+
+	for _, name := range []string{scriptIdle, scriptBusy} {
+		k, err := m.Start(name)
+
+		if !assert.Nil(t, err) {
+			return
+		}
+
+		kernels = append(kernels, k)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() {
+		done <- m.Run(ctx)
+	}()
+
+	cancel()
+	assert.Nil(t, <-done)
+
+	for _, k := range kernels {
+		assert.Equal(t, StateDead, k.State())
+	}
+
+	_, err := m.Start(scriptIdle)
+	assert.True(t, errors.Is(err, ErrClosed))
+}
+
+func TestKernel_State(t *testing.T) {
+	m, cleanup := manager(t)
+	defer cleanup()
+
+	k, err := m.Start(scriptBusy)
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	assert.Equal(t, messaging.StateStarting, k.State())
+
+	ready(t, k)
+	eventually(t, func() bool {
+		return k.State() == messaging.StateBusy
+	})
+
+	crashed, err := m.Start(scriptCrash)
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	eventually(t, func() bool {
+		return crashed.State() == StateDead
+	})
+
+	assert.True(t, errors.Is(crashed.Ready(context.Background()), ErrDead))
+	assert.True(t, errors.Is(crashed.Interrupt(context.Background()), ErrDead))
+}
+
+func TestKernel_Interrupt(t *testing.T) {
+	m, cleanup := manager(t)
+	defer cleanup()
+
+	for _, name := range []string{scriptIdle, "message"} {
+		k, err := m.Start(name)
+
+		if !assert.Nil(t, err) {
+			return
+		}
+
+		ready(t, k)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		assert.Nil(t, k.Interrupt(ctx), name)
+		cancel()
+
+		eventually(t, func() bool {
+			_, err := os.Stat(k.ConnectionFile() + ".interrupted")
+			return err == nil
+		})
+	}
+}
+
+func TestKernel_Restart(t *testing.T) {
+	m, cleanup := manager(t)
+	defer cleanup()
+
+	k, err := m.Start(scriptIdle)
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	ready(t, k)
+	pid, info := k.Pid(), k.ConnectionInfo()
+
+	assert.Nil(t, k.Restart(context.Background()))
+	ready(t, k)
+
+	assert.NotEqual(t, pid, k.Pid())
+	assert.Equal(t, info, k.ConnectionInfo())
+	assert.Equal(t, messaging.StateIdle, k.State())
+}
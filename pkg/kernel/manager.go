@@ -0,0 +1,236 @@
+package kernel
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/messaging"
+)
+
+// Manager starts kernels and keeps track of them until they are shut down.
+type Manager struct {
+	options Options
+
+	mu      sync.Mutex
+	closed  bool
+	kernels map[string]*Kernel
+}
+
+// New initializes a new kernel manager.
+func New(opts ...Option) *Manager {
+	return &Manager{
+		options: newOptions(opts...),
+		kernels: map[string]*Kernel{},
+	}
+}
+
+// Start starts a kernel of the kernel spec with the given name. The kernel
+// is starting until it answers, which Ready waits for.
+func (m *Manager) Start(name string) (*Kernel, error) {
+	spec, err := m.options.Specs.Get(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := m.connectionInfo(spec.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := info.Session()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(m.options.RuntimeDir, 0700); err != nil {
+		return nil, err
+	}
+
+	id := messaging.NewID()
+
+	k := &Kernel{
+		id:      id,
+		spec:    spec,
+		info:    *info,
+		file:    filepath.Join(m.options.RuntimeDir, "kernel-"+id+".json"),
+		session: session,
+		options: m.options,
+	}
+
+	if err := info.Write(k.file); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		_ = os.Remove(k.file)
+		return nil, ErrClosed
+	}
+
+	if err := k.launch(); err != nil {
+		_ = os.Remove(k.file)
+		return nil, err
+	}
+
+	m.kernels[id] = k
+
+	m.options.Logger.Info().
+		Str("kernel", id).
+		Str("name", spec.Name).
+		Int("pid", k.Pid()).
+		Msg("Started kernel")
+
+	return k, nil
+}
+
+// Get returns the kernel with the given id.
+func (m *Manager) Get(id string) (*Kernel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	k, ok := m.kernels[id]
+
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return k, nil
+}
+
+// List returns the running kernels ordered by id.
+func (m *Manager) List() []*Kernel {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kernels := make([]*Kernel, 0, len(m.kernels))
+
+	for _, k := range m.kernels {
+		kernels = append(kernels, k)
+	}
+
+	sort.Slice(kernels, func(i, j int) bool {
+		return kernels[i].id < kernels[j].id
+	})
+
+	return kernels
+}
+
+// Shutdown stops the kernel with the given id and forgets about it.
+func (m *Manager) Shutdown(ctx context.Context, id string) error {
+	m.mu.Lock()
+	k, ok := m.kernels[id]
+	delete(m.kernels, id)
+	m.mu.Unlock()
+
+	if !ok {
+		return ErrNotFound
+	}
+
+	m.options.Logger.Info().
+		Str("kernel", id).
+		Str("name", k.spec.Name).
+		Msg("Shutting down kernel")
+
+	return k.stopAndRemove(ctx)
+}
+
+// Run blocks until the context is done and shuts down all kernels then, so
+// it can be added to the run group of the server.
+func (m *Manager) Run(ctx context.Context) error {
+	<-ctx.Done()
+
+	return m.Close()
+}
+
+// Close shuts down all kernels concurrently, kernels can not be started
+// afterwards.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+
+	var (
+		wg   sync.WaitGroup
+		once sync.Once
+		err  error
+	)
+
+	for _, k := range m.List() {
+		wg.Add(1)
+
+		go func(id string) {
+			defer wg.Done()
+
+			if e := m.Shutdown(context.Background(), id); e != nil {
+				once.Do(func() {
+					err = e
+				})
+			}
+		}(k.id)
+	}
+
+	wg.Wait()
+
+	return err
+}
+
+// connectionInfo returns the content of the connection file of a new kernel
+// with a random key and free ports.
+func (m *Manager) connectionInfo(name string) (*messaging.ConnectionInfo, error) {
+	key := make([]byte, 32)
+
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	ports, err := freePorts(m.options.IP, 5)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &messaging.ConnectionInfo{
+		Transport:       "tcp",
+		IP:              m.options.IP,
+		ShellPort:       ports[0],
+		IOPubPort:       ports[1],
+		StdinPort:       ports[2],
+		ControlPort:     ports[3],
+		HBPort:          ports[4],
+		Key:             hex.EncodeToString(key),
+		SignatureScheme: messaging.DefaultScheme,
+		KernelName:      name,
+	}, nil
+}
+
+// freePorts returns n ports of the given ip which are free at the moment.
+// The listeners are kept open until all ports are found, so the same port
+// is not returned twice.
+func freePorts(ip string, n int) ([]int, error) {
+	ports := make([]int, 0, n)
+
+	for i := 0; i < n; i++ {
+		l, err := net.Listen("tcp", net.JoinHostPort(ip, "0"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		defer l.Close()
+
+		ports = append(ports, l.Addr().(*net.TCPAddr).Port)
+	}
+
+	return ports, nil
+}
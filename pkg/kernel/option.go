@@ -0,0 +1,110 @@
+package kernel
+
+import (
+	"io"
+	"time"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
+	"github.com/owncloud/ocis/ocis-pkg/log"
+)
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	Logger log.Logger
+
+	// Specs looks up the kernel specs kernels are started from.
+	Specs *kernelspec.Manager
+
+	// RuntimeDir is the directory the connection files are written to.
+	RuntimeDir string
+
+	// IP is the address the kernels listen on.
+	IP string
+
+	// StartTimeout limits the time until a started kernel answers.
+	StartTimeout time.Duration
+
+	// ShutdownTimeout is the time kernels get to stop on their own before
+	// they are killed.
+	ShutdownTimeout time.Duration
+
+	// Output receives stdout and stderr of the kernel processes, they are
+	// discarded if it is nil.
+	Output io.Writer
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		RuntimeDir:      "/var/tmp/ocis/jupyter/runtime",
+		IP:              "127.0.0.1",
+		StartTimeout:    60 * time.Second,
+		ShutdownTimeout: 5 * time.Second,
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	if opt.Specs == nil {
+		opt.Specs = kernelspec.New()
+	}
+
+	return opt
+}
+
+// Logger provides a function to set the logger option.
+func Logger(val log.Logger) Option {
+	return func(o *Options) {
+		o.Logger = val
+	}
+}
+
+// Specs provides a function to set the specs option.
+func Specs(val *kernelspec.Manager) Option {
+	return func(o *Options) {
+		o.Specs = val
+	}
+}
+
+// RuntimeDir provides a function to set the runtime dir option.
+func RuntimeDir(val string) Option {
+	return func(o *Options) {
+		if val != "" {
+			o.RuntimeDir = val
+		}
+	}
+}
+
+// IP provides a function to set the ip option.
+func IP(val string) Option {
+	return func(o *Options) {
+		if val != "" {
+			o.IP = val
+		}
+	}
+}
+
+// StartTimeout provides a function to set the start timeout option.
+func StartTimeout(val time.Duration) Option {
+	return func(o *Options) {
+		o.StartTimeout = val
+	}
+}
+
+// ShutdownTimeout provides a function to set the shutdown timeout option.
+func ShutdownTimeout(val time.Duration) Option {
+	return func(o *Options) {
+		o.ShutdownTimeout = val
+	}
+}
+
+// Output provides a function to set the output option.
+func Output(val io.Writer) Option {
+	return func(o *Options) {
+		o.Output = val
+	}
+}
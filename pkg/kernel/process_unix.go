@@ -0,0 +1,35 @@
+// +build !windows
+
+package kernel
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// newProcessGroup starts the command in its own process group, so signals
+// reach the processes started by wrappers like shell scripts or conda run.
+func newProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// interruptProcess sends SIGINT to the process group of the kernel.
+func interruptProcess(p *os.Process) error {
+	return signalProcessGroup(p, syscall.SIGINT)
+}
+
+// killProcess kills the process group of the kernel.
+func killProcess(p *os.Process) error {
+	return signalProcessGroup(p, syscall.SIGKILL)
+}
+
+// signalProcessGroup signals the process group led by the process, or the
+// process alone if the group is gone already.
+func signalProcessGroup(p *os.Process, sig syscall.Signal) error {
+	if err := syscall.Kill(-p.Pid, sig); err != syscall.ESRCH {
+		return err
+	}
+
+	return p.Signal(sig)
+}
@@ -0,0 +1,92 @@
+// +build !windows
+
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
+	"github.com/stretchr/testify/assert"
+)
+
+// wrapped adds a kernel spec which runs the fake kernel of the script as
+// child of a shell, like wrapper scripts or conda run do.
+func wrapped(t *testing.T, m *Manager, script string) string {
+	executable, err := os.Executable()
+	assert.Nil(t, err)
+
+	name := "wrapped-" + script
+	spec := filepath.Join(filepath.Dir(m.options.RuntimeDir), "kernels", name)
+	assert.Nil(t, os.MkdirAll(spec, 0700))
+
+	// The shell survives interrupts and does not exec the kernel.
+	b, _ := json.Marshal(map[string]interface{}{
+		"argv":         []string{"/bin/sh", "-c", `trap : INT; "$0" "$1"; exit $?`, executable, "{connection_file}"},
+		"display_name": "Wrapped " + script,
+		"language":     "python",
+		"env":          map[string]string{fakeKernelEnv: script},
+	})
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(spec, kernelspec.File), b, 0600))
+
+	return name
+}
+
+func TestKernel_InterruptWrapped(t *testing.T) {
+	m, cleanup := manager(t)
+	defer cleanup()
+
+	k, err := m.Start(wrapped(t, m, scriptIdle))
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	ready(t, k)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	assert.Nil(t, k.Interrupt(ctx))
+
+	eventually(t, func() bool {
+		_, err := os.Stat(k.ConnectionFile() + ".interrupted")
+		return err == nil
+	})
+}
+
+func TestManager_ShutdownKillWrapped(t *testing.T) {
+	m, cleanup := manager(t, ShutdownTimeout(200*time.Millisecond))
+	defer cleanup()
+
+	k, err := m.Start(wrapped(t, m, scriptStubborn))
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	ready(t, k)
+	info := k.ConnectionInfo()
+
+	assert.Nil(t, m.Shutdown(context.Background(), k.ID()))
+	assert.Equal(t, StateDead, k.State())
+
+	// The kernel below the shell is killed as well.
+	eventually(t, func() bool {
+		c, err := net.Dial("tcp", net.JoinHostPort(info.IP, strconv.Itoa(info.ShellPort)))
+
+		if err != nil {
+			return true
+		}
+
+		_ = c.Close()
+		return false
+	})
+}
@@ -0,0 +1,22 @@
+// +build windows
+
+package kernel
+
+import (
+	"os"
+	"os/exec"
+)
+
+// newProcessGroup does nothing, Windows has no process groups which can be
+// signalled.
+func newProcessGroup(cmd *exec.Cmd) {}
+
+// interruptProcess sends an interrupt to the kernel process.
+func interruptProcess(p *os.Process) error {
+	return p.Signal(os.Interrupt)
+}
+
+// killProcess kills the kernel process.
+func killProcess(p *os.Process) error {
+	return p.Kill()
+}
@@ -0,0 +1,254 @@
+// Package kernelspec finds the kernel specifications installed for Jupyter.
+// Every kernel is a directory with a kernel.json file, which describes how
+// to start the kernel, and optional resources like logos. The directories
+// are looked up in the kernels subdirectory of the Jupyter data directories
+// and in additionally configured paths.
+package kernelspec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// File is the name of the file describing a kernel.
+const File = "kernel.json"
+
+var (
+	// ErrNotFound defines the error if a kernel is not installed.
+	ErrNotFound = errors.New("kernel spec not found")
+
+	// ErrInvalidName defines the error if a kernel name contains other
+	// characters than letters, digits, dots, dashes and underscores.
+	ErrInvalidName = errors.New("invalid kernel name")
+
+	// validName matches the kernel names accepted by Jupyter.
+	validName = regexp.MustCompile(`^[a-z0-9._-]+$`)
+
+	// resourceFiles are the files of a kernel directory served to clients.
+	resourceFiles = regexp.MustCompile(`^(logo-[a-z0-9]+x[a-z0-9]+\.png|logo-svg\.svg|kernel\.js|kernel\.css)$`)
+)
+
+// KernelSpec describes an installed kernel. The exported JSON fields are
+// the ones of kernel.json.
+type KernelSpec struct {
+	// Name is the name of the kernel directory, in lower case.
+	Name string `json:"-"`
+
+	// ResourceDir is the directory of the kernel.
+	ResourceDir string `json:"-"`
+
+	// Resources are the files of the kernel directory which clients may
+	// load, by their name without extension, e.g. logo-64x64.
+	Resources map[string]string `json:"-"`
+
+	// Argv is the command line starting the kernel, {connection_file} is
+	// replaced with the path of the connection file.
+	Argv []string `json:"argv"`
+
+	DisplayName string `json:"display_name"`
+	Language    string `json:"language"`
+
+	// InterruptMode is signal or message, the kernel is interrupted with
+	// SIGINT or an interrupt_request. Jupyter defaults to signal.
+	InterruptMode string `json:"interrupt_mode,omitempty"`
+
+	// Env are additional environment variables of the kernel process.
+	Env map[string]string `json:"env,omitempty"`
+
+	// Metadata are further details of the kernel, e.g. the debugger
+	// support.
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+}
+
+// Manager looks up the installed kernels. The directories are scanned on
+// every call, so kernels can be installed while the service runs.
+type Manager struct {
+	options Options
+}
+
+// New initializes a new kernel spec manager.
+func New(opts ...Option) *Manager {
+	return &Manager{
+		options: newOptions(opts...),
+	}
+}
+
+// Dirs returns the kernels directories in the order they are searched.
+func (m *Manager) Dirs() []string {
+	dirs := make([]string, 0, len(m.options.Paths)+len(m.options.DataPaths))
+	dirs = append(dirs, m.options.Paths...)
+
+	for _, dir := range m.options.DataPaths {
+		dirs = append(dirs, filepath.Join(dir, "kernels"))
+	}
+
+	return dirs
+}
+
+// List returns the installed kernels ordered by name. If several
+// directories contain a kernel with the same name the first one wins.
+// Directories which do not exist and kernels with an invalid kernel.json
+// are skipped.
+func (m *Manager) List() []*KernelSpec {
+	seen := map[string]bool{}
+	specs := []*KernelSpec{}
+
+	for _, dir := range m.Dirs() {
+		entries, err := ioutil.ReadDir(dir)
+
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name := strings.ToLower(entry.Name())
+
+			if seen[name] || !validName.MatchString(name) {
+				continue
+			}
+
+			spec, err := Load(filepath.Join(dir, entry.Name()))
+
+			if err != nil {
+				continue
+			}
+
+			seen[name] = true
+			specs = append(specs, spec)
+		}
+	}
+
+	sort.Slice(specs, func(i, j int) bool {
+		return specs[i].Name < specs[j].Name
+	})
+
+	return specs
+}
+
+// Get returns the kernel with the given name.
+func (m *Manager) Get(name string) (*KernelSpec, error) {
+	name = strings.ToLower(name)
+
+	if !validName.MatchString(name) {
+		return nil, ErrInvalidName
+	}
+
+	for _, spec := range m.List() {
+		if spec.Name == name {
+			return spec, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// Load reads the kernel in the given directory.
+func Load(dir string) (*KernelSpec, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, File))
+
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &KernelSpec{}
+
+	if err := json.Unmarshal(b, spec); err != nil {
+		return nil, fmt.Errorf("%s: %w", File, err)
+	}
+
+	if len(spec.Argv) == 0 {
+		return nil, fmt.Errorf("%s: argv is missing", File)
+	}
+
+	spec.Name = strings.ToLower(filepath.Base(dir))
+	spec.ResourceDir = dir
+	spec.Resources = map[string]string{}
+
+	if spec.DisplayName == "" {
+		spec.DisplayName = spec.Name
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() && resourceFiles.MatchString(entry.Name()) {
+			spec.Resources[strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))] = entry.Name()
+		}
+	}
+
+	return spec, nil
+}
+
+// Resource returns the path of a resource file of the kernel, only the
+// files listed in the resources can be requested.
+func (s *KernelSpec) Resource(file string) (string, error) {
+	for _, name := range s.Resources {
+		if name == file {
+			return filepath.Join(s.ResourceDir, name), nil
+		}
+	}
+
+	return "", ErrNotFound
+}
+
+// DataDirs returns the standard Jupyter data directories: the ones in
+// JUPYTER_PATH, the data directory of the user and the system wide ones.
+func DataDirs() []string {
+	dirs := filepath.SplitList(os.Getenv("JUPYTER_PATH"))
+
+	if dir := userDataDir(); dir != "" {
+		dirs = append(dirs, dir)
+	}
+
+	if runtime.GOOS == "windows" {
+		if programData := os.Getenv("PROGRAMDATA"); programData != "" {
+			dirs = append(dirs, filepath.Join(programData, "jupyter"))
+		}
+
+		return dirs
+	}
+
+	return append(dirs, "/usr/local/share/jupyter", "/usr/share/jupyter")
+}
+
+// userDataDir returns the Jupyter data directory of the user.
+func userDataDir() string {
+	if dir := os.Getenv("JUPYTER_DATA_DIR"); dir != "" {
+		return dir
+	}
+
+	home, err := os.UserHomeDir()
+
+	if err != nil {
+		return ""
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		return filepath.Join(home, "Library", "Jupyter")
+	case "windows":
+		if appData := os.Getenv("APPDATA"); appData != "" {
+			return filepath.Join(appData, "jupyter")
+		}
+
+		return ""
+	}
+
+	if dataHome := os.Getenv("XDG_DATA_HOME"); dataHome != "" {
+		return filepath.Join(dataHome, "jupyter")
+	}
+
+	return filepath.Join(home, ".local", "share", "jupyter")
+}
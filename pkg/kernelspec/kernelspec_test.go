@@ -0,0 +1,109 @@
+package kernelspec
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func manager() *Manager {
+	return New(
+		Paths("testdata/extra"),
+		DataPaths("testdata/missing", "testdata/data"),
+	)
+}
+
+func TestManager_Dirs(t *testing.T) {
+	assert.Equal(t, []string{
+		"testdata/extra",
+		filepath.Join("testdata/missing", "kernels"),
+		filepath.Join("testdata/data", "kernels"),
+	}, manager().Dirs())
+}
+
+func TestManager_List(t *testing.T) {
+	specs := manager().List()
+
+	names := []string{}
+
+	for _, spec := range specs {
+		names = append(names, spec.Name)
+	}
+
+	// Invalid kernels are skipped, the first python3 wins.
+	assert.Equal(t, []string{"ir", "python3"}, names)
+
+	ir, python := specs[0], specs[1]
+
+	assert.Equal(t, "R", ir.DisplayName)
+	assert.Equal(t, "message", ir.InterruptMode)
+	assert.Equal(t, map[string]string{"R_LIBS_USER": "/opt/R"}, ir.Env)
+	assert.Equal(t, map[string]string{"logo-svg": "logo-svg.svg"}, ir.Resources)
+
+	assert.Equal(t, "Python 3 (conda)", python.DisplayName)
+	assert.Equal(t, filepath.Join("testdata/extra", "python3"), python.ResourceDir)
+	assert.Equal(t, "/opt/conda/bin/python", python.Argv[0])
+	assert.JSONEq(t, `{"debugger": true}`, string(python.Metadata))
+	assert.Empty(t, python.Resources)
+}
+
+func TestManager_Get(t *testing.T) {
+	spec, err := manager().Get("IR")
+	assert.Nil(t, err)
+	assert.Equal(t, "ir", spec.Name)
+
+	_, err = manager().Get("broken")
+	assert.Equal(t, ErrNotFound, err)
+
+	_, err = manager().Get("../ir")
+	assert.Equal(t, ErrInvalidName, err)
+}
+
+func TestKernelSpec_Resource(t *testing.T) {
+	spec, err := New(DataPaths("testdata/data")).Get("python3")
+	assert.Nil(t, err)
+
+	p, err := spec.Resource("logo-64x64.png")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join("testdata/data/kernels/python3", "logo-64x64.png"), p)
+
+	_, err = spec.Resource("kernel.json")
+	assert.Equal(t, ErrNotFound, err)
+
+	ir, err := New(DataPaths("testdata/data")).Get("ir")
+	assert.Nil(t, err)
+
+	_, err = ir.Resource("README.txt")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestKernelSpec_JSON(t *testing.T) {
+	spec, err := Load("testdata/data/kernels/ir")
+	assert.Nil(t, err)
+
+	b, err := json.Marshal(spec)
+	assert.Nil(t, err)
+
+	assert.JSONEq(t, `{
+		"argv": ["R", "--slave", "-e", "IRkernel::main()", "--args", "{connection_file}"],
+		"display_name": "R",
+		"language": "R",
+		"interrupt_mode": "message",
+		"env": {"R_LIBS_USER": "/opt/R"}
+	}`, string(b))
+}
+
+func TestDataDirs(t *testing.T) {
+	defer os.Setenv("JUPYTER_PATH", os.Getenv("JUPYTER_PATH"))
+	defer os.Setenv("JUPYTER_DATA_DIR", os.Getenv("JUPYTER_DATA_DIR"))
+
+	os.Setenv("JUPYTER_PATH", "/srv/jupyter"+string(os.PathListSeparator)+"/opt/jupyter")
+	os.Setenv("JUPYTER_DATA_DIR", "/home/einstein/jupyter")
+
+	dirs := DataDirs()
+
+	assert.Equal(t, []string{"/srv/jupyter", "/opt/jupyter", "/home/einstein/jupyter"}, dirs[:3])
+}
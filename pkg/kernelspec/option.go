@@ -0,0 +1,44 @@
+package kernelspec
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	// Paths are additional kernels directories, searched before the ones
+	// in the Jupyter data directories. Each of them contains one directory
+	// per kernel, named like the kernel.
+	Paths []string
+
+	// DataPaths are the Jupyter data directories, their kernels
+	// subdirectories are searched in order.
+	DataPaths []string
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		DataPaths: DataDirs(),
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// Paths provides a function to set the paths option.
+func Paths(val ...string) Option {
+	return func(o *Options) {
+		o.Paths = append(o.Paths, val...)
+	}
+}
+
+// DataPaths provides a function to set the data paths option, which replaces
+// the standard Jupyter data directories.
+func DataPaths(val ...string) Option {
+	return func(o *Options) {
+		o.DataPaths = val
+	}
+}
@@ -0,0 +1,127 @@
+package merge
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+// mergeJSON merges the changes of a JSON value, nil stands for a missing
+// value. Objects are merged member by member, all other values are taken
+// from the side which changed them. If both sides changed a value
+// differently the preferred one is kept and a conflict is recorded.
+func (m *merger) mergeJSON(path string, base, local, remote json.RawMessage) json.RawMessage {
+	switch {
+	case equalJSON(local, remote), equalJSON(base, remote):
+		return local
+	case equalJSON(base, local):
+		return remote
+	}
+
+	b, l, r, ok := objects(base, local, remote)
+
+	if !ok {
+		m.conflict(path, base, local, remote)
+
+		if m.preferLocal {
+			return local
+		}
+
+		return remote
+	}
+
+	keys := l.Keys()
+
+	for _, key := range r.Keys() {
+		if !l.Has(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	merged := notebook.Metadata{}
+
+	for _, key := range keys {
+		x, _ := b.Raw(key)
+		y, _ := l.Raw(key)
+		z, _ := r.Raw(key)
+
+		if v := m.mergeJSON(path+"/"+escape(key), x, y, z); v != nil {
+			merged.SetRaw(key, v)
+		}
+	}
+
+	return marshal(merged)
+}
+
+// objects decodes three JSON objects, ok is false unless all of them are
+// objects.
+func objects(base, local, remote json.RawMessage) (b, l, r notebook.Metadata, ok bool) {
+	for _, v := range []struct {
+		raw json.RawMessage
+		obj *notebook.Metadata
+	}{{base, &b}, {local, &l}, {remote, &r}} {
+		if !bytes.HasPrefix(bytes.TrimSpace(v.raw), []byte("{")) {
+			return b, l, r, false
+		}
+
+		if err := json.Unmarshal(v.raw, v.obj); err != nil {
+			return b, l, r, false
+		}
+	}
+
+	return b, l, r, true
+}
+
+// equalJSON returns true if both documents encode the same value or are
+// both missing.
+func equalJSON(a, b json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if bytes.Equal(a, b) {
+		return true
+	}
+
+	var x, y interface{}
+
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(x, y)
+}
+
+// marshal returns the JSON of a value, null if it can not be encoded.
+func marshal(v interface{}) json.RawMessage {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return json.RawMessage("null")
+	}
+
+	return b
+}
+
+// compact removes insignificant whitespace of a JSON document.
+func compact(raw json.RawMessage) json.RawMessage {
+	if raw == nil {
+		return nil
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := json.Compact(buf, raw); err != nil {
+		return raw
+	}
+
+	return buf.Bytes()
+}
+
+// escape encodes a member name as reference token of a JSON Pointer.
+func escape(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
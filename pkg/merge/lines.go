@@ -0,0 +1,96 @@
+package merge
+
+import (
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/diff"
+)
+
+// Lines merges the changes of two texts split into lines which were both
+// derived from base. Changes of different lines are combined, for changes
+// of the same lines the preferred side is kept and ok is false.
+func Lines(base, local, remote []string, preferLocal bool) (merged []string, ok bool) {
+	toLocal := matchIndex(base, local)
+	toRemote := matchIndex(base, remote)
+
+	merged = []string{}
+	ok = true
+
+	i, j, k := 0, 0, 0
+
+	for i < len(base) || j < len(local) || k < len(remote) {
+		if i < len(base) && toLocal[i] == j && toRemote[i] == k {
+			merged = append(merged, local[j])
+			i, j, k = i+1, j+1, k+1
+			continue
+		}
+
+		// The next base line which is kept on both sides ends the chunk.
+		b, l, r := i, len(local), len(remote)
+
+		for ; b < len(base); b++ {
+			if toLocal[b] >= 0 && toRemote[b] >= 0 {
+				l, r = toLocal[b], toRemote[b]
+				break
+			}
+		}
+
+		baseChunk, localChunk, remoteChunk := base[i:b], local[j:l], remote[k:r]
+
+		switch {
+		case equalLines(localChunk, baseChunk):
+			merged = append(merged, remoteChunk...)
+		case equalLines(remoteChunk, baseChunk), equalLines(localChunk, remoteChunk):
+			merged = append(merged, localChunk...)
+		case preferLocal:
+			merged = append(merged, localChunk...)
+			ok = false
+		default:
+			merged = append(merged, remoteChunk...)
+			ok = false
+		}
+
+		i, j, k = b, l, r
+	}
+
+	// Lines which ended the text before may be followed by others now.
+	for n := 0; n < len(merged)-1; n++ {
+		if !strings.HasSuffix(merged[n], "\n") {
+			merged[n] += "\n"
+		}
+	}
+
+	return merged, ok
+}
+
+// matchIndex maps the lines of base to the matching lines of other, -1 if
+// they were removed.
+func matchIndex(base, other []string) []int {
+	index := make([]int, len(base))
+
+	for i := range index {
+		index[i] = -1
+	}
+
+	for _, match := range diff.Matches(base, other) {
+		index[match[0]] = match[1]
+	}
+
+	return index
+}
+
+// equalLines returns true if both texts have the same lines, ignoring
+// trailing newlines.
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if strings.TrimSuffix(a[i], "\n") != strings.TrimSuffix(b[i], "\n") {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,303 @@
+// Package merge combines the changes two sides made to a common base version
+// of a notebook, like a three-way merge of text files does. Cells are aligned
+// with the base by the diff package, so changes of different cells and of
+// different lines of the same cell are merged automatically. Changes which
+// overlap are reported as conflicts.
+package merge
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/diff"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+const (
+	// SideLocal marks the local version of a conflicting cell.
+	SideLocal = "local"
+
+	// SideRemote marks the remote version of a conflicting cell.
+	SideRemote = "remote"
+
+	// ConflictKey is the cell metadata key which marks conflict cells.
+	ConflictKey = "merge_conflict"
+)
+
+// Conflict describes a value both sides changed differently.
+type Conflict struct {
+	// Path is the JSON Pointer of the value in the merged notebook, e.g.
+	// /cells/3/source. Cells removed on one side are referenced by the
+	// position they would have.
+	Path string `json:"path"`
+
+	// Base, Local and Remote are the versions of the value as JSON, they
+	// are missing if the value does not exist in a version.
+	Base   json.RawMessage `json:"base,omitempty"`
+	Local  json.RawMessage `json:"local,omitempty"`
+	Remote json.RawMessage `json:"remote,omitempty"`
+}
+
+// Result is the outcome of a merge.
+type Result struct {
+	// Notebook is the merged notebook. Every value in it is taken from one
+	// of the merged notebooks, so it always encodes to valid JSON.
+	Notebook *notebook.Notebook
+
+	// Conflicts lists the values both sides changed differently, the
+	// notebook contains the local version of them unless conflict cells
+	// are enabled.
+	Conflicts []*Conflict
+}
+
+// Notebooks merges the changes from base to local and from base to remote.
+func Notebooks(base, local, remote *notebook.Notebook, opts ...Option) *Result {
+	options := newOptions(opts...)
+
+	m := &merger{
+		preferLocal: true,
+		conflicts:   []*Conflict{},
+	}
+
+	nb := *local
+	nb.Cells = []*notebook.Cell{}
+
+	if remote.NBFormatMinor > nb.NBFormatMinor {
+		nb.NBFormatMinor = remote.NBFormatMinor
+	}
+
+	_ = json.Unmarshal(m.mergeJSON("/metadata", marshal(base.Metadata), marshal(local.Metadata), marshal(remote.Metadata)), &nb.Metadata)
+
+	l, r := newSide(base, local), newSide(base, remote)
+
+	for k := 0; ; k++ {
+		nb.Cells = append(nb.Cells, insertCells(local.Cells, remote.Cells, l.inserts[k], r.inserts[k])...)
+
+		if k == len(base.Cells) {
+			break
+		}
+
+		path := "/cells/" + strconv.Itoa(len(nb.Cells))
+		x, y := l.matches[k], r.matches[k]
+
+		switch {
+		case x < 0 && y < 0:
+		case x < 0:
+			if r.ops[k] == diff.OpUnchanged {
+				continue
+			}
+
+			m.conflict(path, marshal(base.Cells[k]), nil, marshal(remote.Cells[y]))
+
+			if options.ConflictCells {
+				nb.Cells = append(nb.Cells, mark(remote.Cells[y], SideRemote))
+			}
+		case y < 0:
+			if l.ops[k] == diff.OpUnchanged {
+				continue
+			}
+
+			m.conflict(path, marshal(base.Cells[k]), marshal(local.Cells[x]), nil)
+			nb.Cells = append(nb.Cells, local.Cells[x])
+
+			if options.ConflictCells {
+				nb.Cells[len(nb.Cells)-1] = mark(local.Cells[x], SideLocal)
+			}
+		default:
+			conflicts := len(m.conflicts)
+			c := m.mergeCell(path, base.Cells[k], local.Cells[x], remote.Cells[y])
+
+			if !options.ConflictCells || len(m.conflicts) == conflicts {
+				nb.Cells = append(nb.Cells, c)
+				continue
+			}
+
+			other := &merger{}
+
+			nb.Cells = append(nb.Cells,
+				mark(c, SideLocal),
+				mark(other.mergeCell(path, base.Cells[k], local.Cells[x], remote.Cells[y]), SideRemote),
+			)
+		}
+	}
+
+	uniqueIDs(nb.Cells)
+
+	return &Result{
+		Notebook:  &nb,
+		Conflicts: m.conflicts,
+	}
+}
+
+// merger merges the values of a notebook and collects the conflicts.
+type merger struct {
+	// preferLocal keeps the local instead of the remote version of
+	// conflicting values.
+	preferLocal bool
+
+	conflicts []*Conflict
+}
+
+// conflict records a conflict.
+func (m *merger) conflict(path string, base, local, remote json.RawMessage) {
+	m.conflicts = append(m.conflicts, &Conflict{
+		Path:   path,
+		Base:   compact(base),
+		Local:  compact(local),
+		Remote: compact(remote),
+	})
+}
+
+// mergeCell merges the changes of a cell which exists on both sides.
+func (m *merger) mergeCell(path string, base, local, remote *notebook.Cell) *notebook.Cell {
+	c := *remote
+
+	if m.preferLocal {
+		c = *local
+	}
+
+	_ = json.Unmarshal(m.mergeJSON(path+"/cell_type", marshal(base.CellType), marshal(local.CellType), marshal(remote.CellType)), &c.CellType)
+	_ = json.Unmarshal(m.mergeJSON(path+"/id", marshal(base.ID), marshal(local.ID), marshal(remote.ID)), &c.ID)
+	_ = json.Unmarshal(m.mergeJSON(path+"/metadata", marshal(base.Metadata), marshal(local.Metadata), marshal(remote.Metadata)), &c.Metadata)
+
+	source, ok := Lines(base.Source.Lines(), local.Source.Lines(), remote.Source.Lines(), m.preferLocal)
+
+	if !ok {
+		m.conflict(path+"/source", marshal(base.Source), marshal(local.Source), marshal(remote.Source))
+	}
+
+	if text := strings.Join(source, ""); text != c.Source.String() {
+		c.Source = notebook.NewMultilineString(text)
+	}
+
+	if base.Attachments.Len()+local.Attachments.Len()+remote.Attachments.Len() > 0 {
+		_ = json.Unmarshal(m.mergeJSON(path+"/attachments", marshal(base.Attachments), marshal(local.Attachments), marshal(remote.Attachments)), &c.Attachments)
+	}
+
+	if c.CellType == notebook.CellTypeCode {
+		m.mergeOutputs(path, &c, base, local, remote)
+	}
+
+	return &c
+}
+
+// mergeOutputs takes the outputs and the execution count of a code cell
+// from the side which changed the outputs. Execution counts alone do not
+// count as change.
+func (m *merger) mergeOutputs(path string, c, base, local, remote *notebook.Cell) {
+	b, l, r := outputs(base), outputs(local), outputs(remote)
+
+	switch {
+	case equalJSON(l, r):
+		return
+	case equalJSON(b, l):
+		c.Outputs, c.ExecutionCount = remote.Outputs, remote.ExecutionCount
+	case equalJSON(b, r):
+		c.Outputs, c.ExecutionCount = local.Outputs, local.ExecutionCount
+	default:
+		m.conflict(path+"/outputs", marshal(base.Outputs), marshal(local.Outputs), marshal(remote.Outputs))
+	}
+}
+
+// side describes how the cells of base relate to the cells of one side.
+type side struct {
+	// matches are the indexes of the base cells on the side, -1 for
+	// removed cells, and ops the change of them.
+	matches []int
+	ops     []diff.Op
+
+	// inserts are the indexes of the cells added before each base cell,
+	// the last entry holds the cells added at the end.
+	inserts [][]int
+}
+
+// newSide aligns the cells of a side with the cells of base.
+func newSide(base, nb *notebook.Notebook) *side {
+	s := &side{
+		matches: make([]int, len(base.Cells)),
+		ops:     make([]diff.Op, len(base.Cells)),
+		inserts: make([][]int, len(base.Cells)+1),
+	}
+
+	next := 0
+
+	for _, c := range diff.Notebooks(base, nb).Cells {
+		if c.OldIndex < 0 {
+			s.inserts[next] = append(s.inserts[next], c.NewIndex)
+			continue
+		}
+
+		s.matches[c.OldIndex] = c.NewIndex
+		s.ops[c.OldIndex] = c.Op
+		next = c.OldIndex + 1
+	}
+
+	return s
+}
+
+// insertCells returns the cells both sides added at the same position, the
+// local ones first. Cells added on both sides are only taken once.
+func insertCells(local, remote []*notebook.Cell, l, r []int) []*notebook.Cell {
+	cells := make([]*notebook.Cell, 0, len(l)+len(r))
+
+	for _, i := range l {
+		cells = append(cells, local[i])
+	}
+
+next:
+	for _, j := range r {
+		for _, i := range l {
+			if equalJSON(marshal(local[i]), marshal(remote[j])) {
+				continue next
+			}
+		}
+
+		cells = append(cells, remote[j])
+	}
+
+	return cells
+}
+
+// mark returns a copy of a conflicting cell marked with the side it belongs
+// to.
+func mark(cell *notebook.Cell, side string) *notebook.Cell {
+	c := *cell
+	c.Metadata = c.Metadata.Clone()
+	_ = c.Metadata.Set(ConflictKey, map[string]string{"side": side})
+
+	return &c
+}
+
+// uniqueIDs replaces the ids which are used by several cells, e.g. by both
+// versions of a conflicting cell.
+func uniqueIDs(cells []*notebook.Cell) {
+	seen := make(map[string]bool, len(cells))
+
+	for i, cell := range cells {
+		if cell.ID == "" {
+			continue
+		}
+
+		if seen[cell.ID] {
+			c := *cell
+			c.ID = notebook.NewCellID()
+			cells[i] = &c
+		}
+
+		seen[cells[i].ID] = true
+	}
+}
+
+// outputs returns the JSON of the outputs of a cell without execution counts.
+func outputs(c *notebook.Cell) json.RawMessage {
+	outputs := make([]notebook.Output, 0, len(c.Outputs))
+
+	for _, o := range c.Outputs {
+		output := *o
+		output.ExecutionCount = nil
+		outputs = append(outputs, output)
+	}
+
+	return marshal(outputs)
+}
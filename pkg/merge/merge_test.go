@@ -0,0 +1,159 @@
+package merge
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/stretchr/testify/assert"
+)
+
+func cells(sources ...string) *notebook.Notebook {
+	nb := notebook.New()
+
+	for _, source := range sources {
+		nb.Cells = append(nb.Cells, notebook.NewCodeCell(source))
+	}
+
+	return nb
+}
+
+func sources(nb *notebook.Notebook) []string {
+	s := []string{}
+
+	for _, c := range nb.Cells {
+		s = append(s, c.Source.String())
+	}
+
+	return s
+}
+
+func TestLines(t *testing.T) {
+	base := []string{"a\n", "b\n", "c\n", "d"}
+
+	merged, ok := Lines(base,
+		[]string{"A\n", "b\n", "c\n", "d"},
+		[]string{"a\n", "b\n", "c\n", "d\n", "e"},
+		true,
+	)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"A\n", "b\n", "c\n", "d\n", "e"}, merged)
+
+	merged, ok = Lines(base,
+		[]string{"a\n", "B\n", "c\n", "d"},
+		[]string{"a\n", "x\n", "c\n", "d"},
+		true,
+	)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"a\n", "B\n", "c\n", "d"}, merged)
+
+	merged, ok = Lines(base,
+		[]string{"a\n", "B\n", "c\n", "d"},
+		[]string{"a\n", "x\n", "c\n", "d"},
+		false,
+	)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"a\n", "x\n", "c\n", "d"}, merged)
+
+	merged, ok = Lines(base, []string{"a\n", "c\n", "d"}, []string{"a\n", "c\n", "d"}, true)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a\n", "c\n", "d"}, merged)
+}
+
+func TestNotebooks(t *testing.T) {
+	base := cells("import os", "x = 1\nz = 0\ny = 2", "print(x)", "old()")
+	local := cells("import os", "x = 10\nz = 0\ny = 2", "print(x)", "old()", "local()")
+	remote := cells("import os", "x = 1\nz = 0\ny = 20", "print(x)", "old()", "remote()")
+
+	_ = local.Metadata.Set("kernelspec", map[string]string{"name": "python3", "display_name": "Python 3"})
+	_ = remote.Metadata.Set("authors", []string{"alice"})
+
+	res := Notebooks(base, local, remote)
+	assert.Empty(t, res.Conflicts)
+	assert.Equal(t, []string{"import os", "x = 10\nz = 0\ny = 20", "print(x)", "old()", "local()", "remote()"}, sources(res.Notebook))
+	assert.True(t, res.Notebook.Metadata.Has("kernelspec"))
+	assert.True(t, res.Notebook.Metadata.Has("authors"))
+
+	// Cells removed on one side and inserted on both sides are merged.
+	res = Notebooks(base, cells("import os", "x = 1\nz = 0\ny = 2", "new()", "print(x)"), cells("x = 1\nz = 0\ny = 2", "new()", "print(x)", "old()"))
+	assert.Empty(t, res.Conflicts)
+	assert.Equal(t, []string{"x = 1\nz = 0\ny = 2", "new()", "print(x)"}, sources(res.Notebook))
+}
+
+func TestNotebooks_Conflicts(t *testing.T) {
+	base := cells("import os", "x = 1\nz = 0", "print(x)\nprint(z)", "end()")
+	local := cells("import os", "x = 2\nz = 0", "print(x)\nprint(z)\nprint(y)", "end()")
+	remote := cells("import os", "x = 3\nz = 0", "end()")
+
+	remote.Cells[1].Outputs = []*notebook.Output{{OutputType: notebook.OutputTypeStream, Name: "stdout", Text: notebook.NewMultilineString("3")}}
+
+	res := Notebooks(base, local, remote)
+	assert.Equal(t, []string{"import os", "x = 2\nz = 0", "print(x)\nprint(z)\nprint(y)", "end()"}, sources(res.Notebook))
+
+	if assert.Len(t, res.Conflicts, 2) {
+		assert.Equal(t, &Conflict{
+			Path:   "/cells/1/source",
+			Base:   json.RawMessage(`["x = 1\n","z = 0"]`),
+			Local:  json.RawMessage(`["x = 2\n","z = 0"]`),
+			Remote: json.RawMessage(`["x = 3\n","z = 0"]`),
+		}, res.Conflicts[0])
+
+		assert.Equal(t, "/cells/2", res.Conflicts[1].Path)
+		assert.NotNil(t, res.Conflicts[1].Local)
+		assert.Nil(t, res.Conflicts[1].Remote)
+	}
+
+	// The output added remotely is merged despite the conflicting source.
+	assert.Len(t, res.Notebook.Cells[1].Outputs, 1)
+
+	res = Notebooks(base, local, remote, ConflictCells(true))
+	assert.Len(t, res.Conflicts, 2)
+	assert.Equal(t, []string{"import os", "x = 2\nz = 0", "x = 3\nz = 0", "print(x)\nprint(z)\nprint(y)", "end()"}, sources(res.Notebook))
+
+	for i, side := range map[int]string{1: SideLocal, 2: SideRemote, 3: SideLocal} {
+		var marker map[string]string
+
+		ok, err := res.Notebook.Cells[i].Metadata.Get(ConflictKey, &marker)
+		assert.True(t, ok)
+		assert.Nil(t, err)
+		assert.Equal(t, side, marker["side"])
+	}
+
+	assert.False(t, res.Notebook.Cells[4].Metadata.Has(ConflictKey))
+
+	b, err := res.Notebook.Marshal()
+	assert.Nil(t, err)
+	assert.True(t, json.Valid(b))
+}
+
+func TestNotebooks_Metadata(t *testing.T) {
+	base, local, remote := cells("a()"), cells("a()"), cells("a()")
+
+	_ = base.Metadata.Set("kernelspec", map[string]string{"name": "python3", "display_name": "Python 3"})
+	_ = local.Metadata.Set("kernelspec", map[string]string{"name": "python3", "display_name": "Python 3.9"})
+	_ = remote.Metadata.Set("kernelspec", map[string]string{"name": "ir", "display_name": "R"})
+
+	res := Notebooks(base, local, remote)
+	assert.Equal(t, []*Conflict{{
+		Path:   "/metadata/kernelspec/display_name",
+		Base:   json.RawMessage(`"Python 3"`),
+		Local:  json.RawMessage(`"Python 3.9"`),
+		Remote: json.RawMessage(`"R"`),
+	}}, res.Conflicts)
+
+	spec := res.Notebook.KernelSpec()
+	assert.Equal(t, "ir", spec.Name)
+	assert.Equal(t, "Python 3.9", spec.DisplayName)
+
+	// Cell ids stay unique if both versions of a cell are kept.
+	base.Cells[0].ID, local.Cells[0].ID, remote.Cells[0].ID = "a", "a", "a"
+	local.Cells[0].Source = notebook.NewMultilineString("b()")
+	remote.Cells[0].Source = notebook.NewMultilineString("c()")
+
+	res = Notebooks(base, local, remote, ConflictCells(true))
+
+	if assert.Len(t, res.Notebook.Cells, 2) {
+		assert.Equal(t, "a", res.Notebook.Cells[0].ID)
+		assert.NotEqual(t, "a", res.Notebook.Cells[1].ID)
+	}
+}
@@ -0,0 +1,30 @@
+package merge
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	// ConflictCells keeps both versions of a conflicting cell in the merged
+	// notebook, marked in their metadata. Otherwise the local version is
+	// kept and the conflicts are only listed.
+	ConflictCells bool
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// ConflictCells provides a function to set the conflict cells option.
+func ConflictCells(val bool) Option {
+	return func(o *Options) {
+		o.ConflictCells = val
+	}
+}
@@ -0,0 +1,64 @@
+package messaging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+)
+
+// ConnectionInfo is the content of a connection file, which tells a kernel
+// where to listen and how to sign messages.
+type ConnectionInfo struct {
+	Transport       string `json:"transport"`
+	IP              string `json:"ip"`
+	ShellPort       int    `json:"shell_port"`
+	IOPubPort       int    `json:"iopub_port"`
+	StdinPort       int    `json:"stdin_port"`
+	ControlPort     int    `json:"control_port"`
+	HBPort          int    `json:"hb_port"`
+	Key             string `json:"key"`
+	SignatureScheme string `json:"signature_scheme"`
+	KernelName      string `json:"kernel_name,omitempty"`
+}
+
+// ReadConnectionFile reads the connection file at the given path.
+func ReadConnectionFile(path string) (*ConnectionInfo, error) {
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ConnectionInfo{}
+
+	if err := json.Unmarshal(b, info); err != nil {
+		return nil, fmt.Errorf("connection file: %w", err)
+	}
+
+	return info, nil
+}
+
+// Write writes the connection file to the given path, only readable by the
+// owner as it holds the signing key.
+func (c *ConnectionInfo) Write(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b, 0600)
+}
+
+// Session returns a new session signing messages with the key of the
+// connection file.
+func (c *ConnectionInfo) Session(opts ...Option) (*Session, error) {
+	return NewSession(c.Key, c.SignatureScheme, opts...)
+}
+
+// Address returns the address of one of the ports, e.g.
+// tcp://127.0.0.1:52317.
+func (c *ConnectionInfo) Address(port int) string {
+	return c.Transport + "://" + c.IP + ":" + strconv.Itoa(port)
+}
@@ -0,0 +1,301 @@
+package messaging
+
+import "encoding/json"
+
+// Message types of the shell, control, stdin and IOPub channels.
+const (
+	MsgExecuteRequest    = "execute_request"
+	MsgExecuteReply      = "execute_reply"
+	MsgCompleteRequest   = "complete_request"
+	MsgCompleteReply     = "complete_reply"
+	MsgInspectRequest    = "inspect_request"
+	MsgInspectReply      = "inspect_reply"
+	MsgKernelInfoRequest = "kernel_info_request"
+	MsgKernelInfoReply   = "kernel_info_reply"
+	MsgCommOpen          = "comm_open"
+	MsgCommMsg           = "comm_msg"
+	MsgCommClose         = "comm_close"
+	MsgCommInfoRequest   = "comm_info_request"
+	MsgCommInfoReply     = "comm_info_reply"
+	MsgInputRequest      = "input_request"
+	MsgInputReply        = "input_reply"
+	MsgInterruptRequest  = "interrupt_request"
+	MsgInterruptReply    = "interrupt_reply"
+	MsgShutdownRequest   = "shutdown_request"
+	MsgShutdownReply     = "shutdown_reply"
+	MsgStatus            = "status"
+	MsgStream            = "stream"
+	MsgExecuteInput      = "execute_input"
+	MsgExecuteResult     = "execute_result"
+	MsgDisplayData       = "display_data"
+	MsgError             = "error"
+)
+
+// Statuses of replies.
+const (
+	StatusOK      = "ok"
+	StatusError   = "error"
+	StatusAborted = "aborted"
+)
+
+// Execution states of status messages.
+const (
+	StateStarting = "starting"
+	StateIdle     = "idle"
+	StateBusy     = "busy"
+)
+
+// contents creates the empty contents of the message types.
+var contents = map[string]func() interface{}{
+	MsgExecuteRequest:    func() interface{} { return &ExecuteRequest{} },
+	MsgExecuteReply:      func() interface{} { return &ExecuteReply{} },
+	MsgCompleteRequest:   func() interface{} { return &CompleteRequest{} },
+	MsgCompleteReply:     func() interface{} { return &CompleteReply{} },
+	MsgInspectRequest:    func() interface{} { return &InspectRequest{} },
+	MsgInspectReply:      func() interface{} { return &InspectReply{} },
+	MsgKernelInfoRequest: func() interface{} { return &KernelInfoRequest{} },
+	MsgKernelInfoReply:   func() interface{} { return &KernelInfoReply{} },
+	MsgCommOpen:          func() interface{} { return &CommOpen{} },
+	MsgCommMsg:           func() interface{} { return &CommMsg{} },
+	MsgCommClose:         func() interface{} { return &CommClose{} },
+	MsgCommInfoRequest:   func() interface{} { return &CommInfoRequest{} },
+	MsgCommInfoReply:     func() interface{} { return &CommInfoReply{} },
+	MsgInputRequest:      func() interface{} { return &InputRequest{} },
+	MsgInputReply:        func() interface{} { return &InputReply{} },
+	MsgInterruptRequest:  func() interface{} { return &InterruptRequest{} },
+	MsgInterruptReply:    func() interface{} { return &InterruptReply{} },
+	MsgShutdownRequest:   func() interface{} { return &ShutdownRequest{} },
+	MsgShutdownReply:     func() interface{} { return &ShutdownReply{} },
+	MsgStatus:            func() interface{} { return &Status{} },
+	MsgStream:            func() interface{} { return &Stream{} },
+	MsgExecuteInput:      func() interface{} { return &ExecuteInput{} },
+	MsgExecuteResult:     func() interface{} { return &ExecuteResult{} },
+	MsgDisplayData:       func() interface{} { return &DisplayData{} },
+	MsgError:             func() interface{} { return &Error{} },
+}
+
+// TypedContent decodes the content of a message into the struct of its type,
+// e.g. *ExecuteReply for execute_reply. Unknown types are decoded into a
+// map.
+func (m *Message) TypedContent() (interface{}, error) {
+	newContent, ok := contents[m.Header.MsgType]
+
+	if !ok {
+		v := map[string]interface{}{}
+		return v, m.DecodeContent(&v)
+	}
+
+	v := newContent()
+	return v, m.DecodeContent(v)
+}
+
+// Reply holds the fields all replies share, the error details are only set
+// for the error status.
+type Reply struct {
+	Status    string   `json:"status"`
+	EName     string   `json:"ename,omitempty"`
+	EValue    string   `json:"evalue,omitempty"`
+	Traceback []string `json:"traceback,omitempty"`
+}
+
+// ExecuteRequest asks the kernel to execute code.
+type ExecuteRequest struct {
+	Code            string            `json:"code"`
+	Silent          bool              `json:"silent"`
+	StoreHistory    bool              `json:"store_history"`
+	UserExpressions map[string]string `json:"user_expressions"`
+	AllowStdin      bool              `json:"allow_stdin"`
+	StopOnError     bool              `json:"stop_on_error"`
+}
+
+// MarshalJSON encodes missing user expressions as empty object, kernels
+// expect an object.
+func (r ExecuteRequest) MarshalJSON() ([]byte, error) {
+	type request ExecuteRequest
+
+	if r.UserExpressions == nil {
+		r.UserExpressions = map[string]string{}
+	}
+
+	return json.Marshal(request(r))
+}
+
+// ExecuteReply is the outcome of an execute request.
+type ExecuteReply struct {
+	Reply
+	ExecutionCount  int                      `json:"execution_count"`
+	Payload         []map[string]interface{} `json:"payload,omitempty"`
+	UserExpressions map[string]interface{}   `json:"user_expressions,omitempty"`
+}
+
+// CompleteRequest asks for completions at the cursor position, which counts
+// unicode code points.
+type CompleteRequest struct {
+	Code      string `json:"code"`
+	CursorPos int    `json:"cursor_pos"`
+}
+
+// CompleteReply lists the completions of the text between cursor start and
+// end.
+type CompleteReply struct {
+	Reply
+	Matches     []string               `json:"matches"`
+	CursorStart int                    `json:"cursor_start"`
+	CursorEnd   int                    `json:"cursor_end"`
+	Metadata    map[string]interface{} `json:"metadata"`
+}
+
+// InspectRequest asks for information about the code at the cursor
+// position, detail level 0 or 1.
+type InspectRequest struct {
+	Code        string `json:"code"`
+	CursorPos   int    `json:"cursor_pos"`
+	DetailLevel int    `json:"detail_level"`
+}
+
+// InspectReply holds the information by MIME type, if any was found.
+type InspectReply struct {
+	Reply
+	Found    bool                   `json:"found"`
+	Data     map[string]interface{} `json:"data"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+// KernelInfoRequest asks for the details of the kernel.
+type KernelInfoRequest struct{}
+
+// KernelInfoReply describes the kernel and its language.
+type KernelInfoReply struct {
+	Reply
+	ProtocolVersion       string       `json:"protocol_version"`
+	Implementation        string       `json:"implementation"`
+	ImplementationVersion string       `json:"implementation_version"`
+	LanguageInfo          LanguageInfo `json:"language_info"`
+	Banner                string       `json:"banner"`
+	Debugger              bool         `json:"debugger,omitempty"`
+	HelpLinks             []HelpLink   `json:"help_links,omitempty"`
+}
+
+// LanguageInfo describes the language of a kernel, the CodeMirror mode is
+// either a name or an object.
+type LanguageInfo struct {
+	Name              string      `json:"name"`
+	Version           string      `json:"version"`
+	MIMEType          string      `json:"mimetype"`
+	FileExtension     string      `json:"file_extension"`
+	PygmentsLexer     string      `json:"pygments_lexer,omitempty"`
+	CodemirrorMode    interface{} `json:"codemirror_mode,omitempty"`
+	NBConvertExporter string      `json:"nbconvert_exporter,omitempty"`
+}
+
+// HelpLink is a link to the documentation of a kernel.
+type HelpLink struct {
+	Text string `json:"text"`
+	URL  string `json:"url"`
+}
+
+// CommOpen opens a comm between frontend and kernel.
+type CommOpen struct {
+	CommID       string                 `json:"comm_id"`
+	TargetName   string                 `json:"target_name"`
+	TargetModule string                 `json:"target_module,omitempty"`
+	Data         map[string]interface{} `json:"data"`
+}
+
+// CommMsg sends data through an open comm.
+type CommMsg struct {
+	CommID string                 `json:"comm_id"`
+	Data   map[string]interface{} `json:"data"`
+}
+
+// CommClose closes a comm.
+type CommClose struct {
+	CommID string                 `json:"comm_id"`
+	Data   map[string]interface{} `json:"data"`
+}
+
+// CommInfoRequest asks for the open comms, of all targets if the target
+// name is empty.
+type CommInfoRequest struct {
+	TargetName string `json:"target_name,omitempty"`
+}
+
+// CommInfoReply lists the open comms by their id.
+type CommInfoReply struct {
+	Reply
+	Comms map[string]CommInfo `json:"comms"`
+}
+
+// CommInfo describes an open comm.
+type CommInfo struct {
+	TargetName string `json:"target_name"`
+}
+
+// InputRequest asks the frontend for input on the stdin channel.
+type InputRequest struct {
+	Prompt   string `json:"prompt"`
+	Password bool   `json:"password"`
+}
+
+// InputReply is the input of the user.
+type InputReply struct {
+	Value string `json:"value"`
+}
+
+// InterruptRequest interrupts a kernel with the message interrupt mode.
+type InterruptRequest struct{}
+
+// InterruptReply confirms an interrupt.
+type InterruptReply struct {
+	Reply
+}
+
+// ShutdownRequest asks the kernel to stop, or to prepare a restart.
+type ShutdownRequest struct {
+	Restart bool `json:"restart"`
+}
+
+// ShutdownReply confirms a shutdown.
+type ShutdownReply struct {
+	Reply
+	Restart bool `json:"restart"`
+}
+
+// Status announces the execution state of the kernel on the IOPub channel.
+type Status struct {
+	ExecutionState string `json:"execution_state"`
+}
+
+// Stream is output of the kernel to stdout or stderr.
+type Stream struct {
+	Name string `json:"name"`
+	Text string `json:"text"`
+}
+
+// ExecuteInput broadcasts the code of an execute request.
+type ExecuteInput struct {
+	Code           string `json:"code"`
+	ExecutionCount int    `json:"execution_count"`
+}
+
+// ExecuteResult is the result of executed code by MIME type.
+type ExecuteResult struct {
+	ExecutionCount int                    `json:"execution_count"`
+	Data           map[string]interface{} `json:"data"`
+	Metadata       map[string]interface{} `json:"metadata"`
+}
+
+// DisplayData is output displayed by executed code, transient data like
+// the display id is not stored in notebooks.
+type DisplayData struct {
+	Data      map[string]interface{} `json:"data"`
+	Metadata  map[string]interface{} `json:"metadata"`
+	Transient map[string]interface{} `json:"transient,omitempty"`
+}
+
+// Error is an exception raised by executed code.
+type Error struct {
+	EName     string   `json:"ename"`
+	EValue    string   `json:"evalue"`
+	Traceback []string `json:"traceback"`
+}
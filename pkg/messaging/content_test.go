@@ -0,0 +1,130 @@
+package messaging
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessage_TypedContent(t *testing.T) {
+	tests := []struct {
+		msgType  string
+		content  string
+		expected interface{}
+	}{
+		{
+			msgType: MsgKernelInfoReply,
+			content: `{
+				"status": "ok",
+				"protocol_version": "5.3",
+				"implementation": "ipython",
+				"implementation_version": "7.19.0",
+				"language_info": {
+					"name": "python",
+					"version": "3.8.5",
+					"mimetype": "text/x-python",
+					"codemirror_mode": {"name": "ipython", "version": 3},
+					"pygments_lexer": "ipython3",
+					"nbconvert_exporter": "python",
+					"file_extension": ".py"
+				},
+				"banner": "Python 3.8.5",
+				"help_links": [{"text": "Python Reference", "url": "https://docs.python.org/3.8"}]
+			}`,
+			expected: &KernelInfoReply{
+				Reply:                 Reply{Status: StatusOK},
+				ProtocolVersion:       "5.3",
+				Implementation:        "ipython",
+				ImplementationVersion: "7.19.0",
+				LanguageInfo: LanguageInfo{
+					Name:              "python",
+					Version:           "3.8.5",
+					MIMEType:          "text/x-python",
+					FileExtension:     ".py",
+					PygmentsLexer:     "ipython3",
+					CodemirrorMode:    map[string]interface{}{"name": "ipython", "version": float64(3)},
+					NBConvertExporter: "python",
+				},
+				Banner:    "Python 3.8.5",
+				HelpLinks: []HelpLink{{Text: "Python Reference", URL: "https://docs.python.org/3.8"}},
+			},
+		},
+		{
+			msgType: MsgCompleteReply,
+			content: `{"status": "ok", "matches": ["print", "property"], "cursor_start": 0, "cursor_end": 2, "metadata": {}}`,
+			expected: &CompleteReply{
+				Reply:     Reply{Status: StatusOK},
+				Matches:   []string{"print", "property"},
+				CursorEnd: 2,
+				Metadata:  map[string]interface{}{},
+			},
+		},
+		{
+			msgType: MsgExecuteReply,
+			content: `{"status": "error", "execution_count": 2, "ename": "NameError", "evalue": "name 'x' is not defined", "traceback": ["NameError"]}`,
+			expected: &ExecuteReply{
+				Reply: Reply{
+					Status:    StatusError,
+					EName:     "NameError",
+					EValue:    "name 'x' is not defined",
+					Traceback: []string{"NameError"},
+				},
+				ExecutionCount: 2,
+			},
+		},
+		{
+			msgType:  MsgCommOpen,
+			content:  `{"comm_id": "c1", "target_name": "jupyter.widget", "data": {"state": {}}}`,
+			expected: &CommOpen{CommID: "c1", TargetName: "jupyter.widget", Data: map[string]interface{}{"state": map[string]interface{}{}}},
+		},
+		{
+			msgType:  MsgInputRequest,
+			content:  `{"prompt": "Password: ", "password": true}`,
+			expected: &InputRequest{Prompt: "Password: ", Password: true},
+		},
+		{
+			msgType:  MsgStatus,
+			content:  `{"execution_state": "busy"}`,
+			expected: &Status{ExecutionState: StateBusy},
+		},
+		{
+			msgType:  MsgKernelInfoRequest,
+			content:  ``,
+			expected: &KernelInfoRequest{},
+		},
+		{
+			msgType:  "debug_request",
+			content:  `{"command": "initialize"}`,
+			expected: map[string]interface{}{"command": "initialize"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msgType, func(t *testing.T) {
+			m := &Message{
+				Header:  Header{MsgType: tt.msgType},
+				Content: json.RawMessage(tt.content),
+			}
+
+			content, err := m.TypedContent()
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, content)
+		})
+	}
+}
+
+func TestExecuteRequest_JSON(t *testing.T) {
+	b, err := json.Marshal(&ExecuteRequest{Code: "1 + 1", StoreHistory: true, StopOnError: true})
+	assert.Nil(t, err)
+
+	// Jupyter requires every field of execute requests.
+	assert.JSONEq(t, `{
+		"code": "1 + 1",
+		"silent": false,
+		"store_history": true,
+		"user_expressions": {},
+		"allow_stdin": false,
+		"stop_on_error": true
+	}`, string(b))
+}
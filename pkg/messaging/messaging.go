@@ -0,0 +1,327 @@
+// Package messaging implements the wire format of the Jupyter messaging
+// protocol. Every message is sent as multipart message: the routing
+// identities, the <IDS|MSG> delimiter, the HMAC signature, the header, the
+// parent header, the metadata and the content as JSON, followed by binary
+// buffers. Messages are signed with the key of the connection file of the
+// kernel.
+package messaging
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Version is the implemented protocol version.
+	Version = "5.3"
+
+	// Delimiter separates the identities from the message.
+	Delimiter = "<IDS|MSG>"
+
+	// DefaultScheme is the signature scheme of Jupyter.
+	DefaultScheme = "hmac-sha256"
+
+	// dateFormat is the ISO 8601 format of Jupyter for header dates.
+	dateFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+var (
+	// ErrMissingDelimiter defines the error if a multipart message has no
+	// delimiter.
+	ErrMissingDelimiter = errors.New("missing message delimiter")
+
+	// ErrIncomplete defines the error if a multipart message lacks any of
+	// the signature, header, parent header, metadata or content.
+	ErrIncomplete = errors.New("incomplete message")
+
+	// ErrInvalidSignature defines the error if the signature of a message
+	// does not match its content.
+	ErrInvalidSignature = errors.New("invalid message signature")
+
+	// ErrUnsupportedScheme defines the error if a signature scheme is
+	// unknown.
+	ErrUnsupportedScheme = errors.New("unsupported signature scheme")
+
+	// ErrUnsupportedVersion defines the error if a message uses a protocol
+	// version before 5.3.
+	ErrUnsupportedVersion = errors.New("unsupported protocol version")
+
+	// schemes are the hash functions of the signature schemes.
+	schemes = map[string]func() hash.Hash{
+		"hmac-sha1":   sha1.New,
+		"hmac-sha224": sha256.New224,
+		"hmac-sha256": sha256.New,
+		"hmac-sha384": sha512.New384,
+		"hmac-sha512": sha512.New,
+	}
+
+	// empty is the JSON of missing parent headers, metadata and contents.
+	empty = []byte("{}")
+)
+
+// Header identifies a message.
+type Header struct {
+	MsgID    string `json:"msg_id"`
+	Session  string `json:"session"`
+	Username string `json:"username"`
+
+	// Date is the time the message was created, in ISO 8601 format.
+	Date    string `json:"date"`
+	MsgType string `json:"msg_type"`
+	Version string `json:"version"`
+}
+
+// Message is a decoded message of the Jupyter messaging protocol.
+type Message struct {
+	// Identities are the routing prefixes of the message, replies are sent
+	// with the identities of the request.
+	Identities [][]byte
+
+	Header Header
+
+	// ParentHeader is the header of the message this one replies to, nil if
+	// it does not reply to any.
+	ParentHeader *Header
+
+	Metadata map[string]interface{}
+
+	// Content is the JSON of the content, its structure depends on the
+	// message type.
+	Content json.RawMessage
+
+	Buffers [][]byte
+}
+
+// DecodeContent decodes the content into v.
+func (m *Message) DecodeContent(v interface{}) error {
+	content := m.Content
+
+	if len(content) == 0 {
+		content = empty
+	}
+
+	return json.Unmarshal(content, v)
+}
+
+// Session creates, signs and verifies the messages of one client or kernel.
+type Session struct {
+	options Options
+	key     []byte
+	hash    func() hash.Hash
+}
+
+// NewSession initializes a new session signing messages with the key and
+// the scheme of a connection file. Messages are neither signed nor verified
+// with an empty key.
+func NewSession(key, scheme string, opts ...Option) (*Session, error) {
+	if scheme == "" {
+		scheme = DefaultScheme
+	}
+
+	h, ok := schemes[scheme]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedScheme, scheme)
+	}
+
+	return &Session{
+		options: newOptions(opts...),
+		key:     []byte(key),
+		hash:    h,
+	}, nil
+}
+
+// ID returns the id of the session.
+func (s *Session) ID() string {
+	return s.options.ID
+}
+
+// NewMessage returns a new message of the given type, which replies to the
+// parent unless it is nil.
+func (s *Session) NewMessage(msgType string, content interface{}, parent *Message) (*Message, error) {
+	m := &Message{
+		Header: Header{
+			MsgID:    NewID(),
+			Session:  s.options.ID,
+			Username: s.options.Username,
+			Date:     time.Now().UTC().Format(dateFormat),
+			MsgType:  msgType,
+			Version:  Version,
+		},
+		Metadata: map[string]interface{}{},
+		Content:  empty,
+	}
+
+	if content != nil {
+		b, err := json.Marshal(content)
+
+		if err != nil {
+			return nil, fmt.Errorf("content: %w", err)
+		}
+
+		m.Content = b
+	}
+
+	if parent != nil {
+		header := parent.Header
+		m.ParentHeader = &header
+		m.Identities = parent.Identities
+	}
+
+	return m, nil
+}
+
+// Encode returns the frames of a message, with its signature.
+func (s *Session) Encode(m *Message) ([][]byte, error) {
+	parts := make([][]byte, 4)
+	var err error
+
+	if parts[0], err = json.Marshal(m.Header); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	parts[1] = empty
+
+	if m.ParentHeader != nil {
+		if parts[1], err = json.Marshal(m.ParentHeader); err != nil {
+			return nil, fmt.Errorf("parent header: %w", err)
+		}
+	}
+
+	parts[2] = empty
+
+	if m.Metadata != nil {
+		if parts[2], err = json.Marshal(m.Metadata); err != nil {
+			return nil, fmt.Errorf("metadata: %w", err)
+		}
+	}
+
+	parts[3] = empty
+
+	if len(m.Content) > 0 {
+		parts[3] = m.Content
+	}
+
+	frames := make([][]byte, 0, len(m.Identities)+6+len(m.Buffers))
+	frames = append(frames, m.Identities...)
+	frames = append(frames, []byte(Delimiter), []byte(s.Sign(parts...)))
+	frames = append(frames, parts...)
+	frames = append(frames, m.Buffers...)
+
+	return frames, nil
+}
+
+// Decode verifies the signature of the frames of a message and decodes it.
+func (s *Session) Decode(frames [][]byte) (*Message, error) {
+	i := 0
+
+	for i < len(frames) && !bytes.Equal(frames[i], []byte(Delimiter)) {
+		i++
+	}
+
+	if i == len(frames) {
+		return nil, ErrMissingDelimiter
+	}
+
+	if len(frames) < i+6 {
+		return nil, ErrIncomplete
+	}
+
+	parts := frames[i+2 : i+6]
+
+	if len(s.key) > 0 && !hmac.Equal([]byte(s.Sign(parts...)), frames[i+1]) {
+		return nil, ErrInvalidSignature
+	}
+
+	m := &Message{
+		Identities: frames[:i],
+		Content:    json.RawMessage(parts[3]),
+		Buffers:    frames[i+6:],
+	}
+
+	if err := json.Unmarshal(parts[0], &m.Header); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	if !Supported(m.Header.Version) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedVersion, m.Header.Version)
+	}
+
+	parent := &Header{}
+
+	if err := json.Unmarshal(parts[1], parent); err != nil {
+		return nil, fmt.Errorf("parent header: %w", err)
+	}
+
+	if *parent != (Header{}) {
+		m.ParentHeader = parent
+	}
+
+	if err := json.Unmarshal(parts[2], &m.Metadata); err != nil {
+		return nil, fmt.Errorf("metadata: %w", err)
+	}
+
+	return m, nil
+}
+
+// Sign returns the hex encoded HMAC of the header, parent header, metadata
+// and content of a message. It is empty if the session has no key.
+func (s *Session) Sign(parts ...[]byte) string {
+	if len(s.key) == 0 {
+		return ""
+	}
+
+	mac := hmac.New(s.hash, s.key)
+
+	for _, p := range parts {
+		_, _ = mac.Write(p)
+	}
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Supported returns whether messages of a protocol version can be decoded,
+// which is the case for 5.3 and later 5.x versions.
+func Supported(version string) bool {
+	parts := strings.SplitN(version, ".", 3)
+
+	if len(parts) < 2 {
+		return false
+	}
+
+	major, err := strconv.Atoi(parts[0])
+
+	if err != nil || major != 5 {
+		return false
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+
+	return err == nil && minor >= 3
+}
+
+// NewID returns a random UUID for message and session ids.
+func NewID() string {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,199 @@
+package messaging
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testKey = "0f9d3c2a-secret"
+
+	testHeader  = `{"msg_id":"a1","session":"s1","username":"einstein","date":"2021-03-01T12:00:00.000000Z","msg_type":"execute_request","version":"5.3"}`
+	testContent = `{"code":"print(1)","silent":false}`
+
+	// testSignature is the signature of the test message, computed with
+	// the hmac module of Python.
+	testSignature = "34735270ff389e12e154d3c50eaa0b25a76a07226384eba4a63958e3236067cb"
+)
+
+func frames(signature, header string, extra ...string) [][]byte {
+	res := [][]byte{[]byte("client-1"), []byte(Delimiter), []byte(signature), []byte(header), []byte("{}"), []byte("{}"), []byte(testContent)}
+
+	for _, e := range extra {
+		res = append(res, []byte(e))
+	}
+
+	return res
+}
+
+func session(t *testing.T, key string) *Session {
+	s, err := NewSession(key, "", ID("s2"), Username("kernel"))
+	assert.Nil(t, err)
+
+	return s
+}
+
+func TestSession_Decode(t *testing.T) {
+	m, err := session(t, testKey).Decode(frames(testSignature, testHeader, "buffer"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, [][]byte{[]byte("client-1")}, m.Identities)
+	assert.Equal(t, Header{
+		MsgID:    "a1",
+		Session:  "s1",
+		Username: "einstein",
+		Date:     "2021-03-01T12:00:00.000000Z",
+		MsgType:  MsgExecuteRequest,
+		Version:  "5.3",
+	}, m.Header)
+	assert.Nil(t, m.ParentHeader)
+	assert.Empty(t, m.Metadata)
+	assert.JSONEq(t, testContent, string(m.Content))
+	assert.Equal(t, [][]byte{[]byte("buffer")}, m.Buffers)
+
+	content, err := m.TypedContent()
+	assert.Nil(t, err)
+	assert.Equal(t, &ExecuteRequest{Code: "print(1)"}, content)
+}
+
+func TestSession_DecodeErrors(t *testing.T) {
+	s := session(t, testKey)
+
+	_, err := s.Decode(frames(testSignature[1:]+"0", testHeader))
+	assert.Equal(t, ErrInvalidSignature, err)
+
+	_, err = s.Decode(frames(testSignature, testHeader)[2:])
+	assert.Equal(t, ErrMissingDelimiter, err)
+
+	_, err = s.Decode(frames(testSignature, testHeader)[:6])
+	assert.Equal(t, ErrIncomplete, err)
+
+	// Without a key signatures are not verified.
+	legacy := regexp.MustCompile(`"5\.3"`).ReplaceAllString(testHeader, `"5.2"`)
+
+	_, err = session(t, "").Decode(frames("", legacy))
+	assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+
+	_, err = session(t, "").Decode(frames("", `{"msg_id": 1}`))
+	assert.NotNil(t, err)
+}
+
+func TestSession_Encode(t *testing.T) {
+	kernel, client := session(t, testKey), session(t, testKey)
+
+	request, err := client.Decode(frames(testSignature, testHeader))
+	assert.Nil(t, err)
+
+	reply, err := kernel.NewMessage(MsgExecuteReply, &ExecuteReply{
+		Reply:          Reply{Status: StatusOK},
+		ExecutionCount: 1,
+	}, request)
+	assert.Nil(t, err)
+
+	reply.Metadata["started"] = "2021-03-01T12:00:00.000000Z"
+	reply.Buffers = [][]byte{{0, 1, 2}}
+
+	b, err := kernel.Encode(reply)
+	assert.Nil(t, err)
+
+	if assert.Len(t, b, 8) {
+		assert.Equal(t, "client-1", string(b[0]))
+		assert.Equal(t, Delimiter, string(b[1]))
+		assert.Equal(t, kernel.Sign(b[3:7]...), string(b[2]))
+		assert.JSONEq(t, testHeader, string(b[4]))
+		assert.JSONEq(t, `{"status": "ok", "execution_count": 1}`, string(b[6]))
+	}
+
+	decoded, err := client.Decode(b)
+	assert.Nil(t, err)
+	assert.Equal(t, reply.Header, decoded.Header)
+	assert.Equal(t, "s2", decoded.Header.Session)
+	assert.Equal(t, "kernel", decoded.Header.Username)
+	assert.Equal(t, Version, decoded.Header.Version)
+	assert.Equal(t, "a1", decoded.ParentHeader.MsgID)
+	assert.Equal(t, reply.Metadata, decoded.Metadata)
+	assert.Equal(t, reply.Buffers, decoded.Buffers)
+
+	content, err := decoded.TypedContent()
+	assert.Nil(t, err)
+	assert.Equal(t, &ExecuteReply{Reply: Reply{Status: StatusOK}, ExecutionCount: 1}, content)
+
+	// Messages signed with another key are rejected.
+	_, err = session(t, "other").Decode(b)
+	assert.Equal(t, ErrInvalidSignature, err)
+}
+
+func TestNewSession(t *testing.T) {
+	s, err := NewSession(testKey, "hmac-sha512")
+	assert.Nil(t, err)
+	assert.Len(t, s.Sign([]byte("{}")), 128)
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, s.ID())
+
+	_, err = NewSession(testKey, "hmac-md4")
+	assert.True(t, errors.Is(err, ErrUnsupportedScheme))
+}
+
+func TestSupported(t *testing.T) {
+	for version, supported := range map[string]bool{
+		"5.3":   true,
+		"5.4":   true,
+		"5.10":  true,
+		"5.3.1": true,
+		"5.2":   false,
+		"4.1":   false,
+		"6.0":   false,
+		"":      false,
+		"5":     false,
+	} {
+		assert.Equal(t, supported, Supported(version), version)
+	}
+}
+
+func TestReadConnectionFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "messaging")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	p := filepath.Join(dir, "kernel-1.json")
+	b, _ := json.Marshal(map[string]interface{}{
+		"transport":        "tcp",
+		"ip":               "127.0.0.1",
+		"shell_port":       52317,
+		"iopub_port":       52318,
+		"stdin_port":       52319,
+		"control_port":     52320,
+		"hb_port":          52321,
+		"key":              testKey,
+		"signature_scheme": "hmac-sha256",
+		"kernel_name":      "python3",
+	})
+	assert.Nil(t, ioutil.WriteFile(p, b, 0600))
+
+	info, err := ReadConnectionFile(p)
+	assert.Nil(t, err)
+	assert.Equal(t, "tcp://127.0.0.1:52318", info.Address(info.IOPubPort))
+
+	s, err := info.Session()
+	assert.Nil(t, err)
+
+	_, err = s.Decode(frames(testSignature, testHeader))
+	assert.Nil(t, err)
+
+	copied := filepath.Join(dir, "kernel-2.json")
+	assert.Nil(t, info.Write(copied))
+
+	stat, err := os.Stat(copied)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+	read, err := ReadConnectionFile(copied)
+	assert.Nil(t, err)
+	assert.Equal(t, info, read)
+}
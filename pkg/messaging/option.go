@@ -0,0 +1,47 @@
+package messaging
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	// ID is the session id in the headers of new messages, a random one if
+	// empty.
+	ID string
+
+	// Username is the user in the headers of new messages.
+	Username string
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		Username: "ocis-jupyter",
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	if opt.ID == "" {
+		opt.ID = NewID()
+	}
+
+	return opt
+}
+
+// ID provides a function to set the id option.
+func ID(val string) Option {
+	return func(o *Options) {
+		o.ID = val
+	}
+}
+
+// Username provides a function to set the username option.
+func Username(val string) Option {
+	return func(o *Options) {
+		if val != "" {
+			o.Username = val
+		}
+	}
+}
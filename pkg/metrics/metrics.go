@@ -27,21 +27,21 @@ func New(opts ...Option) *Metrics {
 		Counter: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: Namespace,
 			Subsystem: Subsystem,
-			Name:      "greet_total",
-			Help:      "How many greeting requests processed",
-		}, []string{}),
+			Name:      "requests_total",
+			Help:      "How many notebook requests processed",
+		}, []string{"method"}),
 		Latency: prometheus.NewSummaryVec(prometheus.SummaryOpts{
 			Namespace: Namespace,
 			Subsystem: Subsystem,
-			Name:      "greet_latency_microseconds",
-			Help:      "Greet request latencies in microseconds",
-		}, []string{}),
+			Name:      "request_latency_microseconds",
+			Help:      "Notebook request latencies in microseconds",
+		}, []string{"method"}),
 		Duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: Namespace,
 			Subsystem: Subsystem,
-			Name:      "greet_duration_seconds",
-			Help:      "Greet method request time in seconds",
-		}, []string{}),
+			Name:      "request_duration_seconds",
+			Help:      "Notebook request time in seconds",
+		}, []string{"method"}),
 	}
 
 	if err := prometheus.Register(m.Counter); err != nil {
@@ -58,7 +58,7 @@ func New(opts ...Option) *Metrics {
 			Msg("Failed to register prometheus metric")
 	}
 
-	if  err := prometheus.Register(m.Duration); err != nil {
+	if err := prometheus.Register(m.Duration); err != nil {
 		options.Logger.Error().
 			Err(err).
 			Str("metric", "duration").
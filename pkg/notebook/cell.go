@@ -0,0 +1,182 @@
+package notebook
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CellType defines the type of a notebook cell.
+type CellType string
+
+const (
+	// CellTypeCode defines a cell with executable source and outputs.
+	CellTypeCode CellType = "code"
+
+	// CellTypeMarkdown defines a cell with markdown source.
+	CellTypeMarkdown CellType = "markdown"
+
+	// CellTypeRaw defines a cell with source which is passed through unmodified.
+	CellTypeRaw CellType = "raw"
+)
+
+// Cell defines a single cell of a notebook. Fields which only apply to some
+// cell types are ignored for the others.
+type Cell struct {
+	// ID is the cell id introduced with nbformat 4.5.
+	ID string
+
+	// CellType is the type of the cell.
+	CellType CellType
+
+	// Metadata is the cell metadata.
+	Metadata Metadata
+
+	// Source is the cell content.
+	Source MultilineString
+
+	// Attachments of markdown and raw cells.
+	Attachments Attachments
+
+	// ExecutionCount of a code cell, nil if the cell was never executed.
+	ExecutionCount *int
+
+	// Outputs of a code cell.
+	Outputs []*Output
+
+	// raw keeps all members as they have been read.
+	raw object
+}
+
+// NewCodeCell returns a code cell without outputs.
+func NewCodeCell(source string) *Cell {
+	return &Cell{
+		CellType: CellTypeCode,
+		Source:   NewMultilineString(source),
+		Outputs:  []*Output{},
+	}
+}
+
+// NewMarkdownCell returns a markdown cell.
+func NewMarkdownCell(source string) *Cell {
+	return &Cell{
+		CellType: CellTypeMarkdown,
+		Source:   NewMultilineString(source),
+	}
+}
+
+// NewRawCell returns a raw cell.
+func NewRawCell(source string) *Cell {
+	return &Cell{
+		CellType: CellTypeRaw,
+		Source:   NewMultilineString(source),
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Cell) UnmarshalJSON(b []byte) error {
+	var raw object
+
+	if err := raw.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	res := Cell{raw: raw}
+
+	if err := raw.decode("cell_type", &res.CellType); err != nil {
+		return fmt.Errorf("cell_type: %w", err)
+	}
+
+	switch res.CellType {
+	case CellTypeCode, CellTypeMarkdown, CellTypeRaw:
+	default:
+		return fmt.Errorf("unknown cell_type %q", res.CellType)
+	}
+
+	if err := raw.decode("id", &res.ID); err != nil {
+		return fmt.Errorf("id: %w", err)
+	}
+
+	if err := raw.decode("metadata", &res.Metadata); err != nil {
+		return fmt.Errorf("metadata: %w", err)
+	}
+
+	if err := raw.decode("source", &res.Source); err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+
+	if err := raw.decode("attachments", &res.Attachments); err != nil {
+		return fmt.Errorf("attachments: %w", err)
+	}
+
+	if err := raw.decode("execution_count", &res.ExecutionCount); err != nil {
+		return fmt.Errorf("execution_count: %w", err)
+	}
+
+	if err := raw.decode("outputs", &res.Outputs); err != nil {
+		return fmt.Errorf("outputs: %w", err)
+	}
+
+	*c = res
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Cell) MarshalJSON() ([]byte, error) {
+	res := c.raw.clone()
+
+	if err := res.encode("cell_type", c.CellType); err != nil {
+		return nil, err
+	}
+
+	if c.ID != "" {
+		if err := res.encode("id", c.ID); err != nil {
+			return nil, err
+		}
+	} else {
+		res.del("id")
+	}
+
+	if err := res.encode("metadata", c.Metadata); err != nil {
+		return nil, err
+	}
+
+	if err := res.encode("source", c.Source); err != nil {
+		return nil, err
+	}
+
+	switch c.CellType {
+	case CellTypeCode:
+		outputs := c.Outputs
+
+		if outputs == nil {
+			outputs = []*Output{}
+		}
+
+		if err := res.encode("execution_count", c.ExecutionCount); err != nil {
+			return nil, err
+		}
+
+		if err := res.encode("outputs", outputs); err != nil {
+			return nil, err
+		}
+
+		res.del("attachments")
+	default:
+		if c.Attachments.Len() > 0 || res.has("attachments") {
+			if err := res.encode("attachments", c.Attachments); err != nil {
+				return nil, err
+			}
+		}
+
+		res.del("execution_count")
+		res.del("outputs")
+	}
+
+	return res.MarshalJSON()
+}
+
+// Extra returns the raw value of a member as it has been read. This gives
+// access to keys without a typed field, e.g. from newer nbformat versions.
+func (c Cell) Extra(key string) (json.RawMessage, bool) {
+	return c.raw.get(key)
+}
@@ -0,0 +1,104 @@
+package notebook
+
+import (
+	"encoding/json"
+)
+
+// Metadata defines a free-form metadata object of a notebook, cell or output.
+// Keys keep their original order and values are kept in their raw form, so
+// metadata written by other tools is never modified by accident.
+type Metadata struct {
+	fields object
+}
+
+// Len returns the number of keys.
+func (m Metadata) Len() int {
+	return len(m.fields)
+}
+
+// Keys returns all keys in their original order.
+func (m Metadata) Keys() []string {
+	return m.fields.keys()
+}
+
+// Has reports whether the key is present.
+func (m Metadata) Has(key string) bool {
+	return m.fields.has(key)
+}
+
+// Raw returns the undecoded value of a key.
+func (m Metadata) Raw(key string) (json.RawMessage, bool) {
+	return m.fields.get(key)
+}
+
+// Get decodes the value of a key into v. It reports whether the key exists.
+func (m Metadata) Get(key string, v interface{}) (bool, error) {
+	if !m.fields.has(key) {
+		return false, nil
+	}
+
+	return true, m.fields.decode(key, v)
+}
+
+// GetString returns the value of a key if it is a JSON string.
+func (m Metadata) GetString(key string) string {
+	var s string
+
+	if ok, err := m.Get(key, &s); !ok || err != nil {
+		return ""
+	}
+
+	return s
+}
+
+// Set encodes v and stores it under key, keeping the position of existing keys.
+func (m *Metadata) Set(key string, v interface{}) error {
+	return m.fields.encode(key, v)
+}
+
+// SetRaw stores an already encoded value under key.
+func (m *Metadata) SetRaw(key string, raw json.RawMessage) {
+	m.fields.set(key, raw)
+}
+
+// Delete removes a key.
+func (m *Metadata) Delete(key string) {
+	m.fields.del(key)
+}
+
+// Clone returns a copy of the metadata.
+func (m Metadata) Clone() Metadata {
+	return Metadata{fields: m.fields.clone()}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m Metadata) MarshalJSON() ([]byte, error) {
+	if m.fields == nil {
+		return []byte("{}"), nil
+	}
+
+	return m.fields.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *Metadata) UnmarshalJSON(b []byte) error {
+	return m.fields.UnmarshalJSON(b)
+}
+
+// KernelSpec defines the kernelspec entry of the notebook metadata.
+type KernelSpec struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Language    string `json:"language,omitempty"`
+}
+
+// LanguageInfo defines the language_info entry of the notebook metadata.
+type LanguageInfo struct {
+	Name              string          `json:"name"`
+	Version           string          `json:"version,omitempty"`
+	MimeType          string          `json:"mimetype,omitempty"`
+	FileExtension     string          `json:"file_extension,omitempty"`
+	PygmentsLexer     string          `json:"pygments_lexer,omitempty"`
+	NBConvertExporter string          `json:"nbconvert_exporter,omitempty"`
+	CodeMirrorMode    json.RawMessage `json:"codemirror_mode,omitempty"`
+}
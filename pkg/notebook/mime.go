@@ -0,0 +1,147 @@
+package notebook
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// MimeBundle maps MIME types to the representations of an output or
+// attachment. Text based types are stored as multiline strings, JSON based
+// types as JSON values.
+type MimeBundle struct {
+	fields object
+}
+
+// Len returns the number of representations.
+func (b MimeBundle) Len() int {
+	return len(b.fields)
+}
+
+// Types returns the MIME types in their original order.
+func (b MimeBundle) Types() []string {
+	return b.fields.keys()
+}
+
+// Has reports whether a representation for the MIME type exists.
+func (b MimeBundle) Has(mime string) bool {
+	return b.fields.has(mime)
+}
+
+// Raw returns the undecoded representation of a MIME type.
+func (b MimeBundle) Raw(mime string) (json.RawMessage, bool) {
+	return b.fields.get(mime)
+}
+
+// Text returns a text based representation as a single string. For JSON based
+// MIME types the raw JSON is returned.
+func (b MimeBundle) Text(mime string) (string, bool) {
+	raw, ok := b.fields.get(mime)
+
+	if !ok {
+		return "", false
+	}
+
+	if IsJSONMime(mime) {
+		return string(raw), true
+	}
+
+	var text MultilineString
+
+	if err := json.Unmarshal(raw, &text); err != nil {
+		return "", false
+	}
+
+	return text.String(), true
+}
+
+// SetText stores a text based representation, split into lines.
+func (b *MimeBundle) SetText(mime, text string) {
+	if IsJSONMime(mime) {
+		b.fields.set(mime, json.RawMessage(text))
+		return
+	}
+
+	// encoding a list of strings cannot fail
+	raw, _ := marshal(NewMultilineString(text))
+	b.fields.set(mime, raw)
+}
+
+// SetRaw stores an already encoded representation.
+func (b *MimeBundle) SetRaw(mime string, raw json.RawMessage) {
+	b.fields.set(mime, raw)
+}
+
+// Delete removes the representation of a MIME type.
+func (b *MimeBundle) Delete(mime string) {
+	b.fields.del(mime)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b MimeBundle) MarshalJSON() ([]byte, error) {
+	if b.fields == nil {
+		return []byte("{}"), nil
+	}
+
+	return b.fields.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *MimeBundle) UnmarshalJSON(raw []byte) error {
+	return b.fields.UnmarshalJSON(raw)
+}
+
+// IsJSONMime reports whether nbformat stores the MIME type as a JSON value
+// instead of a string.
+func IsJSONMime(mime string) bool {
+	return mime == "application/json" || strings.HasSuffix(mime, "+json")
+}
+
+// Attachments maps attachment file names of markdown and raw cells to their
+// MIME bundles.
+type Attachments struct {
+	fields object
+}
+
+// Names returns the attachment names in their original order.
+func (a Attachments) Names() []string {
+	return a.fields.keys()
+}
+
+// Get returns the MIME bundle of an attachment.
+func (a Attachments) Get(name string) (MimeBundle, bool) {
+	var bundle MimeBundle
+
+	if !a.fields.has(name) {
+		return bundle, false
+	}
+
+	if err := a.fields.decode(name, &bundle); err != nil {
+		return bundle, false
+	}
+
+	return bundle, true
+}
+
+// Set stores the MIME bundle of an attachment.
+func (a *Attachments) Set(name string, bundle MimeBundle) error {
+	return a.fields.encode(name, bundle)
+}
+
+// Len returns the number of attachments.
+func (a Attachments) Len() int {
+	return len(a.fields)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a Attachments) MarshalJSON() ([]byte, error) {
+	if a.fields == nil {
+		return []byte("{}"), nil
+	}
+
+	return a.fields.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Attachments) UnmarshalJSON(raw []byte) error {
+	return a.fields.UnmarshalJSON(raw)
+}
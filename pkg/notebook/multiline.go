@@ -0,0 +1,95 @@
+package notebook
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// MultilineString defines a text value which nbformat stores either as a
+// single string or as a list of lines. The original form is remembered so a
+// round-trip does not change the file.
+type MultilineString struct {
+	lines  []string
+	single bool
+}
+
+// NewMultilineString splits s into lines the way nbformat does, every line
+// keeping its trailing newline.
+func NewMultilineString(s string) MultilineString {
+	return MultilineString{
+		lines: SplitLines(s),
+	}
+}
+
+// String joins all lines.
+func (m MultilineString) String() string {
+	return strings.Join(m.lines, "")
+}
+
+// Lines returns the lines including their trailing newlines.
+func (m MultilineString) Lines() []string {
+	return m.lines
+}
+
+// IsEmpty reports whether the text has no content.
+func (m MultilineString) IsEmpty() bool {
+	for _, l := range m.lines {
+		if l != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m MultilineString) MarshalJSON() ([]byte, error) {
+	if m.single {
+		return marshal(m.String())
+	}
+
+	if m.lines == nil {
+		return []byte("[]"), nil
+	}
+
+	return marshal(m.lines)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *MultilineString) UnmarshalJSON(b []byte) error {
+	var s string
+
+	if err := json.Unmarshal(b, &s); err == nil {
+		m.lines = SplitLines(s)
+		m.single = true
+
+		return nil
+	}
+
+	var lines []string
+
+	if err := json.Unmarshal(b, &lines); err != nil {
+		return err
+	}
+
+	m.lines = lines
+	m.single = false
+
+	return nil
+}
+
+// SplitLines splits s after every newline, like Python's str.splitlines(True)
+// as used by nbformat for "\n" separated text.
+func SplitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(s, "\n")
+
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
@@ -0,0 +1,220 @@
+// Package notebook implements the nbformat v4 document model of Jupyter
+// notebooks. Reading and writing a notebook keeps unknown keys and the
+// original key order, so files are not modified beyond the intended changes.
+package notebook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	// Major is the nbformat major version implemented by this package.
+	Major = 4
+
+	// Minor is the latest nbformat minor version known to this package.
+	Minor = 5
+)
+
+var (
+	// ErrUnsupportedVersion defines the error if a notebook is not in nbformat v4.
+	ErrUnsupportedVersion = errors.New("unsupported nbformat version")
+
+	// ErrMissingVersion defines the error if a document has no nbformat key.
+	ErrMissingVersion = errors.New("missing nbformat version")
+)
+
+// Notebook defines an nbformat v4 notebook.
+type Notebook struct {
+	// NBFormat is the major format version.
+	NBFormat int
+
+	// NBFormatMinor is the minor format version.
+	NBFormatMinor int
+
+	// Metadata is the notebook metadata.
+	Metadata Metadata
+
+	// Cells of the notebook.
+	Cells []*Cell
+
+	// raw keeps all members as they have been read.
+	raw object
+}
+
+// New returns an empty notebook in the latest format version.
+func New() *Notebook {
+	return &Notebook{
+		NBFormat:      Major,
+		NBFormatMinor: Minor,
+		Cells:         []*Cell{},
+	}
+}
+
+// Version returns the format version of an encoded notebook without decoding
+// the whole document.
+func Version(b []byte) (major, minor int, err error) {
+	var v struct {
+		NBFormat      *int `json:"nbformat"`
+		NBFormatMinor int  `json:"nbformat_minor"`
+	}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return 0, 0, err
+	}
+
+	if v.NBFormat == nil {
+		return 0, 0, ErrMissingVersion
+	}
+
+	return *v.NBFormat, v.NBFormatMinor, nil
+}
+
+// Parse decodes an nbformat v4 notebook.
+func Parse(b []byte) (*Notebook, error) {
+	major, _, err := Version(b)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if major != Major {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, major)
+	}
+
+	nb := &Notebook{}
+
+	if err := json.Unmarshal(b, nb); err != nil {
+		return nil, err
+	}
+
+	return nb, nil
+}
+
+// Read decodes an nbformat v4 notebook from r.
+func Read(r io.Reader) (*Notebook, error) {
+	b, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(b)
+}
+
+// Marshal encodes the notebook the same way nbformat writes it: indented by a
+// single space, without escaping of non-ASCII or HTML characters and with a
+// trailing newline.
+func (nb *Notebook) Marshal() ([]byte, error) {
+	buf := &bytes.Buffer{}
+
+	if err := nb.Write(buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Write encodes the notebook to w, see Marshal.
+func (nb *Notebook) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", " ")
+
+	return enc.Encode(nb)
+}
+
+// KernelSpec returns the kernelspec from the notebook metadata, nil if absent.
+func (nb *Notebook) KernelSpec() *KernelSpec {
+	spec := &KernelSpec{}
+
+	if ok, err := nb.Metadata.Get("kernelspec", spec); !ok || err != nil {
+		return nil
+	}
+
+	return spec
+}
+
+// LanguageInfo returns the language_info from the notebook metadata, nil if absent.
+func (nb *Notebook) LanguageInfo() *LanguageInfo {
+	info := &LanguageInfo{}
+
+	if ok, err := nb.Metadata.Get("language_info", info); !ok || err != nil {
+		return nil
+	}
+
+	return info
+}
+
+// Language returns the programming language of the notebook, taken from the
+// language_info or the kernelspec metadata.
+func (nb *Notebook) Language() string {
+	if info := nb.LanguageInfo(); info != nil && info.Name != "" {
+		return info.Name
+	}
+
+	if spec := nb.KernelSpec(); spec != nil {
+		return spec.Language
+	}
+
+	return ""
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (nb *Notebook) UnmarshalJSON(b []byte) error {
+	var raw object
+
+	if err := raw.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	res := Notebook{raw: raw}
+
+	if err := raw.decode("nbformat", &res.NBFormat); err != nil {
+		return fmt.Errorf("nbformat: %w", err)
+	}
+
+	if err := raw.decode("nbformat_minor", &res.NBFormatMinor); err != nil {
+		return fmt.Errorf("nbformat_minor: %w", err)
+	}
+
+	if err := raw.decode("metadata", &res.Metadata); err != nil {
+		return fmt.Errorf("metadata: %w", err)
+	}
+
+	if err := raw.decode("cells", &res.Cells); err != nil {
+		return fmt.Errorf("cells: %w", err)
+	}
+
+	*nb = res
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (nb Notebook) MarshalJSON() ([]byte, error) {
+	res := nb.raw.clone()
+	cells := nb.Cells
+
+	if cells == nil {
+		cells = []*Cell{}
+	}
+
+	err := encodeAll(
+		&res,
+		[]string{"cells", "metadata", "nbformat", "nbformat_minor"},
+		cells,
+		nb.Metadata,
+		nb.NBFormat,
+		nb.NBFormatMinor,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res.MarshalJSON()
+}
@@ -0,0 +1,146 @@
+package notebook
+
+import (
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readExample(t *testing.T) []byte {
+	b, err := ioutil.ReadFile("testdata/example.ipynb")
+	assert.Nil(t, err)
+
+	return b
+}
+
+func TestNotebook_RoundTrip(t *testing.T) {
+	original := readExample(t)
+
+	nb, err := Parse(original)
+	assert.Nil(t, err)
+
+	encoded, err := nb.Marshal()
+	assert.Nil(t, err)
+	assert.Equal(t, string(original), string(encoded))
+}
+
+func TestNotebook_Parse(t *testing.T) {
+	nb, err := Parse(readExample(t))
+	assert.Nil(t, err)
+
+	assert.Equal(t, 4, nb.NBFormat)
+	assert.Equal(t, 5, nb.NBFormatMinor)
+	assert.Equal(t, "python", nb.Language())
+	assert.Equal(t, "python3", nb.KernelSpec().Name)
+	assert.Equal(t, []string{"kernelspec", "language_info", "x-custom"}, nb.Metadata.Keys())
+	assert.Len(t, nb.Cells, 3)
+
+	md := nb.Cells[0]
+	assert.Equal(t, CellTypeMarkdown, md.CellType)
+	assert.Equal(t, "5e0b6c3a", md.ID)
+	assert.Equal(t, []string{"logo.png"}, md.Attachments.Names())
+
+	code := nb.Cells[1]
+	assert.Equal(t, CellTypeCode, code.CellType)
+	assert.Equal(t, 1, *code.ExecutionCount)
+	assert.Equal(t, "print('hello')\nprint(\"wörld\")\n1/0", code.Source.String())
+	assert.Equal(t, []string{"scrolled", "collapsed", "zeta"}, code.Metadata.Keys())
+	assert.Len(t, code.Outputs, 4)
+
+	assert.Equal(t, OutputTypeStream, code.Outputs[0].OutputType)
+	assert.Equal(t, "hello\nwörld\n", code.Outputs[0].Text.String())
+
+	text, ok := code.Outputs[1].Data.Text("text/html")
+	assert.True(t, ok)
+	assert.Equal(t, "<p>1</p>", text)
+
+	text, ok = code.Outputs[2].Data.Text("application/json")
+	assert.True(t, ok)
+	assert.JSONEq(t, `{"a":[1,2]}`, text)
+
+	assert.Equal(t, "ZeroDivisionError", code.Outputs[3].EName)
+	assert.Equal(t, CellTypeRaw, nb.Cells[2].CellType)
+	assert.True(t, nb.Cells[2].Source.IsEmpty())
+}
+
+func TestNotebook_ModifyKeepsUnknownKeys(t *testing.T) {
+	nb, err := Parse(readExample(t))
+	assert.Nil(t, err)
+
+	nb.Cells[1].Source = NewMultilineString("x = 1\ny = 2")
+	nb.Cells[1].Outputs = nil
+	nb.Cells[1].ExecutionCount = nil
+	assert.Nil(t, nb.Cells[1].Metadata.Set("deletable", false))
+
+	encoded, err := nb.Marshal()
+	assert.Nil(t, err)
+
+	again, err := Parse(encoded)
+	assert.Nil(t, err)
+
+	cell := again.Cells[1]
+	assert.Equal(t, []string{"x = 1\n", "y = 2"}, cell.Source.Lines())
+	assert.Nil(t, cell.ExecutionCount)
+	assert.Len(t, cell.Outputs, 0)
+	assert.Equal(t, []string{"scrolled", "collapsed", "zeta", "deletable"}, cell.Metadata.Keys())
+
+	raw, ok := again.Metadata.Raw("x-custom")
+	assert.True(t, ok)
+	assert.Equal(t, "1e-05", string(raw))
+}
+
+func TestNotebook_New(t *testing.T) {
+	nb := New()
+	nb.Cells = append(nb.Cells, NewMarkdownCell("# Title"), NewCodeCell("print(1)\n"))
+
+	encoded, err := nb.Marshal()
+	assert.Nil(t, err)
+
+	expected := `{
+ "cells": [
+  {
+   "cell_type": "markdown",
+   "metadata": {},
+   "source": [
+    "# Title"
+   ]
+  },
+  {
+   "cell_type": "code",
+   "execution_count": null,
+   "metadata": {},
+   "outputs": [],
+   "source": [
+    "print(1)\n"
+   ]
+  }
+ ],
+ "metadata": {},
+ "nbformat": 4,
+ "nbformat_minor": 5
+}
+`
+	assert.Equal(t, expected, string(encoded))
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		err  error
+	}{
+		{"missing version", `{"cells": []}`, ErrMissingVersion},
+		{"old version", `{"nbformat": 3, "nbformat_minor": 0, "worksheets": []}`, ErrUnsupportedVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.doc))
+			assert.True(t, errors.Is(err, tt.err))
+		})
+	}
+
+	_, err := Parse([]byte(`{"nbformat": 4, "nbformat_minor": 4, "cells": [{"cell_type": "unknown"}]}`))
+	assert.EqualError(t, err, `cells: unknown cell_type "unknown"`)
+}
@@ -0,0 +1,219 @@
+package notebook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+// field is a single member of a JSON object in its raw form.
+type field struct {
+	key   string
+	value json.RawMessage
+}
+
+// object is a JSON object which remembers the order of its keys and keeps
+// the raw value of every member, so unknown keys survive a round-trip.
+type object []field
+
+// errNotObject is returned when a JSON value is expected to be an object.
+var errNotObject = errors.New("expected a JSON object")
+
+// index returns the position of key or -1.
+func (o object) index(key string) int {
+	for i := range o {
+		if o[i].key == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// get returns the raw value of key.
+func (o object) get(key string) (json.RawMessage, bool) {
+	if i := o.index(key); i >= 0 {
+		return o[i].value, true
+	}
+
+	return nil, false
+}
+
+// has reports whether key is present.
+func (o object) has(key string) bool {
+	return o.index(key) >= 0
+}
+
+// set replaces the value of an existing key in place. New keys are inserted
+// at their sorted position as long as the object is still sorted, which is
+// how nbformat writes files, and appended otherwise.
+func (o *object) set(key string, value json.RawMessage) {
+	if i := o.index(key); i >= 0 {
+		(*o)[i].value = value
+		return
+	}
+
+	keys := o.keys()
+
+	if sort.StringsAreSorted(keys) {
+		i := sort.SearchStrings(keys, key)
+
+		*o = append(*o, field{})
+		copy((*o)[i+1:], (*o)[i:])
+		(*o)[i] = field{key: key, value: value}
+
+		return
+	}
+
+	*o = append(*o, field{key: key, value: value})
+}
+
+// encode marshals v and stores it under key.
+func (o *object) encode(key string, v interface{}) error {
+	raw, err := marshal(v)
+
+	if err != nil {
+		return err
+	}
+
+	o.set(key, raw)
+	return nil
+}
+
+// decode unmarshals the value of key into v. Missing keys are ignored.
+func (o object) decode(key string, v interface{}) error {
+	raw, ok := o.get(key)
+
+	if !ok {
+		return nil
+	}
+
+	return json.Unmarshal(raw, v)
+}
+
+// del removes key.
+func (o *object) del(key string) {
+	if i := o.index(key); i >= 0 {
+		*o = append((*o)[:i], (*o)[i+1:]...)
+	}
+}
+
+// keys returns all keys in their current order.
+func (o object) keys() []string {
+	keys := make([]string, 0, len(o))
+
+	for _, f := range o {
+		keys = append(keys, f.key)
+	}
+
+	return keys
+}
+
+// clone returns a copy which does not share the member slice.
+func (o object) clone() object {
+	if o == nil {
+		return nil
+	}
+
+	c := make(object, len(o))
+	copy(c, o)
+
+	return c
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *object) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return errNotObject
+	}
+
+	res := object{}
+
+	for dec.More() {
+		tok, err := dec.Token()
+
+		if err != nil {
+			return err
+		}
+
+		key, ok := tok.(string)
+
+		if !ok {
+			return errNotObject
+		}
+
+		var value json.RawMessage
+
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+
+		if i := res.index(key); i >= 0 {
+			res[i].value = value
+			continue
+		}
+
+		res = append(res, field{key: key, value: value})
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	*o = res
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o object) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := marshal(f.key)
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		if len(f.value) == 0 {
+			buf.WriteString("null")
+		} else {
+			buf.Write(f.value)
+		}
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshal encodes v without escaping HTML characters, matching the output
+// of Python's json module used by nbformat.
+func marshal(v interface{}) (json.RawMessage, error) {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
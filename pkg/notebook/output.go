@@ -0,0 +1,190 @@
+package notebook
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// OutputType defines the type of a code cell output.
+type OutputType string
+
+const (
+	// OutputTypeStream defines text written to stdout or stderr.
+	OutputTypeStream OutputType = "stream"
+
+	// OutputTypeDisplayData defines data published by a display call.
+	OutputTypeDisplayData OutputType = "display_data"
+
+	// OutputTypeExecuteResult defines the result of an execution.
+	OutputTypeExecuteResult OutputType = "execute_result"
+
+	// OutputTypeError defines an exception raised during an execution.
+	OutputTypeError OutputType = "error"
+)
+
+const (
+	// StreamStdout is the name of the stdout stream.
+	StreamStdout = "stdout"
+
+	// StreamStderr is the name of the stderr stream.
+	StreamStderr = "stderr"
+)
+
+// Output defines a single output of a code cell. Fields which only apply to
+// some output types are ignored for the others.
+type Output struct {
+	// OutputType is the type of the output.
+	OutputType OutputType
+
+	// Name of the stream, stdout or stderr.
+	Name string
+
+	// Text written to the stream.
+	Text MultilineString
+
+	// Data of display_data and execute_result outputs.
+	Data MimeBundle
+
+	// Metadata of display_data and execute_result outputs.
+	Metadata Metadata
+
+	// ExecutionCount of an execute_result output.
+	ExecutionCount *int
+
+	// EName is the exception name of an error output.
+	EName string
+
+	// EValue is the exception value of an error output.
+	EValue string
+
+	// Traceback of an error output.
+	Traceback []string
+
+	// raw keeps all members as they have been read.
+	raw object
+}
+
+// NewStreamOutput returns a stream output.
+func NewStreamOutput(name, text string) *Output {
+	return &Output{
+		OutputType: OutputTypeStream,
+		Name:       name,
+		Text:       NewMultilineString(text),
+	}
+}
+
+// NewErrorOutput returns an error output.
+func NewErrorOutput(ename, evalue string, traceback []string) *Output {
+	return &Output{
+		OutputType: OutputTypeError,
+		EName:      ename,
+		EValue:     evalue,
+		Traceback:  traceback,
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *Output) UnmarshalJSON(b []byte) error {
+	var raw object
+
+	if err := raw.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	res := Output{raw: raw}
+
+	if err := raw.decode("output_type", &res.OutputType); err != nil {
+		return fmt.Errorf("output_type: %w", err)
+	}
+
+	switch res.OutputType {
+	case OutputTypeStream:
+		if err := raw.decode("name", &res.Name); err != nil {
+			return fmt.Errorf("name: %w", err)
+		}
+
+		if err := raw.decode("text", &res.Text); err != nil {
+			return fmt.Errorf("text: %w", err)
+		}
+	case OutputTypeDisplayData, OutputTypeExecuteResult:
+		if err := raw.decode("data", &res.Data); err != nil {
+			return fmt.Errorf("data: %w", err)
+		}
+
+		if err := raw.decode("metadata", &res.Metadata); err != nil {
+			return fmt.Errorf("metadata: %w", err)
+		}
+
+		if err := raw.decode("execution_count", &res.ExecutionCount); err != nil {
+			return fmt.Errorf("execution_count: %w", err)
+		}
+	case OutputTypeError:
+		if err := raw.decode("ename", &res.EName); err != nil {
+			return fmt.Errorf("ename: %w", err)
+		}
+
+		if err := raw.decode("evalue", &res.EValue); err != nil {
+			return fmt.Errorf("evalue: %w", err)
+		}
+
+		if err := raw.decode("traceback", &res.Traceback); err != nil {
+			return fmt.Errorf("traceback: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown output_type %q", res.OutputType)
+	}
+
+	*o = res
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o Output) MarshalJSON() ([]byte, error) {
+	res := o.raw.clone()
+
+	if err := res.encode("output_type", o.OutputType); err != nil {
+		return nil, err
+	}
+
+	var err error
+
+	switch o.OutputType {
+	case OutputTypeStream:
+		err = encodeAll(&res, []string{"name", "text"}, o.Name, o.Text)
+	case OutputTypeDisplayData:
+		err = encodeAll(&res, []string{"data", "metadata"}, o.Data, o.Metadata)
+	case OutputTypeExecuteResult:
+		err = encodeAll(&res, []string{"data", "execution_count", "metadata"}, o.Data, o.ExecutionCount, o.Metadata)
+	case OutputTypeError:
+		traceback := o.Traceback
+
+		if traceback == nil {
+			traceback = []string{}
+		}
+
+		err = encodeAll(&res, []string{"ename", "evalue", "traceback"}, o.EName, o.EValue, traceback)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res.MarshalJSON()
+}
+
+// Extra returns the raw value of a member as it has been read. This gives
+// access to keys without a typed field, e.g. from newer nbformat versions.
+func (o Output) Extra(key string) (json.RawMessage, bool) {
+	return o.raw.get(key)
+}
+
+// encodeAll encodes a list of values under their keys.
+func encodeAll(o *object, keys []string, values ...interface{}) error {
+	for i, key := range keys {
+		if err := o.encode(key, values[i]); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,487 @@
+package notebook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UpgradeReport describes the conversion of a notebook from an older major
+// nbformat version to v4.
+type UpgradeReport struct {
+	// FromMajor is the original major version.
+	FromMajor int
+
+	// FromMinor is the original minor version.
+	FromMinor int
+
+	// Lossy lists the changes which dropped or reshaped content.
+	Lossy []string
+}
+
+// lossy records a change which does not preserve the original content.
+func (r *UpgradeReport) lossy(format string, args ...interface{}) {
+	r.Lossy = append(r.Lossy, fmt.Sprintf(format, args...))
+}
+
+// mimeAliases maps the output keys of nbformat v3 to MIME types.
+var mimeAliases = map[string]string{
+	"text":       "text/plain",
+	"html":       "text/html",
+	"svg":        "image/svg+xml",
+	"png":        "image/png",
+	"jpeg":       "image/jpeg",
+	"latex":      "text/latex",
+	"json":       "application/json",
+	"javascript": "application/javascript",
+}
+
+// Load decodes a notebook of any supported major version. Notebooks in
+// nbformat v1 to v3 are upgraded to v4 the same way nbformat.convert does, in
+// that case the returned report is not nil.
+func Load(b []byte) (*Notebook, *UpgradeReport, error) {
+	major, minor, err := Version(b)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if major == Major {
+		nb, err := Parse(b)
+		return nb, nil, err
+	}
+
+	if major < 1 || major > Major {
+		return nil, nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, major)
+	}
+
+	var doc object
+
+	if err := doc.UnmarshalJSON(b); err != nil {
+		return nil, nil, err
+	}
+
+	report := &UpgradeReport{
+		FromMajor: major,
+		FromMinor: minor,
+	}
+
+	if major == 1 {
+		if doc, err = upgradeV1(doc, report); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if major <= 2 {
+		// nbformat marks notebooks which have been upgraded through v3.
+		doc.set("orig_nbformat", json.RawMessage("2"))
+		doc.set("orig_nbformat_minor", json.RawMessage(fmt.Sprint(minor)))
+	}
+
+	nb, err := upgradeV3(doc, report)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nb, report, nil
+}
+
+// NewCellID returns a random cell id as introduced with nbformat 4.5.
+func NewCellID() string {
+	b := make([]byte, 4)
+
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// upgradeV1 converts the flat cell list of nbformat v1 into a single v2 worksheet.
+func upgradeV1(doc object, report *UpgradeReport) (object, error) {
+	var cells []object
+
+	if err := doc.decode("cells", &cells); err != nil {
+		return nil, fmt.Errorf("cells: %w", err)
+	}
+
+	for _, key := range doc.keys() {
+		switch key {
+		case "cells", "nbformat", "nbformat_minor":
+		default:
+			report.lossy("/%s: dropped, nbformat v1 fields are not carried over", key)
+		}
+	}
+
+	upgraded := make([]object, 0, len(cells))
+
+	for i, cell := range cells {
+		var cellType string
+
+		if err := cell.decode("cell_type", &cellType); err != nil {
+			return nil, fmt.Errorf("cells/%d/cell_type: %w", i, err)
+		}
+
+		res := object{}
+
+		switch cellType {
+		case "code":
+			res.set("cell_type", json.RawMessage(`"code"`))
+			copyKey(cell, &res, "code", "input")
+			copyKey(cell, &res, "prompt_number", "prompt_number")
+			res.set("outputs", json.RawMessage("[]"))
+		case "text":
+			res.set("cell_type", json.RawMessage(`"markdown"`))
+			copyKey(cell, &res, "text", "source")
+		default:
+			report.lossy("/cells/%d: dropped cell of unknown type %q", i, cellType)
+			continue
+		}
+
+		upgraded = append(upgraded, res)
+	}
+
+	doc = object{}
+
+	if err := doc.encode("worksheets", []interface{}{map[string]interface{}{"cells": upgraded}}); err != nil {
+		return nil, err
+	}
+
+	if err := doc.encode("metadata", map[string]interface{}{}); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// upgradeV3 converts a v2 or v3 document into an nbformat v4 notebook.
+func upgradeV3(doc object, report *UpgradeReport) (*Notebook, error) {
+	nb := &Notebook{
+		NBFormat:      Major,
+		NBFormatMinor: Minor,
+		Cells:         []*Cell{},
+	}
+
+	if err := doc.decode("metadata", &nb.Metadata); err != nil {
+		return nil, fmt.Errorf("metadata: %w", err)
+	}
+
+	origMajor, origMinor := json.RawMessage("3"), json.RawMessage("0")
+
+	if raw, ok := doc.get("orig_nbformat"); ok {
+		origMajor = raw
+	}
+
+	if raw, ok := doc.get("orig_nbformat_minor"); ok {
+		origMinor = raw
+	}
+
+	if name := nb.Metadata.GetString("name"); name != "" {
+		report.lossy("/metadata/name: notebook name %q dropped", name)
+	}
+
+	if nb.Metadata.Has("signature") {
+		report.lossy("/metadata/signature: dropped, the notebook has to be trusted again")
+	}
+
+	nb.Metadata.Delete("name")
+	nb.Metadata.Delete("signature")
+	nb.Metadata.SetRaw("orig_nbformat", origMajor)
+	nb.Metadata.SetRaw("orig_nbformat_minor", origMinor)
+
+	language := ""
+
+	if spec := nb.KernelSpec(); spec != nil {
+		language = spec.Language
+	}
+
+	var worksheets []object
+
+	if err := doc.decode("worksheets", &worksheets); err != nil {
+		return nil, fmt.Errorf("worksheets: %w", err)
+	}
+
+	if len(worksheets) > 1 {
+		report.lossy("/worksheets: %d worksheets flattened into a single list of cells", len(worksheets))
+	}
+
+	for w, ws := range worksheets {
+		var meta Metadata
+
+		if err := ws.decode("metadata", &meta); err != nil {
+			return nil, fmt.Errorf("worksheets/%d/metadata: %w", w, err)
+		}
+
+		if meta.Len() > 0 {
+			report.lossy("/worksheets/%d/metadata: worksheet metadata dropped", w)
+		}
+
+		var cells []object
+
+		if err := ws.decode("cells", &cells); err != nil {
+			return nil, fmt.Errorf("worksheets/%d/cells: %w", w, err)
+		}
+
+		for i, raw := range cells {
+			pointer := fmt.Sprintf("/worksheets/%d/cells/%d", w, i)
+			cell, err := upgradeCell(raw, pointer, language, report)
+
+			if err != nil {
+				return nil, err
+			}
+
+			nb.Cells = append(nb.Cells, cell)
+		}
+	}
+
+	for _, key := range []string{"nbformat", "nbformat_minor", "orig_nbformat", "orig_nbformat_minor", "worksheets", "metadata"} {
+		doc.del(key)
+	}
+
+	// Unknown top-level keys are kept, the same as nbformat does.
+	nb.raw = doc
+	return nb, nil
+}
+
+// upgradeCell converts a v3 cell to a v4 cell.
+func upgradeCell(raw object, pointer, language string, report *UpgradeReport) (*Cell, error) {
+	var cellType string
+
+	if err := raw.decode("cell_type", &cellType); err != nil {
+		return nil, fmt.Errorf("%s/cell_type: %w", pointer, err)
+	}
+
+	cell := &Cell{ID: NewCellID()}
+
+	if err := raw.decode("metadata", &cell.Metadata); err != nil {
+		return nil, fmt.Errorf("%s/metadata: %w", pointer, err)
+	}
+
+	switch cellType {
+	case "code":
+		cell.CellType = CellTypeCode
+		cell.Outputs = []*Output{}
+
+		if lang := decodeString(raw, "language"); lang != "" && language != "" && lang != language {
+			report.lossy("%s/language: cell language %q dropped", pointer, lang)
+		}
+
+		if collapsed, ok := raw.get("collapsed"); ok {
+			cell.Metadata.SetRaw("collapsed", collapsed)
+		}
+
+		if err := raw.decode("input", &cell.Source); err != nil {
+			return nil, fmt.Errorf("%s/input: %w", pointer, err)
+		}
+
+		if err := raw.decode("prompt_number", &cell.ExecutionCount); err != nil {
+			report.lossy("%s/prompt_number: invalid prompt number dropped", pointer)
+			cell.ExecutionCount = nil
+		}
+
+		var outputs []object
+
+		if err := raw.decode("outputs", &outputs); err != nil {
+			return nil, fmt.Errorf("%s/outputs: %w", pointer, err)
+		}
+
+		for i, o := range outputs {
+			output, err := upgradeOutput(o, fmt.Sprintf("%s/outputs/%d", pointer, i), report)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if output != nil {
+				cell.Outputs = append(cell.Outputs, output)
+			}
+		}
+
+		for _, key := range []string{"language", "collapsed", "input", "prompt_number", "outputs"} {
+			raw.del(key)
+		}
+	case "heading":
+		level := 1
+
+		if err := raw.decode("level", &level); err != nil || level < 1 {
+			level = 1
+		}
+
+		source := decodeString(raw, "source")
+		lines := strings.Split(strings.TrimRight(source, "\n"), "\n")
+
+		if len(lines) > 1 {
+			report.lossy("%s: multi-line heading joined into a single line", pointer)
+		}
+
+		if level > 6 {
+			report.lossy("%s/level: heading level %d reduced to 6", pointer, level)
+			level = 6
+		}
+
+		cell.CellType = CellTypeMarkdown
+		cell.Source = NewMultilineString(strings.Repeat("#", level) + " " + strings.Join(lines, " "))
+
+		raw.del("level")
+		raw.del("source")
+	case "markdown", "raw":
+		cell.CellType = CellType(cellType)
+
+		if err := raw.decode("source", &cell.Source); err != nil {
+			return nil, fmt.Errorf("%s/source: %w", pointer, err)
+		}
+	case "html":
+		report.lossy("%s: html cell converted to markdown", pointer)
+		cell.CellType = CellTypeMarkdown
+
+		if err := raw.decode("source", &cell.Source); err != nil {
+			return nil, fmt.Errorf("%s/source: %w", pointer, err)
+		}
+	default:
+		report.lossy("%s: cell of unknown type %q converted to raw", pointer, cellType)
+		cell.CellType = CellTypeRaw
+
+		if err := raw.decode("source", &cell.Source); err != nil {
+			return nil, fmt.Errorf("%s/source: %w", pointer, err)
+		}
+	}
+
+	raw.del("cell_type")
+	raw.del("metadata")
+	cell.raw = raw
+
+	return cell, nil
+}
+
+// upgradeOutput converts a v3 output to a v4 output. Outputs of an unknown
+// type are dropped and nil is returned.
+func upgradeOutput(raw object, pointer string, report *UpgradeReport) (*Output, error) {
+	var outputType string
+
+	if err := raw.decode("output_type", &outputType); err != nil {
+		return nil, fmt.Errorf("%s/output_type: %w", pointer, err)
+	}
+
+	raw.del("output_type")
+
+	switch outputType {
+	case "pyout", "display_data":
+		output := &Output{OutputType: OutputTypeDisplayData}
+
+		if outputType == "pyout" {
+			output.OutputType = OutputTypeExecuteResult
+
+			if err := raw.decode("prompt_number", &output.ExecutionCount); err != nil {
+				output.ExecutionCount = nil
+			}
+
+			raw.del("prompt_number")
+		}
+
+		var meta object
+
+		if err := raw.decode("metadata", &meta); err != nil {
+			return nil, fmt.Errorf("%s/metadata: %w", pointer, err)
+		}
+
+		raw.del("metadata")
+
+		for _, f := range meta {
+			output.Metadata.SetRaw(mimeKey(f.key), f.value)
+		}
+
+		// Every remaining key is a representation of the output.
+		for _, f := range raw {
+			mime := mimeKey(f.key)
+			value := f.value
+
+			if mime == "application/json" {
+				var s string
+
+				if err := json.Unmarshal(value, &s); err == nil {
+					if json.Valid([]byte(s)) {
+						value = json.RawMessage(s)
+					} else {
+						report.lossy("%s/json: invalid JSON output kept as text/plain", pointer)
+						mime = "text/plain"
+					}
+				}
+			}
+
+			output.Data.SetRaw(mime, value)
+		}
+
+		return output, nil
+	case "pyerr":
+		output := &Output{OutputType: OutputTypeError}
+
+		if err := raw.decode("ename", &output.EName); err != nil {
+			return nil, fmt.Errorf("%s/ename: %w", pointer, err)
+		}
+
+		if err := raw.decode("evalue", &output.EValue); err != nil {
+			return nil, fmt.Errorf("%s/evalue: %w", pointer, err)
+		}
+
+		if err := raw.decode("traceback", &output.Traceback); err != nil {
+			return nil, fmt.Errorf("%s/traceback: %w", pointer, err)
+		}
+
+		for _, key := range []string{"ename", "evalue", "traceback"} {
+			raw.del(key)
+		}
+
+		output.raw = raw
+		return output, nil
+	case "stream":
+		output := &Output{OutputType: OutputTypeStream, Name: StreamStdout}
+
+		if err := raw.decode("stream", &output.Name); err != nil {
+			return nil, fmt.Errorf("%s/stream: %w", pointer, err)
+		}
+
+		if err := raw.decode("text", &output.Text); err != nil {
+			return nil, fmt.Errorf("%s/text: %w", pointer, err)
+		}
+
+		raw.del("stream")
+		raw.del("text")
+
+		output.raw = raw
+		return output, nil
+	default:
+		report.lossy("%s: dropped output of unknown type %q", pointer, outputType)
+		return nil, nil
+	}
+}
+
+// mimeKey maps a v3 output key to its MIME type.
+func mimeKey(key string) string {
+	if mime, ok := mimeAliases[key]; ok {
+		return mime
+	}
+
+	return key
+}
+
+// copyKey copies the value of from in src to the key to in dst.
+func copyKey(src object, dst *object, from, to string) {
+	if raw, ok := src.get(from); ok {
+		dst.set(to, raw)
+	}
+}
+
+// decodeString returns a string or list of strings value joined, or an empty
+// string if the key is missing.
+func decodeString(o object, key string) string {
+	var s MultilineString
+
+	if err := o.decode(key, &s); err != nil {
+		return ""
+	}
+
+	return s.String()
+}
@@ -0,0 +1,99 @@
+package notebook
+
+import (
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad_V4(t *testing.T) {
+	nb, report, err := Load(readExample(t))
+	assert.Nil(t, err)
+	assert.Nil(t, report)
+	assert.Len(t, nb.Cells, 3)
+}
+
+func TestLoad_V3(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/legacy-v3.ipynb")
+	assert.Nil(t, err)
+
+	nb, report, err := Load(b)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 3, report.FromMajor)
+	assert.Equal(t, 0, report.FromMinor)
+	assert.Equal(t, []string{
+		`/metadata/name: notebook name "analysis" dropped`,
+		"/metadata/signature: dropped, the notebook has to be trusted again",
+	}, report.Lossy)
+
+	assert.Equal(t, Major, nb.NBFormat)
+	assert.Equal(t, Minor, nb.NBFormatMinor)
+	assert.Equal(t, []string{"orig_nbformat", "orig_nbformat_minor"}, nb.Metadata.Keys())
+	assert.Len(t, nb.Cells, 3)
+
+	heading := nb.Cells[0]
+	assert.Equal(t, CellTypeMarkdown, heading.CellType)
+	assert.Equal(t, "## Results", heading.Source.String())
+	assert.Len(t, heading.ID, 8)
+
+	code := nb.Cells[1]
+	assert.Equal(t, CellTypeCode, code.CellType)
+	assert.Equal(t, "import numpy as np\nnp.arange(3)", code.Source.String())
+	assert.Equal(t, 1, *code.ExecutionCount)
+	assert.Equal(t, []string{"collapsed"}, code.Metadata.Keys())
+	assert.Len(t, code.Outputs, 4)
+
+	assert.Equal(t, OutputTypeStream, code.Outputs[0].OutputType)
+	assert.Equal(t, StreamStdout, code.Outputs[0].Name)
+
+	result := code.Outputs[1]
+	assert.Equal(t, OutputTypeExecuteResult, result.OutputType)
+	assert.Equal(t, 1, *result.ExecutionCount)
+	assert.Equal(t, []string{"text/html", "text/plain"}, result.Data.Types())
+
+	display := code.Outputs[2]
+	assert.Equal(t, OutputTypeDisplayData, display.OutputType)
+	text, _ := display.Data.Text("application/json")
+	assert.JSONEq(t, `{"a": 1}`, text)
+	assert.Equal(t, []string{"image/png"}, display.Metadata.Keys())
+
+	assert.Equal(t, OutputTypeError, code.Outputs[3].OutputType)
+	assert.Equal(t, "NameError", code.Outputs[3].EName)
+
+	// The upgraded notebook has to be readable as v4.
+	encoded, err := nb.Marshal()
+	assert.Nil(t, err)
+
+	again, err := Parse(encoded)
+	assert.Nil(t, err)
+	assert.Len(t, again.Cells, 3)
+}
+
+func TestLoad_V1(t *testing.T) {
+	doc := `{"nbformat": 1, "name": "old", "cells": [
+		{"cell_type": "text", "text": "Intro"},
+		{"cell_type": "code", "code": "1 + 1", "prompt_number": 3}
+	]}`
+
+	nb, report, err := Load([]byte(doc))
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, report.FromMajor)
+	assert.Equal(t, []string{"/name: dropped, nbformat v1 fields are not carried over"}, report.Lossy)
+	assert.Len(t, nb.Cells, 2)
+	assert.Equal(t, CellTypeMarkdown, nb.Cells[0].CellType)
+	assert.Equal(t, "Intro", nb.Cells[0].Source.String())
+	assert.Equal(t, "1 + 1", nb.Cells[1].Source.String())
+	assert.Equal(t, 3, *nb.Cells[1].ExecutionCount)
+
+	raw, _ := nb.Metadata.Raw("orig_nbformat")
+	assert.Equal(t, "2", string(raw))
+}
+
+func TestLoad_Unsupported(t *testing.T) {
+	_, _, err := Load([]byte(`{"nbformat": 5, "nbformat_minor": 0}`))
+	assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+}
@@ -1,110 +0,0 @@
-// Code generated by protoc-gen-micro. DO NOT EDIT.
-// source: hello.proto
-
-package proto
-
-import (
-	fmt "fmt"
-	proto "github.com/golang/protobuf/proto"
-	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
-	_ "google.golang.org/genproto/googleapis/api/annotations"
-	math "math"
-)
-
-import (
-	context "context"
-	api "github.com/micro/go-micro/v2/api"
-	client "github.com/micro/go-micro/v2/client"
-	server "github.com/micro/go-micro/v2/server"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ api.Endpoint
-var _ context.Context
-var _ client.Option
-var _ server.Option
-
-// Api Endpoints for Hello service
-
-func NewHelloEndpoints() []*api.Endpoint {
-	return []*api.Endpoint{
-		&api.Endpoint{
-			Name:    "Hello.Greet",
-			Path:    []string{"/api/v0/greet"},
-			Method:  []string{"POST"},
-			Body:    "*",
-			Handler: "rpc",
-		},
-	}
-}
-
-// Client API for Hello service
-
-type HelloService interface {
-	Greet(ctx context.Context, in *GreetRequest, opts ...client.CallOption) (*GreetResponse, error)
-}
-
-type helloService struct {
-	c    client.Client
-	name string
-}
-
-func NewHelloService(name string, c client.Client) HelloService {
-	return &helloService{
-		c:    c,
-		name: name,
-	}
-}
-
-func (c *helloService) Greet(ctx context.Context, in *GreetRequest, opts ...client.CallOption) (*GreetResponse, error) {
-	req := c.c.NewRequest(c.name, "Hello.Greet", in)
-	out := new(GreetResponse)
-	err := c.c.Call(ctx, req, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// Server API for Hello service
-
-type HelloHandler interface {
-	Greet(context.Context, *GreetRequest, *GreetResponse) error
-}
-
-func RegisterHelloHandler(s server.Server, hdlr HelloHandler, opts ...server.HandlerOption) error {
-	type hello interface {
-		Greet(ctx context.Context, in *GreetRequest, out *GreetResponse) error
-	}
-	type Hello struct {
-		hello
-	}
-	h := &helloHandler{hdlr}
-	opts = append(opts, api.WithEndpoint(&api.Endpoint{
-		Name:    "Hello.Greet",
-		Path:    []string{"/api/v0/greet"},
-		Method:  []string{"POST"},
-		Body:    "*",
-		Handler: "rpc",
-	}))
-	return s.Handle(s.NewHandler(&Hello{h}, opts...))
-}
-
-type helloHandler struct {
-	HelloHandler
-}
-
-func (h *helloHandler) Greet(ctx context.Context, in *GreetRequest, out *GreetResponse) error {
-	return h.HelloHandler.Greet(ctx, in, out)
-}
@@ -0,0 +1,606 @@
+// Code generated by protoc-gen-micro. DO NOT EDIT.
+// source: notebook.proto
+
+package proto
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	math "math"
+)
+
+import (
+	context "context"
+	api "github.com/micro/go-micro/v2/api"
+	client "github.com/micro/go-micro/v2/client"
+	server "github.com/micro/go-micro/v2/server"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ api.Endpoint
+var _ context.Context
+var _ client.Option
+var _ server.Option
+
+// Api Endpoints for Notebook service
+
+func NewNotebookEndpoints() []*api.Endpoint {
+	return []*api.Endpoint{
+		&api.Endpoint{
+			Name:    "Notebook.Render",
+			Path:    []string{"/api/v0/notebooks/render"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.Validate",
+			Path:    []string{"/api/v0/notebooks/validate"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.GetInfo",
+			Path:    []string{"/api/v0/notebooks/info"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.Convert",
+			Path:    []string{"/api/v0/notebooks/convert"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.Trust",
+			Path:    []string{"/api/v0/notebooks/trust"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.Save",
+			Path:    []string{"/api/v0/notebooks/save"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.CreateCheckpoint",
+			Path:    []string{"/api/v0/notebooks/checkpoints/create"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.ListCheckpoints",
+			Path:    []string{"/api/v0/notebooks/checkpoints/list"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.RestoreCheckpoint",
+			Path:    []string{"/api/v0/notebooks/checkpoints/restore"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.DeleteCheckpoint",
+			Path:    []string{"/api/v0/notebooks/checkpoints/delete"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.ListVersions",
+			Path:    []string{"/api/v0/notebooks/versions/list"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.GetVersion",
+			Path:    []string{"/api/v0/notebooks/versions/get"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.DiffVersions",
+			Path:    []string{"/api/v0/notebooks/versions/diff"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.CompareVersions",
+			Path:    []string{"/api/v0/notebooks/versions/compare"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.Diff",
+			Path:    []string{"/api/v0/notebooks/diff"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.Merge",
+			Path:    []string{"/api/v0/notebooks/merge"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "Notebook.ListKernelSpecs",
+			Path:    []string{"/api/v0/kernelspecs/list"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+	}
+}
+
+// Client API for Notebook service
+
+type NotebookService interface {
+	Render(ctx context.Context, in *RenderRequest, opts ...client.CallOption) (*RenderResponse, error)
+	Validate(ctx context.Context, in *ValidateRequest, opts ...client.CallOption) (*ValidateResponse, error)
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...client.CallOption) (*GetInfoResponse, error)
+	Convert(ctx context.Context, in *ConvertRequest, opts ...client.CallOption) (*ConvertResponse, error)
+	Trust(ctx context.Context, in *TrustRequest, opts ...client.CallOption) (*TrustResponse, error)
+	Save(ctx context.Context, in *SaveRequest, opts ...client.CallOption) (*SaveResponse, error)
+	CreateCheckpoint(ctx context.Context, in *CreateCheckpointRequest, opts ...client.CallOption) (*CreateCheckpointResponse, error)
+	ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...client.CallOption) (*ListCheckpointsResponse, error)
+	RestoreCheckpoint(ctx context.Context, in *RestoreCheckpointRequest, opts ...client.CallOption) (*RestoreCheckpointResponse, error)
+	DeleteCheckpoint(ctx context.Context, in *DeleteCheckpointRequest, opts ...client.CallOption) (*DeleteCheckpointResponse, error)
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...client.CallOption) (*ListVersionsResponse, error)
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...client.CallOption) (*GetVersionResponse, error)
+	DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...client.CallOption) (*DiffVersionsResponse, error)
+	CompareVersions(ctx context.Context, in *CompareVersionsRequest, opts ...client.CallOption) (*CompareVersionsResponse, error)
+	Diff(ctx context.Context, in *DiffRequest, opts ...client.CallOption) (*DiffResponse, error)
+	Merge(ctx context.Context, in *MergeRequest, opts ...client.CallOption) (*MergeResponse, error)
+	ListKernelSpecs(ctx context.Context, in *ListKernelSpecsRequest, opts ...client.CallOption) (*ListKernelSpecsResponse, error)
+}
+
+type notebookService struct {
+	c    client.Client
+	name string
+}
+
+func NewNotebookService(name string, c client.Client) NotebookService {
+	return &notebookService{
+		c:    c,
+		name: name,
+	}
+}
+
+func (c *notebookService) Render(ctx context.Context, in *RenderRequest, opts ...client.CallOption) (*RenderResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.Render", in)
+	out := new(RenderResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) Validate(ctx context.Context, in *ValidateRequest, opts ...client.CallOption) (*ValidateResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.Validate", in)
+	out := new(ValidateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...client.CallOption) (*GetInfoResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.GetInfo", in)
+	out := new(GetInfoResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) Convert(ctx context.Context, in *ConvertRequest, opts ...client.CallOption) (*ConvertResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.Convert", in)
+	out := new(ConvertResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) Trust(ctx context.Context, in *TrustRequest, opts ...client.CallOption) (*TrustResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.Trust", in)
+	out := new(TrustResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) Save(ctx context.Context, in *SaveRequest, opts ...client.CallOption) (*SaveResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.Save", in)
+	out := new(SaveResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) CreateCheckpoint(ctx context.Context, in *CreateCheckpointRequest, opts ...client.CallOption) (*CreateCheckpointResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.CreateCheckpoint", in)
+	out := new(CreateCheckpointResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, opts ...client.CallOption) (*ListCheckpointsResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.ListCheckpoints", in)
+	out := new(ListCheckpointsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) RestoreCheckpoint(ctx context.Context, in *RestoreCheckpointRequest, opts ...client.CallOption) (*RestoreCheckpointResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.RestoreCheckpoint", in)
+	out := new(RestoreCheckpointResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) DeleteCheckpoint(ctx context.Context, in *DeleteCheckpointRequest, opts ...client.CallOption) (*DeleteCheckpointResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.DeleteCheckpoint", in)
+	out := new(DeleteCheckpointResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...client.CallOption) (*ListVersionsResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.ListVersions", in)
+	out := new(ListVersionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...client.CallOption) (*GetVersionResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.GetVersion", in)
+	out := new(GetVersionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...client.CallOption) (*DiffVersionsResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.DiffVersions", in)
+	out := new(DiffVersionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) CompareVersions(ctx context.Context, in *CompareVersionsRequest, opts ...client.CallOption) (*CompareVersionsResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.CompareVersions", in)
+	out := new(CompareVersionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) Diff(ctx context.Context, in *DiffRequest, opts ...client.CallOption) (*DiffResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.Diff", in)
+	out := new(DiffResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) Merge(ctx context.Context, in *MergeRequest, opts ...client.CallOption) (*MergeResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.Merge", in)
+	out := new(MergeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookService) ListKernelSpecs(ctx context.Context, in *ListKernelSpecsRequest, opts ...client.CallOption) (*ListKernelSpecsResponse, error) {
+	req := c.c.NewRequest(c.name, "Notebook.ListKernelSpecs", in)
+	out := new(ListKernelSpecsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Notebook service
+
+type NotebookHandler interface {
+	Render(context.Context, *RenderRequest, *RenderResponse) error
+	Validate(context.Context, *ValidateRequest, *ValidateResponse) error
+	GetInfo(context.Context, *GetInfoRequest, *GetInfoResponse) error
+	Convert(context.Context, *ConvertRequest, *ConvertResponse) error
+	Trust(context.Context, *TrustRequest, *TrustResponse) error
+	Save(context.Context, *SaveRequest, *SaveResponse) error
+	CreateCheckpoint(context.Context, *CreateCheckpointRequest, *CreateCheckpointResponse) error
+	ListCheckpoints(context.Context, *ListCheckpointsRequest, *ListCheckpointsResponse) error
+	RestoreCheckpoint(context.Context, *RestoreCheckpointRequest, *RestoreCheckpointResponse) error
+	DeleteCheckpoint(context.Context, *DeleteCheckpointRequest, *DeleteCheckpointResponse) error
+	ListVersions(context.Context, *ListVersionsRequest, *ListVersionsResponse) error
+	GetVersion(context.Context, *GetVersionRequest, *GetVersionResponse) error
+	DiffVersions(context.Context, *DiffVersionsRequest, *DiffVersionsResponse) error
+	CompareVersions(context.Context, *CompareVersionsRequest, *CompareVersionsResponse) error
+	Diff(context.Context, *DiffRequest, *DiffResponse) error
+	Merge(context.Context, *MergeRequest, *MergeResponse) error
+	ListKernelSpecs(context.Context, *ListKernelSpecsRequest, *ListKernelSpecsResponse) error
+}
+
+func RegisterNotebookHandler(s server.Server, hdlr NotebookHandler, opts ...server.HandlerOption) error {
+	type notebook interface {
+		Render(ctx context.Context, in *RenderRequest, out *RenderResponse) error
+		Validate(ctx context.Context, in *ValidateRequest, out *ValidateResponse) error
+		GetInfo(ctx context.Context, in *GetInfoRequest, out *GetInfoResponse) error
+		Convert(ctx context.Context, in *ConvertRequest, out *ConvertResponse) error
+		Trust(ctx context.Context, in *TrustRequest, out *TrustResponse) error
+		Save(ctx context.Context, in *SaveRequest, out *SaveResponse) error
+		CreateCheckpoint(ctx context.Context, in *CreateCheckpointRequest, out *CreateCheckpointResponse) error
+		ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, out *ListCheckpointsResponse) error
+		RestoreCheckpoint(ctx context.Context, in *RestoreCheckpointRequest, out *RestoreCheckpointResponse) error
+		DeleteCheckpoint(ctx context.Context, in *DeleteCheckpointRequest, out *DeleteCheckpointResponse) error
+		ListVersions(ctx context.Context, in *ListVersionsRequest, out *ListVersionsResponse) error
+		GetVersion(ctx context.Context, in *GetVersionRequest, out *GetVersionResponse) error
+		DiffVersions(ctx context.Context, in *DiffVersionsRequest, out *DiffVersionsResponse) error
+		CompareVersions(ctx context.Context, in *CompareVersionsRequest, out *CompareVersionsResponse) error
+		Diff(ctx context.Context, in *DiffRequest, out *DiffResponse) error
+		Merge(ctx context.Context, in *MergeRequest, out *MergeResponse) error
+		ListKernelSpecs(ctx context.Context, in *ListKernelSpecsRequest, out *ListKernelSpecsResponse) error
+	}
+	type Notebook struct {
+		notebook
+	}
+	h := &notebookHandler{hdlr}
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.Render",
+		Path:    []string{"/api/v0/notebooks/render"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.Validate",
+		Path:    []string{"/api/v0/notebooks/validate"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.GetInfo",
+		Path:    []string{"/api/v0/notebooks/info"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.Convert",
+		Path:    []string{"/api/v0/notebooks/convert"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.Trust",
+		Path:    []string{"/api/v0/notebooks/trust"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.Save",
+		Path:    []string{"/api/v0/notebooks/save"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.CreateCheckpoint",
+		Path:    []string{"/api/v0/notebooks/checkpoints/create"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.ListCheckpoints",
+		Path:    []string{"/api/v0/notebooks/checkpoints/list"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.RestoreCheckpoint",
+		Path:    []string{"/api/v0/notebooks/checkpoints/restore"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.DeleteCheckpoint",
+		Path:    []string{"/api/v0/notebooks/checkpoints/delete"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.ListVersions",
+		Path:    []string{"/api/v0/notebooks/versions/list"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.GetVersion",
+		Path:    []string{"/api/v0/notebooks/versions/get"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.DiffVersions",
+		Path:    []string{"/api/v0/notebooks/versions/diff"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.CompareVersions",
+		Path:    []string{"/api/v0/notebooks/versions/compare"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.Diff",
+		Path:    []string{"/api/v0/notebooks/diff"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.Merge",
+		Path:    []string{"/api/v0/notebooks/merge"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "Notebook.ListKernelSpecs",
+		Path:    []string{"/api/v0/kernelspecs/list"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	return s.Handle(s.NewHandler(&Notebook{h}, opts...))
+}
+
+type notebookHandler struct {
+	NotebookHandler
+}
+
+func (h *notebookHandler) Render(ctx context.Context, in *RenderRequest, out *RenderResponse) error {
+	return h.NotebookHandler.Render(ctx, in, out)
+}
+
+func (h *notebookHandler) Validate(ctx context.Context, in *ValidateRequest, out *ValidateResponse) error {
+	return h.NotebookHandler.Validate(ctx, in, out)
+}
+
+func (h *notebookHandler) GetInfo(ctx context.Context, in *GetInfoRequest, out *GetInfoResponse) error {
+	return h.NotebookHandler.GetInfo(ctx, in, out)
+}
+
+func (h *notebookHandler) Convert(ctx context.Context, in *ConvertRequest, out *ConvertResponse) error {
+	return h.NotebookHandler.Convert(ctx, in, out)
+}
+
+func (h *notebookHandler) Trust(ctx context.Context, in *TrustRequest, out *TrustResponse) error {
+	return h.NotebookHandler.Trust(ctx, in, out)
+}
+
+func (h *notebookHandler) Save(ctx context.Context, in *SaveRequest, out *SaveResponse) error {
+	return h.NotebookHandler.Save(ctx, in, out)
+}
+
+func (h *notebookHandler) CreateCheckpoint(ctx context.Context, in *CreateCheckpointRequest, out *CreateCheckpointResponse) error {
+	return h.NotebookHandler.CreateCheckpoint(ctx, in, out)
+}
+
+func (h *notebookHandler) ListCheckpoints(ctx context.Context, in *ListCheckpointsRequest, out *ListCheckpointsResponse) error {
+	return h.NotebookHandler.ListCheckpoints(ctx, in, out)
+}
+
+func (h *notebookHandler) RestoreCheckpoint(ctx context.Context, in *RestoreCheckpointRequest, out *RestoreCheckpointResponse) error {
+	return h.NotebookHandler.RestoreCheckpoint(ctx, in, out)
+}
+
+func (h *notebookHandler) DeleteCheckpoint(ctx context.Context, in *DeleteCheckpointRequest, out *DeleteCheckpointResponse) error {
+	return h.NotebookHandler.DeleteCheckpoint(ctx, in, out)
+}
+
+func (h *notebookHandler) ListVersions(ctx context.Context, in *ListVersionsRequest, out *ListVersionsResponse) error {
+	return h.NotebookHandler.ListVersions(ctx, in, out)
+}
+
+func (h *notebookHandler) GetVersion(ctx context.Context, in *GetVersionRequest, out *GetVersionResponse) error {
+	return h.NotebookHandler.GetVersion(ctx, in, out)
+}
+
+func (h *notebookHandler) DiffVersions(ctx context.Context, in *DiffVersionsRequest, out *DiffVersionsResponse) error {
+	return h.NotebookHandler.DiffVersions(ctx, in, out)
+}
+
+func (h *notebookHandler) CompareVersions(ctx context.Context, in *CompareVersionsRequest, out *CompareVersionsResponse) error {
+	return h.NotebookHandler.CompareVersions(ctx, in, out)
+}
+
+func (h *notebookHandler) Diff(ctx context.Context, in *DiffRequest, out *DiffResponse) error {
+	return h.NotebookHandler.Diff(ctx, in, out)
+}
+
+func (h *notebookHandler) Merge(ctx context.Context, in *MergeRequest, out *MergeResponse) error {
+	return h.NotebookHandler.Merge(ctx, in, out)
+}
+
+func (h *notebookHandler) ListKernelSpecs(ctx context.Context, in *ListKernelSpecsRequest, out *ListKernelSpecsResponse) error {
+	return h.NotebookHandler.ListKernelSpecs(ctx, in, out)
+}
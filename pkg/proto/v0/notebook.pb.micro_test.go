@@ -6,11 +6,11 @@ import (
 	"log"
 	"testing"
 
-	"github.com/owncloud/ocis-hello/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
 	"github.com/owncloud/ocis/ocis-pkg/service/grpc"
 	"github.com/stretchr/testify/assert"
 
-	svc "github.com/owncloud/ocis-hello/pkg/service/v0"
+	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
 )
 
 var service = grpc.Service{}
@@ -18,13 +18,13 @@ var service = grpc.Service{}
 func init() {
 	service = grpc.NewService(
 		grpc.Namespace("com.owncloud.api"),
-		grpc.Name("hello"),
+		grpc.Name("jupyter"),
 		grpc.Address("localhost:9992"),
 	)
 
-	err := proto.RegisterHelloHandler(service.Server(), svc.NewService())
+	err := proto.RegisterNotebookHandler(service.Server(), svc.NewService())
 	if err != nil {
-		log.Fatalf("could not register HelloHandler: %v", err)
+		log.Fatalf("could not register NotebookHandler: %v", err)
 	}
 	service.Server().Start()
 }
@@ -39,7 +39,7 @@ type ErrorMessage struct {
 func TestCorrectService(t *testing.T) {
 	type TestStruct struct {
 		testDataName     string
-		name             string
+		content          string
 		expectedError    ErrorMessage
 		expectedResponse interface{}
 	}
@@ -51,40 +51,49 @@ func TestCorrectService(t *testing.T) {
 			ErrorMessage{
 				"go.micro.client",
 				500,
-				"missing a name",
+				"missing notebook content",
 				"Internal Server Error",
 			},
 			nil,
 		},
-		{"ASCII name",
-			"Milan",
-			ErrorMessage{},
-			&proto.GreetResponse{
-				Message: "Hello Milan",
+		{
+			"future version",
+			`{"nbformat": 5, "nbformat_minor": 0}`,
+			ErrorMessage{
+				"go.micro.client",
+				500,
+				"unsupported nbformat version: 5",
+				"Internal Server Error",
 			},
+			nil,
 		},
-		{"special characters",
-			`$&/\#.`,
+		{"empty notebook",
+			`{"cells": [], "metadata": {}, "nbformat": 4, "nbformat_minor": 4}`,
 			ErrorMessage{},
-			&proto.GreetResponse{
-				Message: `Hello $&/\#.`,
+			&proto.GetInfoResponse{
+				Nbformat:      4,
+				NbformatMinor: 4,
 			},
 		},
-		{"UTF name",
-			"मिलन",
+		{"kernelspec",
+			`{"cells": [], "metadata": {"kernelspec": {"name": "ir", "display_name": "R", "language": "R"}}, "nbformat": 4, "nbformat_minor": 5}`,
 			ErrorMessage{},
-			&proto.GreetResponse{
-				Message: "Hello मिलन",
+			&proto.GetInfoResponse{
+				Nbformat:          4,
+				NbformatMinor:     5,
+				Language:          "R",
+				KernelName:        "ir",
+				KernelDisplayName: "R",
 			},
 		},
 	}
 
 	for _, testCase := range tests {
 		t.Run(testCase.testDataName, func(t *testing.T) {
-			request := proto.GreetRequest{Name: testCase.name}
+			request := proto.GetInfoRequest{Content: testCase.content}
 			client := service.Client()
-			cl := proto.NewHelloService("com.owncloud.api.hello", client)
-			response, err := cl.Greet(context.Background(), &request)
+			cl := proto.NewNotebookService("com.owncloud.api.jupyter", client)
+			response, err := cl.GetInfo(context.Background(), &request)
 			if err != nil || (ErrorMessage{}) != testCase.expectedError {
 				assert.Nil(t, response)
 				assert.Error(t, err)
@@ -97,9 +106,12 @@ func TestCorrectService(t *testing.T) {
 			}
 			if testCase.expectedResponse != nil {
 				assert.Nil(t, err)
-				// TODO: this should test the whole response, not just the message. BUT: response body doesn't get closed
-				// when only checking the `response` itself and there is no way to close it, other than accessing it as of now.
-				assert.Equal(t, testCase.expectedResponse.(*proto.GreetResponse).Message, response.Message)
+				expected := testCase.expectedResponse.(*proto.GetInfoResponse)
+				assert.Equal(t, expected.Nbformat, response.Nbformat)
+				assert.Equal(t, expected.NbformatMinor, response.NbformatMinor)
+				assert.Equal(t, expected.Language, response.Language)
+				assert.Equal(t, expected.KernelName, response.KernelName)
+				assert.Equal(t, expected.KernelDisplayName, response.KernelDisplayName)
 			}
 		})
 	}
@@ -108,18 +120,18 @@ func TestCorrectService(t *testing.T) {
 func TestWrongService(t *testing.T) {
 	var tests = []string{
 		"com.owncloud.api",
-		"com.owncloud.api.greet",
-		"com.owncloud.hello",
-		`com/owncloud/api/hello`,
+		"com.owncloud.api.notebook",
+		"com.owncloud.jupyter",
+		`com/owncloud/api/jupyter`,
 		"",
 	}
 
 	for _, testCase := range tests {
 		t.Run(testCase, func(t *testing.T) {
-			request := proto.GreetRequest{Name: "Milan"}
+			request := proto.GetInfoRequest{Content: "{}"}
 			client := service.Client()
-			cl := proto.NewHelloService(testCase, client)
-			response, err := cl.Greet(context.Background(), &request)
+			cl := proto.NewNotebookService(testCase, client)
+			response, err := cl.GetInfo(context.Background(), &request)
 			assert.Nil(t, response)
 			assert.Error(t, err)
 			var errorData ErrorMessage
@@ -0,0 +1,2076 @@
+// Code generated by protoc-gen-microweb. DO NOT EDIT.
+// source: proto.proto
+
+package proto
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+type webNotebookHandler struct {
+	r chi.Router
+	h NotebookHandler
+}
+
+func (h *webNotebookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.r.ServeHTTP(w, r)
+}
+
+func (h *webNotebookHandler) Render(w http.ResponseWriter, r *http.Request) {
+
+	req := &RenderRequest{}
+
+	resp := &RenderResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.Render(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) Validate(w http.ResponseWriter, r *http.Request) {
+
+	req := &ValidateRequest{}
+
+	resp := &ValidateResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.Validate(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) GetInfo(w http.ResponseWriter, r *http.Request) {
+
+	req := &GetInfoRequest{}
+
+	resp := &GetInfoResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.GetInfo(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) Convert(w http.ResponseWriter, r *http.Request) {
+
+	req := &ConvertRequest{}
+
+	resp := &ConvertResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.Convert(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) Trust(w http.ResponseWriter, r *http.Request) {
+
+	req := &TrustRequest{}
+
+	resp := &TrustResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.Trust(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) Save(w http.ResponseWriter, r *http.Request) {
+
+	req := &SaveRequest{}
+
+	resp := &SaveResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.Save(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) CreateCheckpoint(w http.ResponseWriter, r *http.Request) {
+
+	req := &CreateCheckpointRequest{}
+
+	resp := &CreateCheckpointResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.CreateCheckpoint(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) ListCheckpoints(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListCheckpointsRequest{}
+
+	resp := &ListCheckpointsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListCheckpoints(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) RestoreCheckpoint(w http.ResponseWriter, r *http.Request) {
+
+	req := &RestoreCheckpointRequest{}
+
+	resp := &RestoreCheckpointResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RestoreCheckpoint(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) DeleteCheckpoint(w http.ResponseWriter, r *http.Request) {
+
+	req := &DeleteCheckpointRequest{}
+
+	resp := &DeleteCheckpointResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.DeleteCheckpoint(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) ListVersions(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListVersionsRequest{}
+
+	resp := &ListVersionsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListVersions(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
+
+	req := &GetVersionRequest{}
+
+	resp := &GetVersionResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.GetVersion(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) DiffVersions(w http.ResponseWriter, r *http.Request) {
+
+	req := &DiffVersionsRequest{}
+
+	resp := &DiffVersionsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.DiffVersions(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) CompareVersions(w http.ResponseWriter, r *http.Request) {
+
+	req := &CompareVersionsRequest{}
+
+	resp := &CompareVersionsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.CompareVersions(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) Diff(w http.ResponseWriter, r *http.Request) {
+
+	req := &DiffRequest{}
+
+	resp := &DiffResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.Diff(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) Merge(w http.ResponseWriter, r *http.Request) {
+
+	req := &MergeRequest{}
+
+	resp := &MergeResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.Merge(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webNotebookHandler) ListKernelSpecs(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListKernelSpecsRequest{}
+
+	resp := &ListKernelSpecsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListKernelSpecs(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func RegisterNotebookWeb(r chi.Router, i NotebookHandler, middlewares ...func(http.Handler) http.Handler) {
+	handler := &webNotebookHandler{
+		r: r,
+		h: i,
+	}
+
+	r.MethodFunc("POST", "/api/v0/notebooks/render", handler.Render)
+	r.MethodFunc("POST", "/api/v0/notebooks/validate", handler.Validate)
+	r.MethodFunc("POST", "/api/v0/notebooks/info", handler.GetInfo)
+	r.MethodFunc("POST", "/api/v0/notebooks/convert", handler.Convert)
+	r.MethodFunc("POST", "/api/v0/notebooks/trust", handler.Trust)
+	r.MethodFunc("POST", "/api/v0/notebooks/save", handler.Save)
+	r.MethodFunc("POST", "/api/v0/notebooks/checkpoints/create", handler.CreateCheckpoint)
+	r.MethodFunc("POST", "/api/v0/notebooks/checkpoints/list", handler.ListCheckpoints)
+	r.MethodFunc("POST", "/api/v0/notebooks/checkpoints/restore", handler.RestoreCheckpoint)
+	r.MethodFunc("POST", "/api/v0/notebooks/checkpoints/delete", handler.DeleteCheckpoint)
+	r.MethodFunc("POST", "/api/v0/notebooks/versions/list", handler.ListVersions)
+	r.MethodFunc("POST", "/api/v0/notebooks/versions/get", handler.GetVersion)
+	r.MethodFunc("POST", "/api/v0/notebooks/versions/diff", handler.DiffVersions)
+	r.MethodFunc("POST", "/api/v0/notebooks/versions/compare", handler.CompareVersions)
+	r.MethodFunc("POST", "/api/v0/notebooks/diff", handler.Diff)
+	r.MethodFunc("POST", "/api/v0/notebooks/merge", handler.Merge)
+	r.MethodFunc("POST", "/api/v0/kernelspecs/list", handler.ListKernelSpecs)
+}
+
+// RenderRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RenderRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RenderRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RenderRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RenderRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RenderRequest)(nil)
+
+// RenderRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RenderRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RenderRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RenderRequest) UnmarshalJSON(b []byte) error {
+	return RenderRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RenderRequest)(nil)
+
+// RenderResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RenderResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RenderResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RenderResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RenderResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RenderResponse)(nil)
+
+// RenderResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RenderResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RenderResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RenderResponse) UnmarshalJSON(b []byte) error {
+	return RenderResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RenderResponse)(nil)
+
+// ValidateRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ValidateRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ValidateRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ValidateRequest)(nil)
+
+// ValidateRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ValidateRequest) UnmarshalJSON(b []byte) error {
+	return ValidateRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ValidateRequest)(nil)
+
+// ValidateResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ValidateResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ValidateResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ValidateResponse)(nil)
+
+// ValidateResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ValidateResponse) UnmarshalJSON(b []byte) error {
+	return ValidateResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ValidateResponse)(nil)
+
+// ValidationErrorJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidationError. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidationErrorJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ValidationError) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ValidationErrorJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ValidationError)(nil)
+
+// ValidationErrorJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidationError. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidationErrorJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ValidationError) UnmarshalJSON(b []byte) error {
+	return ValidationErrorJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ValidationError)(nil)
+
+// GetInfoRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetInfoRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetInfoRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetInfoRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetInfoRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetInfoRequest)(nil)
+
+// GetInfoRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetInfoRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetInfoRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetInfoRequest) UnmarshalJSON(b []byte) error {
+	return GetInfoRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetInfoRequest)(nil)
+
+// GetInfoResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetInfoResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetInfoResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetInfoResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetInfoResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetInfoResponse)(nil)
+
+// GetInfoResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetInfoResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetInfoResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetInfoResponse) UnmarshalJSON(b []byte) error {
+	return GetInfoResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetInfoResponse)(nil)
+
+// ConvertRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ConvertRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ConvertRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ConvertRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ConvertRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ConvertRequest)(nil)
+
+// ConvertRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ConvertRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ConvertRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ConvertRequest) UnmarshalJSON(b []byte) error {
+	return ConvertRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ConvertRequest)(nil)
+
+// ConvertResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ConvertResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ConvertResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ConvertResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ConvertResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ConvertResponse)(nil)
+
+// ConvertResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ConvertResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ConvertResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ConvertResponse) UnmarshalJSON(b []byte) error {
+	return ConvertResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ConvertResponse)(nil)
+
+// TrustRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of TrustRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var TrustRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *TrustRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := TrustRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*TrustRequest)(nil)
+
+// TrustRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of TrustRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var TrustRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *TrustRequest) UnmarshalJSON(b []byte) error {
+	return TrustRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*TrustRequest)(nil)
+
+// TrustResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of TrustResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var TrustResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *TrustResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := TrustResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*TrustResponse)(nil)
+
+// TrustResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of TrustResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var TrustResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *TrustResponse) UnmarshalJSON(b []byte) error {
+	return TrustResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*TrustResponse)(nil)
+
+// SaveRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SaveRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SaveRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SaveRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SaveRequest)(nil)
+
+// SaveRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SaveRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SaveRequest) UnmarshalJSON(b []byte) error {
+	return SaveRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SaveRequest)(nil)
+
+// SaveResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SaveResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SaveResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SaveResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SaveResponse)(nil)
+
+// SaveResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SaveResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SaveResponse) UnmarshalJSON(b []byte) error {
+	return SaveResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SaveResponse)(nil)
+
+// CheckpointJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Checkpoint. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CheckpointJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *Checkpoint) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CheckpointJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*Checkpoint)(nil)
+
+// CheckpointJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Checkpoint. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CheckpointJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *Checkpoint) UnmarshalJSON(b []byte) error {
+	return CheckpointJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*Checkpoint)(nil)
+
+// CreateCheckpointRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CreateCheckpointRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateCheckpointRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CreateCheckpointRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CreateCheckpointRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CreateCheckpointRequest)(nil)
+
+// CreateCheckpointRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CreateCheckpointRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateCheckpointRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CreateCheckpointRequest) UnmarshalJSON(b []byte) error {
+	return CreateCheckpointRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CreateCheckpointRequest)(nil)
+
+// CreateCheckpointResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CreateCheckpointResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateCheckpointResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CreateCheckpointResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CreateCheckpointResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CreateCheckpointResponse)(nil)
+
+// CreateCheckpointResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CreateCheckpointResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateCheckpointResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CreateCheckpointResponse) UnmarshalJSON(b []byte) error {
+	return CreateCheckpointResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CreateCheckpointResponse)(nil)
+
+// ListCheckpointsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListCheckpointsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListCheckpointsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListCheckpointsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListCheckpointsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListCheckpointsRequest)(nil)
+
+// ListCheckpointsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListCheckpointsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListCheckpointsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListCheckpointsRequest) UnmarshalJSON(b []byte) error {
+	return ListCheckpointsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListCheckpointsRequest)(nil)
+
+// ListCheckpointsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListCheckpointsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListCheckpointsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListCheckpointsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListCheckpointsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListCheckpointsResponse)(nil)
+
+// ListCheckpointsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListCheckpointsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListCheckpointsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListCheckpointsResponse) UnmarshalJSON(b []byte) error {
+	return ListCheckpointsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListCheckpointsResponse)(nil)
+
+// RestoreCheckpointRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RestoreCheckpointRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RestoreCheckpointRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RestoreCheckpointRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RestoreCheckpointRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RestoreCheckpointRequest)(nil)
+
+// RestoreCheckpointRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RestoreCheckpointRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RestoreCheckpointRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RestoreCheckpointRequest) UnmarshalJSON(b []byte) error {
+	return RestoreCheckpointRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RestoreCheckpointRequest)(nil)
+
+// RestoreCheckpointResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RestoreCheckpointResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RestoreCheckpointResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RestoreCheckpointResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RestoreCheckpointResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RestoreCheckpointResponse)(nil)
+
+// RestoreCheckpointResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RestoreCheckpointResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RestoreCheckpointResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RestoreCheckpointResponse) UnmarshalJSON(b []byte) error {
+	return RestoreCheckpointResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RestoreCheckpointResponse)(nil)
+
+// DeleteCheckpointRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DeleteCheckpointRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteCheckpointRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DeleteCheckpointRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DeleteCheckpointRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DeleteCheckpointRequest)(nil)
+
+// DeleteCheckpointRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DeleteCheckpointRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteCheckpointRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DeleteCheckpointRequest) UnmarshalJSON(b []byte) error {
+	return DeleteCheckpointRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DeleteCheckpointRequest)(nil)
+
+// DeleteCheckpointResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DeleteCheckpointResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteCheckpointResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DeleteCheckpointResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DeleteCheckpointResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DeleteCheckpointResponse)(nil)
+
+// DeleteCheckpointResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DeleteCheckpointResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteCheckpointResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DeleteCheckpointResponse) UnmarshalJSON(b []byte) error {
+	return DeleteCheckpointResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DeleteCheckpointResponse)(nil)
+
+// VersionJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Version. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VersionJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *Version) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := VersionJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*Version)(nil)
+
+// VersionJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Version. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VersionJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *Version) UnmarshalJSON(b []byte) error {
+	return VersionJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*Version)(nil)
+
+// ListVersionsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListVersionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListVersionsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListVersionsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListVersionsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListVersionsRequest)(nil)
+
+// ListVersionsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListVersionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListVersionsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListVersionsRequest) UnmarshalJSON(b []byte) error {
+	return ListVersionsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListVersionsRequest)(nil)
+
+// ListVersionsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListVersionsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListVersionsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListVersionsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListVersionsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListVersionsResponse)(nil)
+
+// ListVersionsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListVersionsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListVersionsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListVersionsResponse) UnmarshalJSON(b []byte) error {
+	return ListVersionsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListVersionsResponse)(nil)
+
+// GetVersionRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetVersionRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetVersionRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetVersionRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetVersionRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetVersionRequest)(nil)
+
+// GetVersionRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetVersionRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetVersionRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetVersionRequest) UnmarshalJSON(b []byte) error {
+	return GetVersionRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetVersionRequest)(nil)
+
+// GetVersionResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetVersionResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetVersionResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetVersionResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetVersionResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetVersionResponse)(nil)
+
+// GetVersionResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetVersionResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetVersionResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetVersionResponse) UnmarshalJSON(b []byte) error {
+	return GetVersionResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetVersionResponse)(nil)
+
+// DiffVersionsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DiffVersionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffVersionsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DiffVersionsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DiffVersionsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DiffVersionsRequest)(nil)
+
+// DiffVersionsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DiffVersionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffVersionsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DiffVersionsRequest) UnmarshalJSON(b []byte) error {
+	return DiffVersionsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DiffVersionsRequest)(nil)
+
+// DiffVersionsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DiffVersionsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffVersionsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DiffVersionsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DiffVersionsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DiffVersionsResponse)(nil)
+
+// DiffVersionsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DiffVersionsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffVersionsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DiffVersionsResponse) UnmarshalJSON(b []byte) error {
+	return DiffVersionsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DiffVersionsResponse)(nil)
+
+// CompareVersionsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CompareVersionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CompareVersionsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CompareVersionsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CompareVersionsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CompareVersionsRequest)(nil)
+
+// CompareVersionsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CompareVersionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CompareVersionsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CompareVersionsRequest) UnmarshalJSON(b []byte) error {
+	return CompareVersionsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CompareVersionsRequest)(nil)
+
+// CompareVersionsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CompareVersionsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CompareVersionsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CompareVersionsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CompareVersionsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CompareVersionsResponse)(nil)
+
+// CompareVersionsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CompareVersionsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CompareVersionsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CompareVersionsResponse) UnmarshalJSON(b []byte) error {
+	return CompareVersionsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CompareVersionsResponse)(nil)
+
+// DiffRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DiffRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DiffRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DiffRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DiffRequest)(nil)
+
+// DiffRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DiffRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DiffRequest) UnmarshalJSON(b []byte) error {
+	return DiffRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DiffRequest)(nil)
+
+// DiffResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DiffResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DiffResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DiffResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DiffResponse)(nil)
+
+// DiffResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DiffResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DiffResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DiffResponse) UnmarshalJSON(b []byte) error {
+	return DiffResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DiffResponse)(nil)
+
+// MergeRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of MergeRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var MergeRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *MergeRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := MergeRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*MergeRequest)(nil)
+
+// MergeRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of MergeRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var MergeRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *MergeRequest) UnmarshalJSON(b []byte) error {
+	return MergeRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*MergeRequest)(nil)
+
+// MergeResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of MergeResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var MergeResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *MergeResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := MergeResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*MergeResponse)(nil)
+
+// MergeResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of MergeResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var MergeResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *MergeResponse) UnmarshalJSON(b []byte) error {
+	return MergeResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*MergeResponse)(nil)
+
+// MergeConflictJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of MergeConflict. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var MergeConflictJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *MergeConflict) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := MergeConflictJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*MergeConflict)(nil)
+
+// MergeConflictJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of MergeConflict. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var MergeConflictJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *MergeConflict) UnmarshalJSON(b []byte) error {
+	return MergeConflictJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*MergeConflict)(nil)
+
+// ListKernelSpecsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListKernelSpecsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListKernelSpecsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListKernelSpecsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListKernelSpecsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListKernelSpecsRequest)(nil)
+
+// ListKernelSpecsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListKernelSpecsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListKernelSpecsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListKernelSpecsRequest) UnmarshalJSON(b []byte) error {
+	return ListKernelSpecsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListKernelSpecsRequest)(nil)
+
+// ListKernelSpecsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListKernelSpecsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListKernelSpecsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListKernelSpecsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListKernelSpecsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListKernelSpecsResponse)(nil)
+
+// ListKernelSpecsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListKernelSpecsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListKernelSpecsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListKernelSpecsResponse) UnmarshalJSON(b []byte) error {
+	return ListKernelSpecsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListKernelSpecsResponse)(nil)
+
+// KernelSpecJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of KernelSpec. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var KernelSpecJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *KernelSpec) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := KernelSpecJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*KernelSpec)(nil)
+
+// KernelSpecJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of KernelSpec. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var KernelSpecJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *KernelSpec) UnmarshalJSON(b []byte) error {
+	return KernelSpecJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*KernelSpec)(nil)
+
+// CellDiffJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CellDiff. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CellDiffJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CellDiff) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CellDiffJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CellDiff)(nil)
+
+// CellDiffJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CellDiff. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CellDiffJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CellDiff) UnmarshalJSON(b []byte) error {
+	return CellDiffJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CellDiff)(nil)
+
+// OutputDiffJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of OutputDiff. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var OutputDiffJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *OutputDiff) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := OutputDiffJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*OutputDiff)(nil)
+
+// OutputDiffJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of OutputDiff. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var OutputDiffJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *OutputDiff) UnmarshalJSON(b []byte) error {
+	return OutputDiffJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*OutputDiff)(nil)
+
+// MimeDiffJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of MimeDiff. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var MimeDiffJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *MimeDiff) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := MimeDiffJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*MimeDiff)(nil)
+
+// MimeDiffJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of MimeDiff. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var MimeDiffJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *MimeDiff) UnmarshalJSON(b []byte) error {
+	return MimeDiffJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*MimeDiff)(nil)
+
+// PatchOperationJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of PatchOperation. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PatchOperationJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *PatchOperation) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := PatchOperationJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*PatchOperation)(nil)
+
+// PatchOperationJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of PatchOperation. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PatchOperationJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *PatchOperation) UnmarshalJSON(b []byte) error {
+	return PatchOperationJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*PatchOperation)(nil)
+
+// LineDiffJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of LineDiff. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var LineDiffJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *LineDiff) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := LineDiffJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*LineDiff)(nil)
+
+// LineDiffJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of LineDiff. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var LineDiffJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *LineDiff) UnmarshalJSON(b []byte) error {
+	return LineDiffJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*LineDiff)(nil)
+
+// UpgradeJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Upgrade. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpgradeJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *Upgrade) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := UpgradeJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*Upgrade)(nil)
+
+// UpgradeJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Upgrade. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpgradeJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *Upgrade) UnmarshalJSON(b []byte) error {
+	return UpgradeJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*Upgrade)(nil)
@@ -0,0 +1,85 @@
+package proto_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+)
+
+var invalidEndpoints = []string{"api", "api/v0", "notebooks", "api/v0/notebooks", ""}
+
+const testNotebook = `{\"cells\": [{\"cell_type\": \"code\", \"execution_count\": null, \"metadata\": {}, \"outputs\": [], \"source\": [\"1 + 1\"]}], \"metadata\": {}, \"nbformat\": 4, \"nbformat_minor\": 4}`
+
+func TestPOSTInfoVariousData(t *testing.T) {
+	type TestStruct struct {
+		testDataName         string
+		requestBody          string
+		expectedStatusCode   int
+		expectedResponseBody string
+	}
+
+	var tests = []TestStruct{
+		{"no-data", `{}`, 400, "missing notebook content\n"},
+		{"wrong key", `{"notebook": "{}"}`, 412, `unknown field "notebook" in proto.GetInfoRequest` + "\n"},
+		{"empty body", ``, 412, "EOF\n"},
+		{"invalid json", `{"content":"{}"{}`, 412, "invalid character '{' after object key:value pair\n"},
+		{"data is int", `{"content":23}`, 412, "json: cannot unmarshal number into Go value of type string\n"},
+		{"future version", `{"content":"{\"nbformat\": 5}"}`, 400, "unsupported nbformat version: 5\n"},
+		{"valid", `{"content":"` + testNotebook + `"}`, 201, `{"nbformat":4,"nbformatMinor":4,"cells":1,"codeCells":1}` + "\n"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.testDataName, func(t *testing.T) {
+			rr := sendRequest(t, "POST", "/api/v0/notebooks/info", testCase.requestBody)
+			assertResult(t, rr, testCase.expectedStatusCode, testCase.expectedResponseBody)
+		})
+	}
+}
+
+func TestPOSTConvert(t *testing.T) {
+	rr := sendRequest(t, "POST", "/api/v0/notebooks/convert", `{"content":"`+testNotebook+`","to":"script"}`)
+	assertResult(t, rr, 201, `{"content":"1 + 1\n","mimeType":"text/plain","fileExtension":".txt"}`+"\n")
+}
+
+func TestPOSTIncorrectEndpoints(t *testing.T) {
+	for _, endpoint := range invalidEndpoints {
+		t.Run(endpoint, func(t *testing.T) {
+			rr := sendRequest(t, "POST", endpoint, `{"content":"{}"}`)
+			assertResult(t, rr, 404, "404 page not found\n")
+		})
+	}
+}
+
+func TestGETIncorrectEndpoints(t *testing.T) {
+	for _, endpoint := range invalidEndpoints {
+		t.Run(endpoint, func(t *testing.T) {
+			rr := sendRequest(t, "GET", endpoint, "")
+			assertResult(t, rr, 404, "404 page not found\n")
+		})
+	}
+}
+
+func sendRequest(t *testing.T, method, endpoint, data string) *httptest.ResponseRecorder {
+	var reader = strings.NewReader(data)
+	req, err := http.NewRequest(method, endpoint, reader)
+	assert.Nil(t, err)
+
+	r := chi.NewRouter()
+	proto.RegisterNotebookWeb(r, svc.NewService())
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+	return rr
+}
+
+func assertResult(t *testing.T, rr *httptest.ResponseRecorder, expectedStatusCode int, expectedBody string) {
+	assert.Equal(t, expectedBody, rr.Body.String(), "response body not as expected")
+	assert.Equal(t, expectedStatusCode, rr.Code, "response code not as expected")
+}
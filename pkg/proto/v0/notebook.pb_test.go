@@ -5,24 +5,25 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
-	"github.com/owncloud/ocis-hello/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
 )
+
 type TestStruct struct {
 	testDataName string
-	name         string
+	content      string
 	expected     string
 }
 
 func TestRequestString(t *testing.T) {
 	var tests = []TestStruct{
-		{"ASCII", "Milan", `name:"Milan"`},
-		{"UTF", "मिलन", `name:"मिलन"`},
-		{"empty", "" , ``},
+		{"ASCII", `{"nbformat": 4}`, `content:"{\"nbformat\": 4}"`},
+		{"UTF", "मिलन", `content:"मिलन"`},
+		{"empty", "", ``},
 	}
 
 	for _, testCase := range tests {
 		t.Run(testCase.testDataName, func(t *testing.T) {
-			request := proto.GreetRequest{Name: testCase.name}
+			request := proto.RenderRequest{Content: testCase.content}
 			assert.Equal(t, testCase.expected, request.String())
 		})
 	}
@@ -30,14 +31,14 @@ func TestRequestString(t *testing.T) {
 
 func TestResponseString(t *testing.T) {
 	var tests = []TestStruct{
-		{"ASCII", "Milan", `message:"Milan"`},
-		{"UTF", "मिलन", `message:"मिलन"`},
-		{"empty", "" , ``},
+		{"ASCII", "<p>Milan</p>", `html:"<p>Milan</p>"`},
+		{"UTF", "मिलन", `html:"मिलन"`},
+		{"empty", "", ``},
 	}
 
 	for _, testCase := range tests {
 		t.Run(testCase.testDataName, func(t *testing.T) {
-			response := proto.GreetResponse{Message: testCase.name}
+			response := proto.RenderResponse{Html: testCase.content}
 			assert.Equal(t, testCase.expected, response.String())
 		})
 	}
@@ -0,0 +1,256 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/diff"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/highlight"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+// opMoved marks cells which were removed at one position and added at
+// another one.
+const opMoved = "moved"
+
+// Compare writes two versions of a notebook side by side as HTML document
+// to w. Changed source lines are highlighted, added, removed and moved cells
+// are marked and changed outputs are shown next to each other.
+func (r *Renderer) Compare(w io.Writer, old, new *notebook.Notebook, oldTitle, newTitle string) error {
+	d := diff.Notebooks(old, new)
+
+	p := comparison{
+		Title:    oldTitle + " - " + newTitle,
+		OldTitle: oldTitle,
+		NewTitle: newTitle,
+		Language: new.Language(),
+		Style:    highlight.CSS(r.options.Theme),
+		Changed:  d.Changed(),
+		Rows:     make([]row, 0, len(d.Cells)),
+	}
+
+	oldHighlighter, newHighlighter := highlight.ForNotebook(old), highlight.ForNotebook(new)
+	moves := moved(d, old, new)
+
+	for i, c := range d.Cells {
+		res := row{
+			Op:   string(c.Op),
+			Type: string(c.CellType),
+		}
+
+		if link, ok := moves[i]; ok {
+			res.Op = opMoved
+			res.Link = link
+		}
+
+		var err error
+
+		if c.OldIndex >= 0 {
+			if res.Old, err = r.compareSide(c, old.Cells[c.OldIndex], c.OldIndex, diff.OpRemoved, oldHighlighter); err != nil {
+				return fmt.Errorf("old cell %d: %w", c.OldIndex, err)
+			}
+		}
+
+		if c.NewIndex >= 0 {
+			if res.New, err = r.compareSide(c, new.Cells[c.NewIndex], c.NewIndex, diff.OpAdded, newHighlighter); err != nil {
+				return fmt.Errorf("new cell %d: %w", c.NewIndex, err)
+			}
+		}
+
+		if res.Outputs, err = r.compareOutputs(c, old, new); err != nil {
+			return fmt.Errorf("cell %d: %w", i, err)
+		}
+
+		p.Rows = append(p.Rows, res)
+	}
+
+	return compareTemplate.Execute(w, p)
+}
+
+// CompareString returns two versions of a notebook side by side as HTML
+// document.
+func (r *Renderer) CompareString(old, new *notebook.Notebook, oldTitle, newTitle string) (string, error) {
+	buf := &bytes.Buffer{}
+
+	if err := r.Compare(buf, old, new, oldTitle, newTitle); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// compareSide prepares the version of a cell in one of the notebooks, op is
+// the change of lines which only exist in this version.
+func (r *Renderer) compareSide(c *diff.Cell, nc *notebook.Cell, index int, op diff.Op, h *highlight.Highlighter) (*side, error) {
+	res := &side{
+		Anchor: anchor(op, index),
+	}
+
+	if nc.CellType == notebook.CellTypeCode {
+		res.Prompt = prompt("In ", nc.ExecutionCount)
+	}
+
+	// Unchanged text cells are shown as they are rendered.
+	if c.Op == diff.OpUnchanged && nc.CellType == notebook.CellTypeMarkdown {
+		body, err := r.renderMarkdown(nc.Source.String(), nc.Attachments, r.sanitizer)
+		res.Body = body
+
+		return res, err
+	}
+
+	for _, l := range c.Source {
+		if l.Op != diff.OpUnchanged && l.Op != op {
+			continue
+		}
+
+		text := template.HTML(template.HTMLEscapeString(l.Text))
+
+		if nc.CellType == notebook.CellTypeCode {
+			highlighted, err := h.Code(l.Text)
+
+			if err != nil {
+				return nil, err
+			}
+
+			text = template.HTML(strings.TrimSuffix(string(highlighted), "\n"))
+		}
+
+		res.Lines = append(res.Lines, line{
+			Op:   string(l.Op),
+			Text: text,
+		})
+	}
+
+	return res, nil
+}
+
+// compareOutputs prepares the outputs of a code cell in both notebooks,
+// outputs of both versions are paired like in the diff.
+func (r *Renderer) compareOutputs(c *diff.Cell, old, new *notebook.Notebook) ([]outputRow, error) {
+	var oldOutputs, newOutputs []*notebook.Output
+
+	if c.OldIndex >= 0 {
+		oldOutputs = old.Cells[c.OldIndex].Outputs
+	}
+
+	if c.NewIndex >= 0 {
+		newOutputs = new.Cells[c.NewIndex].Outputs
+	}
+
+	changes := c.Outputs
+
+	if changes == nil {
+		changes = unchangedOutputs(oldOutputs, newOutputs)
+	}
+
+	rows := make([]outputRow, 0, len(changes))
+
+	for _, o := range changes {
+		res := outputRow{
+			Op: string(o.Op),
+		}
+
+		for _, data := range o.Data {
+			if data.Op != diff.OpUnchanged && strings.HasPrefix(data.MimeType, "image/") {
+				res.Image = true
+			}
+		}
+
+		if o.OldIndex >= 0 && o.OldIndex < len(oldOutputs) {
+			ro, err := r.output(oldOutputs[o.OldIndex])
+
+			if err != nil {
+				return nil, fmt.Errorf("old output %d: %w", o.OldIndex, err)
+			}
+
+			if ro.Body != "" {
+				res.Old = &ro
+			}
+		}
+
+		if o.NewIndex >= 0 && o.NewIndex < len(newOutputs) {
+			ro, err := r.output(newOutputs[o.NewIndex])
+
+			if err != nil {
+				return nil, fmt.Errorf("new output %d: %w", o.NewIndex, err)
+			}
+
+			if ro.Body != "" {
+				res.New = &ro
+			}
+		}
+
+		if res.Old != nil || res.New != nil {
+			rows = append(rows, res)
+		}
+	}
+
+	return rows, nil
+}
+
+// unchangedOutputs pairs the outputs of cells without changed outputs, or
+// lists the outputs of added and removed cells.
+func unchangedOutputs(old, new []*notebook.Output) []*diff.Output {
+	res := []*diff.Output{}
+
+	for i := 0; i < len(old) || i < len(new); i++ {
+		o := &diff.Output{Op: diff.OpUnchanged, OldIndex: -1, NewIndex: -1}
+
+		switch {
+		case i < len(old) && i < len(new):
+			o.OldIndex, o.NewIndex = i, i
+		case i < len(old):
+			o.Op, o.OldIndex = diff.OpRemoved, i
+		default:
+			o.Op, o.NewIndex = diff.OpAdded, i
+		}
+
+		res = append(res, o)
+	}
+
+	return res
+}
+
+// moved returns the removed and added cells which are the same cell at
+// another position, by the position in the diff. The values are the anchors
+// of the other position.
+func moved(d *diff.Diff, old, new *notebook.Notebook) map[int]string {
+	res := map[int]string{}
+
+	for i, removed := range d.Cells {
+		if removed.Op != diff.OpRemoved {
+			continue
+		}
+
+		for j, added := range d.Cells {
+			if _, ok := res[j]; ok || added.Op != diff.OpAdded {
+				continue
+			}
+
+			a, b := old.Cells[removed.OldIndex], new.Cells[added.NewIndex]
+			sameID := a.ID != "" && a.ID == b.ID
+			sameSource := a.CellType == b.CellType && a.Source.String() == b.Source.String()
+
+			if sameID || sameSource {
+				res[i] = anchor(diff.OpAdded, added.NewIndex)
+				res[j] = anchor(diff.OpRemoved, removed.OldIndex)
+
+				break
+			}
+		}
+	}
+
+	return res
+}
+
+// anchor returns the id of a cell in the old or the new version.
+func anchor(op diff.Op, index int) string {
+	if op == diff.OpRemoved {
+		return fmt.Sprintf("old-%d", index+1)
+	}
+
+	return fmt.Sprintf("new-%d", index+1)
+}
@@ -0,0 +1,60 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderer_Compare(t *testing.T) {
+	old := notebook.New()
+	old.Cells = []*notebook.Cell{
+		notebook.NewMarkdownCell("Moved"),
+		notebook.NewMarkdownCell("# Title"),
+		notebook.NewCodeCell("x = 1\nplot(x)"),
+		notebook.NewCodeCell("removed()"),
+	}
+	old.Cells[2].Outputs = []*notebook.Output{
+		{OutputType: notebook.OutputTypeDisplayData, Data: bundle(t, `{"image/png": "aGVsbG8="}`)},
+	}
+
+	new := notebook.New()
+	new.Cells = []*notebook.Cell{
+		notebook.NewMarkdownCell("# Title"),
+		notebook.NewCodeCell("x = 2\nplot(x)"),
+		notebook.NewMarkdownCell("Moved"),
+	}
+	new.Cells[1].Outputs = []*notebook.Output{
+		{OutputType: notebook.OutputTypeDisplayData, Data: bundle(t, `{"image/png": "d29ybGQ="}`)},
+	}
+
+	html, err := New().CompareString(old, new, "v1", "v2")
+	assert.Nil(t, err)
+
+	assert.Contains(t, html, "<title>v1 - v2</title>")
+	assert.Contains(t, html, `<div class="row row-modified code-row">`)
+	assert.Contains(t, html, `<div class="row row-removed code-row">`)
+	assert.Contains(t, html, `<div class="row row-moved markdown-row">`)
+	assert.Contains(t, html, `<a href="#new-3">to new-3</a>`)
+	assert.Contains(t, html, `<a href="#old-1">from old-1</a>`)
+	assert.Contains(t, html, `<span class="source-line source-removed">`)
+	assert.Contains(t, html, `<span class="source-line source-added">`)
+	assert.Contains(t, html, "<h1>Title</h1>")
+
+	// Both versions of the changed image are shown in the same row.
+	i := strings.Index(html, "output-image-changed")
+
+	if assert.True(t, i > 0) {
+		assert.Contains(t, html[i:], "aGVsbG8=")
+		assert.Contains(t, html[i:], "d29ybGQ=")
+	}
+}
+
+func bundle(t *testing.T, s string) notebook.MimeBundle {
+	var b notebook.MimeBundle
+	assert.Nil(t, b.UnmarshalJSON([]byte(s)))
+
+	return b
+}
@@ -0,0 +1,63 @@
+package render
+
+import (
+	"bytes"
+	"html/template"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// attachmentPrefix marks image links to attachments of a cell.
+const attachmentPrefix = "attachment:"
+
+// renderMarkdown converts CommonMark with GitHub extensions to HTML. Images
+// referring to cell attachments are embedded as data URIs. Markdown may
+// contain raw HTML, so the result is passed through the sanitizer.
+func (r *Renderer) renderMarkdown(source string, attachments notebook.Attachments, s *sanitizer) (template.HTML, error) {
+	src := []byte(source)
+	doc := r.markdown.Parser().Parse(text.NewReader(src))
+
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		img, ok := n.(*ast.Image)
+
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		name := string(img.Destination)
+
+		if !strings.HasPrefix(name, attachmentPrefix) {
+			return ast.WalkContinue, nil
+		}
+
+		bundle, ok := attachments.Get(strings.TrimPrefix(name, attachmentPrefix))
+
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+
+		for _, mime := range bundle.Types() {
+			if data, ok := bundle.Text(mime); ok && strings.HasPrefix(mime, "image/") {
+				img.Destination = []byte(dataURI(mime, data))
+				break
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := r.markdown.Renderer().Render(buf, src, doc); err != nil {
+		return "", err
+	}
+
+	return s.html(buf.String()), nil
+}
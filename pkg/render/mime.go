@@ -0,0 +1,136 @@
+package render
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+// DefaultPriority defines the order in which the representations of an
+// output are preferred by a new registry, it matches the HTML exporter of
+// nbconvert.
+var DefaultPriority = []string{
+	"application/javascript",
+	"text/html",
+	"text/markdown",
+	"image/svg+xml",
+	"text/latex",
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"application/json",
+	"text/plain",
+}
+
+// builtinRenderers are the renderers of a new registry.
+var builtinRenderers = map[string]MimeRenderer{
+	"application/javascript": MimeRendererFunc(renderJavaScript),
+	"text/html":              MimeRendererFunc(renderHTML),
+	"text/markdown":          MimeRendererFunc(renderMarkdown),
+	"image/svg+xml":          MimeRendererFunc(renderSVG),
+	"text/latex":             MimeRendererFunc(renderLatex),
+	"image/png":              MimeRendererFunc(renderImage),
+	"image/jpeg":             MimeRendererFunc(renderImage),
+	"image/gif":              MimeRendererFunc(renderImage),
+	"application/json":       MimeRendererFunc(renderJSON),
+	"text/plain":             MimeRendererFunc(renderText),
+}
+
+// renderMime renders the representation of the given type.
+func (r *Renderer) renderMime(mime string, o *notebook.Output) (template.HTML, error) {
+	data, _ := o.Data.Text(mime)
+	m, ok := r.options.Registry.Lookup(mime)
+
+	if !ok {
+		m = MimeRendererFunc(renderText)
+	}
+
+	return m.RenderMime(&MimeData{
+		Type:     mime,
+		Data:     data,
+		Output:   o,
+		renderer: r,
+	})
+}
+
+func renderJavaScript(m *MimeData) (template.HTML, error) {
+	return template.HTML(`<script type="text/javascript">` + "\n" + m.Data + "\n</script>"), nil
+}
+
+func renderHTML(m *MimeData) (template.HTML, error) {
+	return m.Sanitize(m.Data), nil
+}
+
+// renderSVG embeds untrusted SVG as image, browsers do not run scripts of
+// SVG documents loaded that way.
+func renderSVG(m *MimeData) (template.HTML, error) {
+	if m.Trusted() {
+		return template.HTML(m.Data), nil
+	}
+
+	return template.HTML(fmt.Sprintf(`<img src="%s">`, dataURI(m.Type, base64.StdEncoding.EncodeToString([]byte(m.Data))))), nil
+}
+
+func renderMarkdown(m *MimeData) (template.HTML, error) {
+	return m.Markdown(m.Data)
+}
+
+func renderLatex(m *MimeData) (template.HTML, error) {
+	return template.HTML(`<div class="latex">` + template.HTMLEscapeString(m.Data) + `</div>`), nil
+}
+
+// renderImage embeds base64 encoded images, untrusted ones are sanitised
+// like HTML outputs.
+func renderImage(m *MimeData) (template.HTML, error) {
+	var size struct {
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	}
+
+	// The size is a hint only, invalid metadata does not break the output.
+	_, _ = m.Output.Metadata.Get(m.Type, &size)
+
+	data := strings.Join(strings.Fields(m.Data), "")
+
+	// The data ends up in an attribute, so anything else than base64 is
+	// rejected instead of trusting the escaping alone.
+	if _, err := base64.StdEncoding.DecodeString(data); err != nil {
+		return "", fmt.Errorf("invalid image data: %w", err)
+	}
+
+	attrs := ""
+
+	if size.Width > 0 {
+		attrs += fmt.Sprintf(` width="%d"`, size.Width)
+	}
+
+	if size.Height > 0 {
+		attrs += fmt.Sprintf(` height="%d"`, size.Height)
+	}
+
+	return m.Sanitize(fmt.Sprintf(`<img src="%s"%s>`, template.HTMLEscapeString(dataURI(m.Type, data)), attrs)), nil
+}
+
+func renderJSON(m *MimeData) (template.HTML, error) {
+	buf := &bytes.Buffer{}
+
+	if err := json.Indent(buf, []byte(m.Data), "", "  "); err != nil {
+		return "", err
+	}
+
+	return preformatted(buf.String()), nil
+}
+
+func renderText(m *MimeData) (template.HTML, error) {
+	return terminal(m.Data), nil
+}
+
+// dataURI embeds base64 encoded data, notebooks store it with line breaks.
+func dataURI(mime, data string) string {
+	return "data:" + mime + ";base64," + strings.Join(strings.Fields(data), "")
+}
@@ -0,0 +1,67 @@
+package render
+
+import (
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/highlight"
+)
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	Priority []string
+	Disabled []string
+	Registry *Registry
+	Policy   Policy
+	Theme    string
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		Registry: DefaultRegistry,
+		Theme:    highlight.DefaultTheme,
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// Priority provides a function to set the MIME priority option. Without it
+// the priority of the registry applies.
+func Priority(val []string) Option {
+	return func(o *Options) {
+		o.Priority = val
+	}
+}
+
+// Disabled provides a function to set the MIME types which are never displayed.
+func Disabled(val []string) Option {
+	return func(o *Options) {
+		o.Disabled = val
+	}
+}
+
+// Renderers provides a function to set the registry option.
+func Renderers(val *Registry) Option {
+	return func(o *Options) {
+		o.Registry = val
+	}
+}
+
+// Sanitize provides a function to set the policy option for untrusted content.
+func Sanitize(val Policy) Option {
+	return func(o *Options) {
+		o.Policy = val
+	}
+}
+
+// Theme provides a function to set the highlighting theme option.
+func Theme(val string) Option {
+	return func(o *Options) {
+		o.Theme = val
+	}
+}
@@ -0,0 +1,113 @@
+package render
+
+import (
+	"html/template"
+	"sync"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+// MimeRenderer renders a single representation of an output as HTML.
+type MimeRenderer interface {
+	RenderMime(m *MimeData) (template.HTML, error)
+}
+
+// MimeRendererFunc adapts a function to the MimeRenderer interface.
+type MimeRendererFunc func(m *MimeData) (template.HTML, error)
+
+// RenderMime implements the MimeRenderer interface.
+func (f MimeRendererFunc) RenderMime(m *MimeData) (template.HTML, error) {
+	return f(m)
+}
+
+// MimeData is the representation of an output handed to a MimeRenderer.
+type MimeData struct {
+	// Type is the MIME type of the representation.
+	Type string
+
+	// Data is the representation as text, JSON based types are given as
+	// encoded JSON.
+	Data string
+
+	// Output is the output the representation belongs to.
+	Output *notebook.Output
+
+	renderer *Renderer
+}
+
+// Trusted returns true if the representation may be rendered as it is,
+// because the notebook is trusted or sanitising is disabled.
+func (m *MimeData) Trusted() bool {
+	return m.renderer.outputs.disabled
+}
+
+// Sanitize removes everything outside the allow-list from untrusted HTML.
+func (m *MimeData) Sanitize(html string) template.HTML {
+	return m.renderer.outputs.html(html)
+}
+
+// Markdown converts markdown to HTML, which is sanitised unless trusted.
+func (m *MimeData) Markdown(source string) (template.HTML, error) {
+	return m.renderer.renderMarkdown(source, notebook.Attachments{}, m.renderer.outputs)
+}
+
+// Registry maps MIME types to their renderers. It also defines the default
+// priority of the types, renderers added for new types are preferred over
+// the ones added before.
+type Registry struct {
+	mu        sync.RWMutex
+	renderers map[string]MimeRenderer
+	priority  []string
+}
+
+// NewRegistry returns a registry with the built-in renderers.
+func NewRegistry() *Registry {
+	r := &Registry{
+		renderers: map[string]MimeRenderer{},
+	}
+
+	for i := len(DefaultPriority) - 1; i >= 0; i-- {
+		mime := DefaultPriority[i]
+		r.Register(mime, builtinRenderers[mime])
+	}
+
+	return r
+}
+
+// Register adds the renderer for a MIME type, replacing an existing one.
+func (r *Registry) Register(mime string, m MimeRenderer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.renderers[mime]; !ok {
+		r.priority = append([]string{mime}, r.priority...)
+	}
+
+	r.renderers[mime] = m
+}
+
+// Lookup returns the renderer for a MIME type.
+func (r *Registry) Lookup(mime string) (MimeRenderer, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	m, ok := r.renderers[mime]
+	return m, ok
+}
+
+// Priority returns the registered MIME types, the most preferred first.
+func (r *Registry) Priority() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]string(nil), r.priority...)
+}
+
+// DefaultRegistry is used by renderers without a registry option.
+var DefaultRegistry = NewRegistry()
+
+// Register adds the renderer for a MIME type to the default registry, e.g.
+// from an init function of the package implementing it.
+func Register(mime string, m MimeRenderer) {
+	DefaultRegistry.Register(mime, m)
+}
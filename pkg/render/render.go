@@ -0,0 +1,282 @@
+// Package render converts notebooks into self-contained HTML documents, the
+// way the classic template of nbconvert does. Nothing but the notebook itself
+// is needed, there is no kernel involved.
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/ansi"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/highlight"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// Renderer renders notebooks as HTML.
+type Renderer struct {
+	options   Options
+	priority  []string
+	markdown  goldmark.Markdown
+	sanitizer *sanitizer
+
+	// outputs applies to outputs of code cells, they are kept as they are
+	// for trusted notebooks.
+	outputs *sanitizer
+}
+
+// New initializes a new renderer.
+func New(opts ...Option) *Renderer {
+	options := newOptions(opts...)
+	untrusted := newSanitizer(options.Policy)
+	priority := options.Priority
+
+	if len(priority) == 0 {
+		priority = options.Registry.Priority()
+	}
+
+	return &Renderer{
+		options:  options,
+		priority: without(priority, options.Disabled),
+		markdown: goldmark.New(
+			goldmark.WithExtensions(extension.GFM),
+			goldmark.WithRendererOptions(html.WithUnsafe()),
+		),
+		sanitizer: untrusted,
+		outputs:   untrusted,
+	}
+}
+
+// Trusted returns a renderer for notebooks signed by a trusted user. Their
+// outputs are rendered as they are, like Jupyter does, markdown and raw
+// cells are still sanitised.
+func (r *Renderer) Trusted() *Renderer {
+	t := *r
+	t.outputs = newSanitizer(Policy{Disabled: true})
+
+	return &t
+}
+
+// WithTheme returns a renderer which highlights code in the given theme.
+func (r *Renderer) WithTheme(name string) *Renderer {
+	t := *r
+	t.options.Theme = name
+
+	return &t
+}
+
+// Render writes the notebook as HTML document to w.
+func (r *Renderer) Render(w io.Writer, nb *notebook.Notebook, title string) error {
+	if t := nb.Metadata.GetString("title"); t != "" {
+		title = t
+	}
+
+	p := page{
+		Title:    title,
+		Language: nb.Language(),
+		Style:    highlight.CSS(r.options.Theme),
+		Cells:    make([]cell, 0, len(nb.Cells)),
+	}
+
+	h := highlight.ForNotebook(nb)
+
+	for i, c := range nb.Cells {
+		rc, err := r.cell(c, p.Language, h)
+
+		if err != nil {
+			return fmt.Errorf("cell %d: %w", i, err)
+		}
+
+		p.Cells = append(p.Cells, rc)
+	}
+
+	return pageTemplate.Execute(w, p)
+}
+
+// String returns the notebook as HTML document.
+func (r *Renderer) String(nb *notebook.Notebook, title string) (string, error) {
+	buf := &bytes.Buffer{}
+
+	if err := r.Render(buf, nb, title); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// cell prepares a single cell for the page template.
+func (r *Renderer) cell(c *notebook.Cell, language string, h *highlight.Highlighter) (cell, error) {
+	res := cell{
+		Type: string(c.CellType),
+	}
+
+	switch c.CellType {
+	case notebook.CellTypeMarkdown:
+		body, err := r.renderMarkdown(c.Source.String(), c.Attachments, r.sanitizer)
+
+		if err != nil {
+			return res, err
+		}
+
+		res.Body = body
+	case notebook.CellTypeRaw:
+		body, err := r.renderRaw(c)
+
+		if err != nil {
+			return res, err
+		}
+
+		res.Body = body
+	case notebook.CellTypeCode:
+		res.Prompt = prompt("In ", c.ExecutionCount)
+		res.Language = language
+
+		source, err := h.Code(c.Source.String())
+
+		if err != nil {
+			return res, err
+		}
+
+		res.Source = source
+
+		outputs := coalesceStreams(c.Outputs)
+
+		for i, o := range outputs {
+			ro, err := r.output(o)
+
+			if err != nil {
+				return res, fmt.Errorf("output %d: %w", i, err)
+			}
+
+			if ro.Body != "" {
+				res.Outputs = append(res.Outputs, ro)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// renderRaw renders raw cells which are meant for HTML, everything else is
+// omitted like nbconvert does.
+func (r *Renderer) renderRaw(c *notebook.Cell) (template.HTML, error) {
+	format := c.Metadata.GetString("raw_mimetype")
+
+	if format == "" {
+		format = c.Metadata.GetString("format")
+	}
+
+	switch format {
+	case "text/html":
+		return r.sanitizer.html(c.Source.String()), nil
+	case "text/markdown":
+		return r.renderMarkdown(c.Source.String(), c.Attachments, r.sanitizer)
+	}
+
+	return "", nil
+}
+
+// output prepares a single output for the page template.
+func (r *Renderer) output(o *notebook.Output) (output, error) {
+	res := output{
+		Type: string(o.OutputType),
+	}
+
+	switch o.OutputType {
+	case notebook.OutputTypeStream:
+		res.Name = o.Name
+		res.Body = terminal(o.Text.String())
+	case notebook.OutputTypeError:
+		res.Body = terminal(strings.Join(o.Traceback, "\n"))
+	case notebook.OutputTypeExecuteResult, notebook.OutputTypeDisplayData:
+		if o.OutputType == notebook.OutputTypeExecuteResult {
+			res.Prompt = prompt("Out", o.ExecutionCount)
+		}
+
+		mime, ok := r.choose(o.Data)
+
+		if !ok {
+			return res, nil
+		}
+
+		body, err := r.renderMime(mime, o)
+
+		if err != nil {
+			return res, fmt.Errorf("%s: %w", mime, err)
+		}
+
+		res.Mime = mime
+		res.Body = body
+	}
+
+	return res, nil
+}
+
+// choose returns the MIME type of the bundle with the highest priority,
+// representations which are not allowed by the policy are skipped.
+func (r *Renderer) choose(data notebook.MimeBundle) (string, bool) {
+	for _, mime := range r.priority {
+		if data.Has(mime) && r.outputs.allowed(mime) {
+			return mime, true
+		}
+	}
+
+	return "", false
+}
+
+// without returns the MIME types of list which are not in disabled.
+func without(list, disabled []string) []string {
+	res := make([]string, 0, len(list))
+
+	for _, mime := range list {
+		if !contains(disabled, mime) {
+			res = append(res, mime)
+		}
+	}
+
+	return res
+}
+
+// prompt formats an input or output prompt like the classic notebook.
+func prompt(kind string, count *int) string {
+	if count == nil {
+		return kind + "[ ]:"
+	}
+
+	return fmt.Sprintf("%s[%d]:", kind, *count)
+}
+
+// coalesceStreams merges consecutive stream outputs of the same name, like
+// Jupyter does while a cell runs. Progress bars are often spread over many
+// outputs and collapse only if they are merged.
+func coalesceStreams(outputs []*notebook.Output) []*notebook.Output {
+	res := make([]*notebook.Output, 0, len(outputs))
+
+	for _, o := range outputs {
+		if n := len(res); n > 0 && o.OutputType == notebook.OutputTypeStream {
+			if last := res[n-1]; last.OutputType == notebook.OutputTypeStream && last.Name == o.Name {
+				res[n-1] = notebook.NewStreamOutput(o.Name, last.Text.String()+o.Text.String())
+				continue
+			}
+		}
+
+		res = append(res, o)
+	}
+
+	return res
+}
+
+// terminal converts terminal output with ANSI escape sequences for a pre element.
+func terminal(text string) template.HTML {
+	return template.HTML("<pre>" + string(ansi.HTML(text)) + "</pre>")
+}
+
+// preformatted escapes text for a pre element.
+func preformatted(text string) template.HTML {
+	return template.HTML("<pre>" + template.HTMLEscapeString(text) + "</pre>")
+}
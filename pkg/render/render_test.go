@@ -0,0 +1,306 @@
+package render
+
+import (
+	"html/template"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+const testNotebook = `{
+ "cells": [
+  {"cell_type": "markdown", "metadata": {}, "source": ["# Title\n", "\n", "| a | b |\n", "|---|---|\n", "| ~~1~~ | 2 |\n", "\n", "![logo](attachment:logo.png)"],
+   "attachments": {"logo.png": {"image/png": "iVBORw0KGgo=\n"}}},
+  {"cell_type": "code", "execution_count": 3, "metadata": {}, "source": ["if a < b:\n", "    print('<b>')"],
+   "outputs": [
+    {"output_type": "stream", "name": "stderr", "text": ["warning\n"]},
+    {"output_type": "execute_result", "execution_count": 3, "metadata": {},
+     "data": {"text/plain": ["<b>2</b>"], "text/html": ["<b>2</b>"]}},
+    {"output_type": "display_data", "metadata": {"image/png": {"width": 320}},
+     "data": {"text/plain": ["<Figure>"], "image/png": "aGVs\nbG8=\n"}},
+    {"output_type": "error", "ename": "ValueError", "evalue": "bad", "traceback": ["\u001b[0;31mValueError\u001b[0m: bad"]}
+   ]},
+  {"cell_type": "code", "execution_count": null, "metadata": {}, "source": [], "outputs": []},
+  {"cell_type": "raw", "metadata": {"raw_mimetype": "text/html"}, "source": ["<hr class=\"raw\">"]},
+  {"cell_type": "raw", "metadata": {"raw_mimetype": "text/x-python"}, "source": ["hidden = True"]}
+ ],
+ "metadata": {"kernelspec": {"name": "python3", "display_name": "Python 3", "language": "python"}},
+ "nbformat": 4,
+ "nbformat_minor": 4
+}`
+
+func parse(t *testing.T) *notebook.Notebook {
+	nb, err := notebook.Parse([]byte(testNotebook))
+	assert.Nil(t, err)
+
+	return nb
+}
+
+func TestRenderer_Render(t *testing.T) {
+	html, err := New().String(parse(t), "example.ipynb")
+	assert.Nil(t, err)
+
+	expected := []string{
+		`<title>example.ipynb</title>`,
+		`<h1>Title</h1>`,
+		`<td><del>1</del></td>`,
+		`<img src="data:image/png;base64,iVBORw0KGgo=" alt="logo">`,
+		`<div class="prompt">In [3]:</div>`,
+		`<code class="language-python"><span class="line"><span class="cl"><span class="k">if</span> <span class="n">a</span> <span class="o">&lt;</span> <span class="n">b</span><span class="p">:</span>`,
+		`<span class="s1">&#39;&lt;b&gt;&#39;</span>`,
+		`.chroma .k { color: #008000; font-weight: bold }`,
+		`<div class="output-area output-stream stderr">
+<pre>warning
+</pre>`,
+		`<div class="prompt">Out[3]:</div>`,
+		`data-mime-type="text/html">
+<b>2</b>
+</div>`,
+		`<img src="data:image/png;base64,aGVsbG8=" width="320">`,
+		`<pre><span class="ansi-red-fg">ValueError</span>: bad</pre>`,
+		`<div class="prompt">In [ ]:</div>`,
+		`<hr class="raw">`,
+	}
+
+	for _, e := range expected {
+		assert.Contains(t, html, e)
+	}
+
+	assert.NotContains(t, html, "hidden = True")
+}
+
+// imageNotebook returns a notebook with an output of the given PNG data.
+func imageNotebook(t *testing.T, data string) *notebook.Notebook {
+	nb, err := notebook.Parse([]byte(`{
+ "cells": [{"cell_type": "code", "execution_count": 1, "metadata": {}, "source": [],
+  "outputs": [{"output_type": "display_data", "metadata": {}, "data": {"image/png": ` + data + `}}]}],
+ "metadata": {}, "nbformat": 4, "nbformat_minor": 4
+}`))
+	assert.Nil(t, err)
+
+	return nb
+}
+
+func TestRenderer_InvalidImage(t *testing.T) {
+	for _, data := range []string{
+		`"AAAA\"><script>alert(1)</script><x\""`,
+		`["aGVs\n", "bG8=<\n"]`,
+	} {
+		html, err := New().String(imageNotebook(t, data), "")
+		assert.NotNil(t, err, data)
+		assert.NotContains(t, html, "<script>")
+	}
+}
+
+func TestRenderer_Priority(t *testing.T) {
+	html, err := New(Priority([]string{"text/plain"})).String(parse(t), "")
+	assert.Nil(t, err)
+
+	assert.Contains(t, html, `data-mime-type="text/plain">
+<pre>&lt;b&gt;2&lt;/b&gt;</pre>`)
+	assert.Contains(t, html, `<pre>&lt;Figure&gt;</pre>`)
+	assert.NotContains(t, html, `data:image/png;base64,aGVsbG8=`)
+}
+
+func TestRenderer_Title(t *testing.T) {
+	nb := notebook.New()
+	assert.Nil(t, nb.Metadata.Set("title", "Analysis"))
+
+	html, err := New().String(nb, "analysis.ipynb")
+	assert.Nil(t, err)
+	assert.Contains(t, html, `<title>Analysis</title>`)
+}
+
+const untrustedNotebook = `{
+ "cells": [
+  {"cell_type": "markdown", "metadata": {}, "source": ["[click](javascript:alert(1)) <img src=x onerror=\"alert(2)\"> <a href=\"ftp://example.org/x\">ftp</a>"]},
+  {"cell_type": "code", "execution_count": 1, "metadata": {}, "source": [],
+   "outputs": [
+    {"output_type": "display_data", "metadata": {},
+     "data": {"text/plain": ["<IPython.core.display.Javascript object>"], "application/javascript": ["alert(3)"]}},
+    {"output_type": "display_data", "metadata": {},
+     "data": {"text/html": ["<p style=\"color: red; position: fixed\" onclick=\"alert(4)\">red</p><script>alert(5)</script>"]}},
+    {"output_type": "display_data", "metadata": {},
+     "data": {"image/svg+xml": ["<svg onload=\"alert(6)\"></svg>"]}},
+    {"output_type": "display_data", "metadata": {"image/png": {"width": 320}},
+     "data": {"image/png": "aGVs\nbG8=\n"}}
+   ]}
+ ],
+ "metadata": {},
+ "nbformat": 4,
+ "nbformat_minor": 4
+}`
+
+func TestRenderer_Sanitize(t *testing.T) {
+	nb, err := notebook.Parse([]byte(untrustedNotebook))
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name        string
+		policy      Policy
+		contains    []string
+		notContains []string
+	}{
+		{
+			"default",
+			Policy{},
+			[]string{
+				`<p>click <img src="x"> ftp</p>`,
+				`<pre>&lt;IPython.core.display.Javascript object&gt;</pre>`,
+				`<p>red</p>`,
+				`<img src="data:image/svg+xml;base64,PHN2ZyBvbmxvYWQ9ImFsZXJ0KDYpIj48L3N2Zz4=">`,
+				`<img src="data:image/png;base64,aGVsbG8=" width="320">`,
+			},
+			[]string{"alert("},
+		},
+		{
+			"styles and schemes",
+			Policy{AllowStyles: true, URLSchemes: []string{"ftp", "javascript"}},
+			[]string{
+				`<a href="ftp://example.org/x" rel="nofollow">ftp</a>`,
+				`<p style="color: red">red</p>`,
+			},
+			[]string{"alert(", "position"},
+		},
+		{
+			"disabled",
+			Policy{Disabled: true},
+			[]string{
+				`<a href="javascript:alert(1)">click</a>`,
+				"<script type=\"text/javascript\">\nalert(3)\n</script>",
+				`<script>alert(5)</script>`,
+				`<svg onload="alert(6)"></svg>`,
+				`<img src="data:image/png;base64,aGVsbG8=" width="320">`,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, err := New(Sanitize(tt.policy)).String(nb, "")
+			assert.Nil(t, err)
+
+			for _, c := range tt.contains {
+				assert.Contains(t, html, c)
+			}
+
+			for _, c := range tt.notContains {
+				assert.NotContains(t, html, c)
+			}
+
+			// Images can not break out of their attribute with any policy.
+			html, err = New(Sanitize(tt.policy)).String(imageNotebook(t, `"AAAA\"><script>alert(7)</script><x\""`), "")
+			assert.NotNil(t, err)
+			assert.NotContains(t, html, "alert(7)")
+		})
+	}
+}
+
+func TestRenderer_Trusted(t *testing.T) {
+	nb, err := notebook.Parse([]byte(untrustedNotebook))
+	assert.Nil(t, err)
+
+	html, err := New().Trusted().String(nb, "")
+	assert.Nil(t, err)
+
+	assert.Contains(t, html, "<script type=\"text/javascript\">\nalert(3)\n</script>")
+	assert.Contains(t, html, `<script>alert(5)</script>`)
+	assert.Contains(t, html, `<svg onload="alert(6)"></svg>`)
+
+	// Markdown cells are never trusted.
+	assert.Contains(t, html, `<p>click <img src="x"> ftp</p>`)
+	assert.NotContains(t, html, "alert(1)")
+	assert.NotContains(t, html, "alert(2)")
+}
+
+func TestRenderer_Disabled(t *testing.T) {
+	html, err := New(Disabled([]string{"text/html", "image/png"})).String(parse(t), "")
+	assert.Nil(t, err)
+
+	assert.Contains(t, html, `data-mime-type="text/plain">
+<pre>&lt;b&gt;2&lt;/b&gt;</pre>`)
+	assert.Contains(t, html, `<pre>&lt;Figure&gt;</pre>`)
+}
+
+func TestRegistry_Register(t *testing.T) {
+	const plotly = "application/vnd.plotly.v1+json"
+
+	registry := NewRegistry()
+	registry.Register(plotly, MimeRendererFunc(func(m *MimeData) (template.HTML, error) {
+		return m.Sanitize(`<div class="plotly" onclick="alert(1)">` + template.HTMLEscapeString(m.Data) + `</div>`), nil
+	}))
+
+	assert.Equal(t, plotly, registry.Priority()[0])
+	assert.Equal(t, DefaultPriority, registry.Priority()[1:])
+
+	nb, err := notebook.Parse([]byte(`{"nbformat": 4, "nbformat_minor": 4, "metadata": {}, "cells": [
+		{"cell_type": "code", "execution_count": 1, "metadata": {}, "source": "", "outputs": [
+			{"output_type": "display_data", "metadata": {}, "data": {"text/plain": "<Figure>", "application/vnd.plotly.v1+json": {"data": []}}}
+		]}
+	]}`))
+	assert.Nil(t, err)
+
+	html, err := New(Renderers(registry)).String(nb, "")
+	assert.Nil(t, err)
+	assert.Contains(t, html, `<div class="plotly">{&#34;data&#34;: []}</div>`)
+
+	html, err = New(Renderers(registry), Priority([]string{"text/plain", plotly})).String(nb, "")
+	assert.Nil(t, err)
+	assert.Contains(t, html, `<pre>&lt;Figure&gt;</pre>`)
+
+	html, err = New().String(nb, "")
+	assert.Nil(t, err)
+	assert.Contains(t, html, `<pre>&lt;Figure&gt;</pre>`)
+}
+
+func TestRenderer_Streams(t *testing.T) {
+	nb, err := notebook.Parse([]byte(`{"nbformat": 4, "nbformat_minor": 4, "metadata": {}, "cells": [
+		{"cell_type": "code", "execution_count": 1, "metadata": {}, "source": "", "outputs": [
+			{"output_type": "stream", "name": "stderr", "text": "  0%|          | 0/2\r"},
+			{"output_type": "stream", "name": "stderr", "text": " 50%|\u001b[32m█████     \u001b[0m| 1/2\r"},
+			{"output_type": "stream", "name": "stderr", "text": "100%|\u001b[32m██████████\u001b[0m| 2/2\n"},
+			{"output_type": "stream", "name": "stdout", "text": "done\n"}
+		]}
+	]}`))
+	assert.Nil(t, err)
+
+	html, err := New().String(nb, "")
+	assert.Nil(t, err)
+
+	assert.Contains(t, html, `<div class="output-area output-stream stderr">
+<pre>100%|<span class="ansi-green-fg">██████████</span>| 2/2
+</pre>`)
+	assert.Contains(t, html, `<div class="output-area output-stream stdout">
+<pre>done
+</pre>`)
+	assert.NotContains(t, html, "50%")
+}
+
+func TestRenderer_Highlight(t *testing.T) {
+	nb, err := notebook.Parse([]byte(`{
+ "cells": [
+  {"cell_type": "code", "execution_count": 1, "metadata": {}, "source": ["%%bash\n", "echo $HOME"], "outputs": []},
+  {"cell_type": "code", "execution_count": 2, "metadata": {}, "source": ["x <- c(1, 2)"], "outputs": []}
+ ],
+ "metadata": {"language_info": {"name": "R"}},
+ "nbformat": 4,
+ "nbformat_minor": 4
+}`))
+	assert.Nil(t, err)
+
+	html, err := New(Theme("monokai")).String(nb, "")
+	assert.Nil(t, err)
+
+	assert.Contains(t, html, `<code class="language-R">`)
+	assert.Contains(t, html, `<span class="o">&lt;-</span>`)
+	assert.Contains(t, html, `.chroma .k { color: #66d9ef }`)
+
+	// Magics switch the lexer of Python notebooks only.
+	assert.NotContains(t, html, `<span class="nv">$HOME</span>`)
+
+	html, err = New().WithTheme("unknown").String(parse(t), "")
+	assert.Nil(t, err)
+	assert.Contains(t, html, `.chroma .k { color: #008000; font-weight: bold }`)
+}
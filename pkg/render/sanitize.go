@@ -0,0 +1,107 @@
+package render
+
+import (
+	"html/template"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// Policy defines how untrusted content is sanitised. The zero value
+// sanitises with the default allow-list.
+type Policy struct {
+	// Disabled renders untrusted content as it is.
+	Disabled bool
+
+	// URLSchemes lists URL schemes allowed in addition to http, https and mailto.
+	URLSchemes []string
+
+	// AllowStyles keeps inline styles of well-known CSS properties.
+	AllowStyles bool
+}
+
+var (
+	// scriptSchemes are never allowed, even if configured.
+	scriptSchemes = []string{"javascript", "vbscript"}
+
+	// styleProperties are the CSS properties kept if styles are allowed.
+	styleProperties = []string{
+		"color",
+		"background-color",
+		"font-style",
+		"font-weight",
+		"text-align",
+		"text-decoration",
+		"vertical-align",
+		"white-space",
+		"border",
+		"border-collapse",
+		"padding",
+		"margin",
+		"width",
+		"height",
+	}
+
+	// unsafeMimeTypes are dropped from untrusted outputs.
+	unsafeMimeTypes = []string{
+		"application/javascript",
+		"text/javascript",
+	}
+)
+
+// sanitizer applies a policy to untrusted HTML.
+type sanitizer struct {
+	disabled bool
+	policy   *bluemonday.Policy
+}
+
+// newSanitizer builds the allow-list for a policy.
+func newSanitizer(p Policy) *sanitizer {
+	policy := bluemonday.UGCPolicy()
+	policy.AllowDataURIImages()
+	policy.AllowAttrs("class").Globally()
+
+	for _, scheme := range p.URLSchemes {
+		scheme = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(scheme), ":"))
+
+		if scheme == "" || contains(scriptSchemes, scheme) {
+			continue
+		}
+
+		policy.AllowURLSchemes(scheme)
+	}
+
+	if p.AllowStyles {
+		policy.AllowStyles(styleProperties...).Globally()
+	}
+
+	return &sanitizer{
+		disabled: p.Disabled,
+		policy:   policy,
+	}
+}
+
+// html returns untrusted HTML with everything outside the allow-list removed.
+func (s *sanitizer) html(html string) template.HTML {
+	if s.disabled {
+		return template.HTML(html)
+	}
+
+	return template.HTML(s.policy.Sanitize(html))
+}
+
+// allowed returns false for representations which are dropped completely.
+func (s *sanitizer) allowed(mime string) bool {
+	return s.disabled || !contains(unsafeMimeTypes, mime)
+}
+
+// contains returns true if list contains s.
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,273 @@
+package render
+
+import (
+	"html/template"
+)
+
+// page defines the data of the page template.
+type page struct {
+	Title    string
+	Language string
+	Style    template.CSS
+	Cells    []cell
+}
+
+// cell defines a single cell of the page.
+type cell struct {
+	Type     string
+	Prompt   string
+	Language string
+	Source   template.HTML
+	Body     template.HTML
+	Outputs  []output
+}
+
+// output defines a single output of a code cell.
+type output struct {
+	Type   string
+	Name   string
+	Mime   string
+	Prompt string
+	Body   template.HTML
+}
+
+// pageStyle is the stylesheet of notebooks shared by all pages.
+const pageStyle = `body { margin: 0; font-family: -apple-system, "Segoe UI", Roboto, Helvetica, Arial, sans-serif; font-size: 14px; line-height: 1.5; color: #000; background: #fff; }
+pre, code { font-family: Menlo, Consolas, "DejaVu Sans Mono", monospace; font-size: 13px; }
+pre { margin: 0; padding: 0; white-space: pre-wrap; word-wrap: break-word; }
+.notebook { max-width: 1140px; margin: 0 auto; padding: 15px; }
+.cell { display: flex; flex-direction: column; margin: 0 0 10px; }
+.input, .output { display: flex; flex-direction: row; }
+.prompt { flex: 0 0 90px; min-width: 90px; padding: 0.4em 0.5em 0 0; text-align: right; font-family: Menlo, Consolas, monospace; font-size: 13px; }
+.input .prompt { color: #303f9f; }
+.output .prompt { color: #d84315; }
+.input-area { flex: 1; min-width: 0; padding: 0.4em; border: 1px solid #cfcfcf; border-radius: 2px; background: #f7f7f7; }
+.output-area { flex: 1; min-width: 0; padding: 0.4em; overflow-x: auto; }
+.output-area img { max-width: 100%; height: auto; }
+.output-stream.stderr { background: #fdd; }
+.output-error pre { color: #b22b31; }
+.text-cell { margin-left: 90px; padding: 0.4em; overflow-x: auto; }
+.text-cell img { max-width: 100%; }
+.text-cell table, .output-area table { border-collapse: collapse; }
+.text-cell th, .text-cell td, .output-area th, .output-area td { padding: 0.25em 0.5em; border: 1px solid #cfcfcf; }
+.latex { font-family: Menlo, Consolas, monospace; }
+.ansi-black-fg { color: #3e424d; } .ansi-black-bg { background-color: #3e424d; }
+.ansi-black-intense-fg { color: #282c36; } .ansi-black-intense-bg { background-color: #282c36; }
+.ansi-red-fg { color: #e75c58; } .ansi-red-bg { background-color: #e75c58; }
+.ansi-red-intense-fg { color: #b22b31; } .ansi-red-intense-bg { background-color: #b22b31; }
+.ansi-green-fg { color: #00a250; } .ansi-green-bg { background-color: #00a250; }
+.ansi-green-intense-fg { color: #007427; } .ansi-green-intense-bg { background-color: #007427; }
+.ansi-yellow-fg { color: #ddb62b; } .ansi-yellow-bg { background-color: #ddb62b; }
+.ansi-yellow-intense-fg { color: #b27d12; } .ansi-yellow-intense-bg { background-color: #b27d12; }
+.ansi-blue-fg { color: #208ffb; } .ansi-blue-bg { background-color: #208ffb; }
+.ansi-blue-intense-fg { color: #0065ca; } .ansi-blue-intense-bg { background-color: #0065ca; }
+.ansi-magenta-fg { color: #d160c4; } .ansi-magenta-bg { background-color: #d160c4; }
+.ansi-magenta-intense-fg { color: #a03196; } .ansi-magenta-intense-bg { background-color: #a03196; }
+.ansi-cyan-fg { color: #60c6c8; } .ansi-cyan-bg { background-color: #60c6c8; }
+.ansi-cyan-intense-fg { color: #258f8f; } .ansi-cyan-intense-bg { background-color: #258f8f; }
+.ansi-white-fg { color: #c5c1b4; } .ansi-white-bg { background-color: #c5c1b4; }
+.ansi-white-intense-fg { color: #a1a6b2; } .ansi-white-intense-bg { background-color: #a1a6b2; }
+.ansi-default-inverse-fg { color: #fff; } .ansi-default-inverse-bg { background-color: #000; }
+.ansi-bold { font-weight: bold; } .ansi-italic { font-style: italic; } .ansi-underline { text-decoration: underline; }`
+
+// templates holds the base stylesheet shared by the page templates.
+var templates = template.Must(template.New("style").Parse(pageStyle))
+
+// pageTemplate renders a self-contained document, all styles are inlined.
+var pageTemplate = template.Must(templates.New("page").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<title>{{ .Title }}</title>
+<style type="text/css">
+{{ template "style" }}
+{{ .Style }}
+</style>
+</head>
+<body>
+<div class="notebook" data-language="{{ .Language }}">
+{{- range .Cells }}
+{{- if eq .Type "code" }}
+<div class="cell code-cell">
+<div class="input">
+<div class="prompt">{{ .Prompt }}</div>
+<div class="input-area chroma"><pre><code{{ if .Language }} class="language-{{ .Language }}"{{ end }}>{{ .Source }}</code></pre></div>
+</div>
+{{- range .Outputs }}
+<div class="output">
+<div class="prompt">{{ .Prompt }}</div>
+<div class="output-area output-{{ .Type }}{{ if .Name }} {{ .Name }}{{ end }}"{{ if .Mime }} data-mime-type="{{ .Mime }}"{{ end }}>
+{{ .Body }}
+</div>
+</div>
+{{- end }}
+</div>
+{{- else if .Body }}
+<div class="cell text-cell {{ .Type }}-cell">
+{{ .Body }}
+</div>
+{{- end }}
+{{- end }}
+</div>
+</body>
+</html>
+`))
+
+// comparison defines the data of the compare template.
+type comparison struct {
+	Title    string
+	OldTitle string
+	NewTitle string
+	Language string
+	Style    template.CSS
+	Changed  bool
+	Rows     []row
+}
+
+// row defines a cell in both versions of the compare page.
+type row struct {
+	Op   string
+	Type string
+
+	// Link is the anchor of the other position of a moved cell.
+	Link string
+
+	// Old and New are nil if the cell does not exist in a version.
+	Old     *side
+	New     *side
+	Outputs []outputRow
+}
+
+// Versions returns the cell in the old and in the new version.
+func (r row) Versions() []version {
+	return []version{{"old", r.Old}, {"new", r.New}}
+}
+
+// version defines a cell in one of the versions of a row.
+type version struct {
+	Name string
+	Side *side
+}
+
+// side defines a cell in one version of the compare page, either as lines
+// of its source or rendered.
+type side struct {
+	Anchor string
+	Prompt string
+	Lines  []line
+	Body   template.HTML
+}
+
+// line defines a source line of the compare page.
+type line struct {
+	Op   string
+	Text template.HTML
+}
+
+// outputRow defines an output in both versions of the compare page.
+type outputRow struct {
+	Op string
+
+	// Image is set if an image of the output changed.
+	Image bool
+
+	Old *output
+	New *output
+}
+
+// Versions returns the output in the old and in the new version.
+func (o outputRow) Versions() []outputVersion {
+	return []outputVersion{{"old", o.Old}, {"new", o.New}}
+}
+
+// outputVersion defines an output in one of the versions of a row.
+type outputVersion struct {
+	Name   string
+	Output *output
+}
+
+// compareTemplate renders two versions of a notebook side by side as a
+// self-contained document.
+var compareTemplate = template.Must(templates.New("compare").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<title>{{ .Title }}</title>
+<style type="text/css">
+{{ template "style" }}
+.compare { max-width: none; }
+.versions, .row, .output-row { display: flex; flex-direction: row; }
+.versions { position: sticky; top: 0; z-index: 1; background: #fff; border-bottom: 1px solid #cfcfcf; font-weight: bold; }
+.side { flex: 1 1 50%; min-width: 0; padding: 0.4em 0.5em; }
+.row { margin: 0 0 10px; border-left: 4px solid transparent; }
+.row-added { border-left-color: #28a745; }
+.row-removed { border-left-color: #d73a49; }
+.row-modified { border-left-color: #dbab09; }
+.row-moved { border-left-color: #0366d6; }
+.row-unchanged .side { opacity: 0.75; }
+.missing { background: repeating-linear-gradient(135deg, #fafafa, #fafafa 6px, #f0f0f0 6px, #f0f0f0 12px); }
+.label { font-size: 12px; color: #586069; }
+.label a { color: #0366d6; }
+.side .text-cell { margin-left: 0; }
+.source { padding: 0.4em; border: 1px solid #cfcfcf; border-radius: 2px; background: #f7f7f7; }
+.source-line { display: block; min-height: 1.5em; }
+.source-added { background: #e6ffed; }
+.source-removed { background: #ffeef0; }
+.output-row .side { padding-top: 0; }
+.output-image-changed { outline: 2px solid #dbab09; outline-offset: -2px; }
+.output-image-changed .output-area img { background: #fff; border: 1px solid #cfcfcf; }
+{{ .Style }}
+</style>
+</head>
+<body>
+<div class="notebook compare" data-language="{{ .Language }}" data-changed="{{ .Changed }}">
+<div class="versions">
+<div class="side">{{ .OldTitle }}</div>
+<div class="side">{{ .NewTitle }}</div>
+</div>
+{{- range .Rows }}
+{{- $row := . }}
+<div class="row row-{{ .Op }} {{ .Type }}-row">
+{{- range .Versions }}
+{{- $version := .Name }}
+{{- $side := .Side }}
+{{- if $side }}
+<div class="side {{ $version }}" id="{{ $side.Anchor }}">
+<div class="label">{{ $row.Type }} {{ $row.Op }}{{ if $row.Link }}, <a href="#{{ $row.Link }}">{{ if eq $version "old" }}to{{ else }}from{{ end }} {{ $row.Link }}</a>{{ end }}{{ if $side.Prompt }} {{ $side.Prompt }}{{ end }}</div>
+{{- if $side.Body }}
+<div class="text-cell">
+{{ $side.Body }}
+</div>
+{{- else }}
+<div class="source chroma"><pre><code>{{ range $side.Lines }}<span class="source-line source-{{ .Op }}">{{ .Text }}</span>{{ end }}</code></pre></div>
+{{- end }}
+</div>
+{{- else }}
+<div class="side {{ $version }} missing"></div>
+{{- end }}
+{{- end }}
+</div>
+{{- range .Outputs }}
+<div class="output-row output-{{ .Op }}{{ if .Image }} output-image-changed{{ end }}">
+{{- range .Versions }}
+{{- $version := .Name }}
+{{- $output := .Output }}
+{{- if $output }}
+<div class="side {{ $version }}">
+<div class="output-area output-{{ $output.Type }}{{ if $output.Name }} {{ $output.Name }}{{ end }}"{{ if $output.Mime }} data-mime-type="{{ $output.Mime }}"{{ end }}>
+{{ $output.Body }}
+</div>
+</div>
+{{- else }}
+<div class="side {{ $version }} missing"></div>
+{{- end }}
+{{- end }}
+</div>
+{{- end }}
+{{- end }}
+</div>
+</body>
+</html>
+`))
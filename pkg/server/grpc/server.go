@@ -1,9 +1,11 @@
 package grpc
 
 import (
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/events"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
 	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/version"
+	"github.com/micro/go-micro/v2"
 	"github.com/owncloud/ocis/ocis-pkg/service/grpc"
 )
 
@@ -21,13 +23,44 @@ func Server(opts ...Option) grpc.Service {
 		grpc.Flags(options.Flags...),
 	)
 
-	handler := svc.NewService()
+	handler := svc.NewService(
+		svc.Config(options.Config),
+		svc.Storage(options.Storage),
+		svc.Cache(options.Cache),
+		svc.Contents(options.Contents),
+		svc.KernelSpecs(options.KernelSpecs),
+	)
 	handler = svc.NewInstrument(handler, options.Metrics)
 	handler = svc.NewLogging(handler, options.Logger)
-	if err := proto.RegisterHelloHandler(service.Server(), handler); err != nil {
+	if err := proto.RegisterNotebookHandler(service.Server(), handler); err != nil {
 		options.Logger.Fatal().Err(err).Msg("could not register ocis-jupyter service handler")
 	}
 
-	service.Init()
+	var handlers []events.Handler
+
+	if options.Cache != nil {
+		handlers = append(handlers, options.Cache)
+	}
+
+	subscriber := events.NewSubscriber(
+		service.Options().Broker,
+		events.Logger(options.Logger),
+		events.Context(options.Context),
+		events.Topic(events.Created, options.Config.Events.Topics.Created),
+		events.Topic(events.Modified, options.Config.Events.Topics.Modified),
+		events.Topic(events.Moved, options.Config.Events.Topics.Moved),
+		events.Topic(events.Deleted, options.Config.Events.Topics.Deleted),
+		events.Queue(options.Config.Events.Queue),
+		events.Workers(options.Config.Events.Workers),
+		events.Handlers(handlers...),
+	)
+
+	// The broker is connected when the server starts, subscriptions have to
+	// wait until then.
+	service.Init(
+		micro.AfterStart(subscriber.Start),
+		micro.BeforeStop(subscriber.Stop),
+	)
+
 	return service
 }
@@ -0,0 +1,289 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/contents"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+)
+
+// contentsError is the body of error responses of the Contents API.
+type contentsError struct {
+	Message string  `json:"message"`
+	Reason  *string `json:"reason"`
+}
+
+// checkpointsHandlerFunc handles requests of the checkpoints of a file, the
+// id is empty for requests of all checkpoints.
+type checkpointsHandlerFunc func(w http.ResponseWriter, r *http.Request, p, id string)
+
+// contentsRouter serves the Contents API of the Jupyter Server, so stock
+// Jupyter clients can open and save files in the storage. It is mounted at
+// /api/contents below the given root.
+func contentsRouter(manager *contents.Manager, root string) http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/*", withCheckpoints(getContents(manager), listCheckpoints(manager)))
+	r.Put("/*", saveContents(manager))
+	r.Post("/*", withCheckpoints(createContents(manager, root), createCheckpoint(manager, root)))
+	r.Patch("/*", renameContents(manager))
+	r.Delete("/*", withCheckpoints(deleteContents(manager), deleteCheckpoint(manager)))
+
+	return r
+}
+
+// withCheckpoints serves requests of paths ending with /checkpoints or
+// /checkpoints/{id} with the handler of checkpoints, all others with the
+// one of files. chi can not match a pattern after the path of a file.
+func withCheckpoints(files http.HandlerFunc, checkpoints checkpointsHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(contentsPath(r), "/")
+		n := len(segments)
+
+		switch {
+		case n >= 2 && segments[n-1] == "checkpoints":
+			checkpoints(w, r, strings.Join(segments[:n-1], "/"), "")
+		case n >= 3 && segments[n-2] == "checkpoints":
+			checkpoints(w, r, strings.Join(segments[:n-2], "/"), segments[n-1])
+		default:
+			files(w, r)
+		}
+	}
+}
+
+// getContents returns the model of a file, with content unless content=0
+// is requested.
+func getContents(manager *contents.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		opts := contents.GetOptions{
+			Type:    r.URL.Query().Get("type"),
+			Format:  r.URL.Query().Get("format"),
+			Content: true,
+		}
+
+		switch r.URL.Query().Get("content") {
+		case "", "1":
+		case "0":
+			opts.Content = false
+		default:
+			writeContentsError(w, r, http.StatusBadRequest, "content must be 0 or 1")
+			return
+		}
+
+		model, err := manager.Get(r.Context(), contentsPath(r), opts)
+
+		if err != nil {
+			writeContentsError(w, r, contentsStatus(err), err.Error())
+			return
+		}
+
+		render.JSON(w, r, model)
+	}
+}
+
+// saveContents writes a file or creates a folder, it responds with 201 if
+// the file has been created.
+func saveContents(manager *contents.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := &contents.Request{}
+
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			writeContentsError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		model, created, err := manager.Save(r.Context(), contentsPath(r), req)
+
+		if err != nil {
+			writeContentsError(w, r, contentsStatus(err), err.Error())
+			return
+		}
+
+		if created {
+			render.Status(r, http.StatusCreated)
+		}
+
+		render.JSON(w, r, model)
+	}
+}
+
+// createContents adds an untitled file to a folder or copies a file into
+// it. The location of the new file is returned in the Location header.
+func createContents(manager *contents.Manager, root string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := &contents.Request{}
+
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil && err != io.EOF {
+			writeContentsError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		model, err := manager.Create(r.Context(), contentsPath(r), req)
+
+		if err != nil {
+			writeContentsError(w, r, contentsStatus(err), err.Error())
+			return
+		}
+
+		location := &url.URL{
+			Path: path.Join(root, "/api/contents", model.Path),
+		}
+
+		w.Header().Set("Location", location.EscapedPath())
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, model)
+	}
+}
+
+// renameContents moves a file to the path given in the request body.
+func renameContents(manager *contents.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := &contents.Request{}
+
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			writeContentsError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if req.Path == "" {
+			writeContentsError(w, r, http.StatusBadRequest, "missing path")
+			return
+		}
+
+		model, err := manager.Rename(r.Context(), contentsPath(r), req.Path)
+
+		if err != nil {
+			writeContentsError(w, r, contentsStatus(err), err.Error())
+			return
+		}
+
+		render.JSON(w, r, model)
+	}
+}
+
+// deleteContents removes a file or a folder.
+func deleteContents(manager *contents.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := manager.Delete(r.Context(), contentsPath(r)); err != nil {
+			writeContentsError(w, r, contentsStatus(err), err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// listCheckpoints returns the checkpoints of a file.
+func listCheckpoints(manager *contents.Manager) checkpointsHandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, p, id string) {
+		if id != "" {
+			writeContentsError(w, r, http.StatusMethodNotAllowed, "checkpoints can only be restored or deleted")
+			return
+		}
+
+		checkpoints, err := manager.ListCheckpoints(r.Context(), p)
+
+		if err != nil {
+			writeContentsError(w, r, contentsStatus(err), err.Error())
+			return
+		}
+
+		render.JSON(w, r, checkpoints)
+	}
+}
+
+// createCheckpoint takes a snapshot of a file or, if an id is given,
+// restores the file from a checkpoint.
+func createCheckpoint(manager *contents.Manager, root string) checkpointsHandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, p, id string) {
+		if id != "" {
+			if err := manager.RestoreCheckpoint(r.Context(), p, id); err != nil {
+				writeContentsError(w, r, contentsStatus(err), err.Error())
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		checkpoint, err := manager.CreateCheckpoint(r.Context(), p)
+
+		if err != nil {
+			writeContentsError(w, r, contentsStatus(err), err.Error())
+			return
+		}
+
+		location := &url.URL{
+			Path: path.Join(root, "/api/contents", p, "checkpoints", checkpoint.ID),
+		}
+
+		w.Header().Set("Location", location.EscapedPath())
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, checkpoint)
+	}
+}
+
+// deleteCheckpoint removes a checkpoint of a file.
+func deleteCheckpoint(manager *contents.Manager) checkpointsHandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, p, id string) {
+		if id == "" {
+			writeContentsError(w, r, http.StatusMethodNotAllowed, "missing checkpoint id")
+			return
+		}
+
+		if err := manager.DeleteCheckpoint(r.Context(), p, id); err != nil {
+			writeContentsError(w, r, contentsStatus(err), err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// contentsPath returns the path of the file a request is about. chi matches
+// the escaped path if the request path contains escaped slashes, in that
+// case the path is unescaped.
+func contentsPath(r *http.Request) string {
+	p := chi.URLParam(r, "*")
+
+	if r.URL.RawPath != "" {
+		if unescaped, err := url.PathUnescape(p); err == nil {
+			p = unescaped
+		}
+	}
+
+	return p
+}
+
+// contentsStatus returns the HTTP status for errors of the Contents API.
+func contentsStatus(err error) int {
+	switch {
+	case errors.Is(err, contents.ErrNoStorage), errors.Is(err, storage.ErrNotSupported):
+		return http.StatusNotImplemented
+	case errors.Is(err, storage.ErrExists):
+		return http.StatusConflict
+	case errors.Is(err, contents.ErrInvalidType),
+		errors.Is(err, contents.ErrInvalidFormat),
+		errors.Is(err, contents.ErrInvalidContent),
+		errors.Is(err, storage.ErrInvalidReference):
+		return http.StatusBadRequest
+	}
+
+	return storageStatus(err)
+}
+
+// writeContentsError responds with an error the way the Jupyter Server does.
+func writeContentsError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	render.Status(r, status)
+	render.JSON(w, r, contentsError{
+		Message: message,
+	})
+}
@@ -0,0 +1,214 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/contents"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+// newContents returns the Contents API on top of a memory storage with the
+// given files, mounted like the server does.
+func newContents(t *testing.T, files map[string]string) http.Handler {
+	drv := storage.NewMemory()
+
+	for p, content := range files {
+		_, err := drv.Write(context.Background(), storage.Reference{Path: p}, []byte(content), "")
+		assert.Nil(t, err)
+	}
+
+	mux := chi.NewRouter()
+	mux.Mount("/api/contents", contentsRouter(contents.New(drv), "/"))
+
+	return mux
+}
+
+// request sends a request to the handler, body is encoded as JSON unless it
+// is nil.
+func request(h http.Handler, method, target string, body interface{}) *httptest.ResponseRecorder {
+	var r io.Reader
+
+	if body != nil {
+		b, _ := json.Marshal(body)
+		r = strings.NewReader(string(b))
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, target, r))
+
+	return rec
+}
+
+// decode decodes the JSON body of a response.
+func decode(t *testing.T, rec *httptest.ResponseRecorder, v interface{}) {
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), v))
+}
+
+func TestContents_Get(t *testing.T) {
+	h := newContents(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+	})
+
+	rec := request(h, http.MethodGet, "/api/contents/Notebooks/analysis.ipynb", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	model := map[string]interface{}{}
+	decode(t, rec, &model)
+	assert.Equal(t, "Notebooks/analysis.ipynb", model["path"])
+	assert.Equal(t, contents.TypeNotebook, model["type"])
+	assert.Equal(t, contents.FormatJSON, model["format"])
+	assert.NotNil(t, model["content"])
+
+	// Escaped slashes are unescaped, content=0 leaves out the content.
+	rec = request(h, http.MethodGet, "/api/contents/Notebooks%2Fanalysis.ipynb?content=0", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	model = map[string]interface{}{}
+	decode(t, rec, &model)
+	assert.Equal(t, "Notebooks/analysis.ipynb", model["path"])
+	assert.Nil(t, model["content"])
+	assert.Nil(t, model["format"])
+
+	rec = request(h, http.MethodGet, "/api/contents/Notebooks?content=1", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	model = map[string]interface{}{}
+	decode(t, rec, &model)
+	assert.Equal(t, contents.TypeDirectory, model["type"])
+	assert.Len(t, model["content"], 1)
+
+	for target, status := range map[string]int{
+		"/api/contents/Notebooks/analysis.ipynb?content=2":        http.StatusBadRequest,
+		"/api/contents/Notebooks/analysis.ipynb?type=directory":   http.StatusBadRequest,
+		"/api/contents/Notebooks/missing.ipynb":                   http.StatusNotFound,
+		"/api/contents/Notebooks/analysis.ipynb/checkpoints/1":    http.StatusMethodNotAllowed,
+		"/api/contents/Notebooks/missing.ipynb/checkpoints":       http.StatusNotFound,
+		"/api/contents/Notebooks/analysis.ipynb?type=unsupported": http.StatusBadRequest,
+	} {
+		rec := request(h, http.MethodGet, target, nil)
+		assert.Equal(t, status, rec.Code, target)
+
+		body := contentsError{}
+		decode(t, rec, &body)
+		assert.NotEmpty(t, body.Message, target)
+	}
+}
+
+func TestContents_Save(t *testing.T) {
+	h := newContents(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+	})
+
+	notebook := map[string]interface{}{
+		"type":    contents.TypeNotebook,
+		"content": json.RawMessage(testNotebook),
+	}
+
+	rec := request(h, http.MethodPut, "/api/contents/Notebooks/copy.ipynb", notebook)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	rec = request(h, http.MethodPut, "/api/contents/Notebooks/copy.ipynb", notebook)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	model := map[string]interface{}{}
+	decode(t, rec, &model)
+	assert.Equal(t, "Notebooks/copy.ipynb", model["path"])
+	assert.Nil(t, model["content"])
+
+	rec = request(h, http.MethodPut, "/api/contents/Notebooks/copy.ipynb", map[string]interface{}{})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = request(h, http.MethodPut, "/api/contents/Notebooks", notebook)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Without a storage the API is not implemented.
+	mux := chi.NewRouter()
+	mux.Mount("/api/contents", contentsRouter(contents.New(nil), "/"))
+
+	rec = request(mux, http.MethodPut, "/api/contents/Notebooks/copy.ipynb", notebook)
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+}
+
+func TestContents_CreateRenameDelete(t *testing.T) {
+	h := newContents(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+	})
+
+	rec := request(h, http.MethodPost, "/api/contents/Notebooks", map[string]string{"type": contents.TypeDirectory})
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "/api/contents/Notebooks/Untitled%20Folder", rec.Header().Get("Location"))
+
+	// An empty body creates an untitled file.
+	rec = request(h, http.MethodPost, "/api/contents/Notebooks", nil)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "/api/contents/Notebooks/untitled", rec.Header().Get("Location"))
+
+	rec = request(h, http.MethodPost, "/api/contents/Notebooks", map[string]string{"copy_from": "Notebooks/analysis.ipynb"})
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "/api/contents/Notebooks/analysis-Copy1.ipynb", rec.Header().Get("Location"))
+
+	rec = request(h, http.MethodPatch, "/api/contents/Notebooks/analysis-Copy1.ipynb", map[string]string{"path": "Notebooks/analysis.ipynb"})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = request(h, http.MethodPatch, "/api/contents/Notebooks/analysis-Copy1.ipynb", map[string]string{})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = request(h, http.MethodPatch, "/api/contents/Notebooks/analysis-Copy1.ipynb", map[string]string{"path": "Notebooks/renamed.ipynb"})
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	model := map[string]interface{}{}
+	decode(t, rec, &model)
+	assert.Equal(t, "Notebooks/renamed.ipynb", model["path"])
+
+	rec = request(h, http.MethodDelete, "/api/contents/Notebooks/renamed.ipynb", nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = request(h, http.MethodDelete, "/api/contents/Notebooks/renamed.ipynb", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestContents_Checkpoints(t *testing.T) {
+	h := newContents(t, map[string]string{
+		"/Notebooks/analysis.ipynb": testNotebook,
+	})
+
+	rec := request(h, http.MethodPost, "/api/contents/Notebooks/analysis.ipynb/checkpoints", nil)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "/api/contents/Notebooks/analysis.ipynb/checkpoints/1", rec.Header().Get("Location"))
+
+	checkpoint := contents.Checkpoint{}
+	decode(t, rec, &checkpoint)
+	assert.Equal(t, "1", checkpoint.ID)
+
+	rec = request(h, http.MethodGet, "/api/contents/Notebooks%2Fanalysis.ipynb/checkpoints", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	checkpoints := []contents.Checkpoint{}
+	decode(t, rec, &checkpoints)
+	assert.Len(t, checkpoints, 1)
+
+	rec = request(h, http.MethodPost, "/api/contents/Notebooks/analysis.ipynb/checkpoints/1", nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = request(h, http.MethodDelete, "/api/contents/Notebooks/analysis.ipynb/checkpoints", nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = request(h, http.MethodDelete, "/api/contents/Notebooks/analysis.ipynb/checkpoints/1", nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = request(h, http.MethodDelete, "/api/contents/Notebooks/analysis.ipynb/checkpoints/1", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// The file itself is still served by the handler of files.
+	rec = request(h, http.MethodDelete, "/api/contents/Notebooks/analysis.ipynb", nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -0,0 +1,168 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+)
+
+// kernelSpecs is the body of the kernelspecs API of the Jupyter Server.
+type kernelSpecs struct {
+	Default     string                 `json:"default"`
+	KernelSpecs map[string]*kernelSpec `json:"kernelspecs"`
+}
+
+// kernelSpec is the model of a kernel in the kernelspecs API, spec holds
+// the content of the kernel.json.
+type kernelSpec struct {
+	Name      string            `json:"name"`
+	Spec      kernelSpecFile    `json:"spec"`
+	Resources map[string]string `json:"resources"`
+}
+
+// kernelSpecFile is the content of a kernel.json.
+type kernelSpecFile struct {
+	Argv          []string          `json:"argv"`
+	DisplayName   string            `json:"display_name"`
+	Language      string            `json:"language"`
+	InterruptMode string            `json:"interrupt_mode,omitempty"`
+	Env           map[string]string `json:"env,omitempty"`
+	Metadata      json.RawMessage   `json:"metadata,omitempty"`
+}
+
+// kernelSpecsRouter serves the kernelspecs API of the Jupyter Server and
+// the resources of the kernels. It is mounted at /api/kernelspecs below the
+// root, the resources are served below it as well because the static
+// middleware handles all other paths.
+func kernelSpecsRouter(handle proto.NotebookHandler, manager *kernelspec.Manager) http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/", listKernelSpecs(handle))
+	r.Get("/{name}", getKernelSpec(handle))
+	r.Get("/{name}/{file}", kernelSpecResource(handle, manager))
+
+	return r
+}
+
+// listKernelSpecs returns the models of all allowed kernels.
+func listKernelSpecs(handle proto.NotebookHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rsp := &proto.ListKernelSpecsResponse{}
+
+		if err := handle.ListKernelSpecs(r.Context(), &proto.ListKernelSpecsRequest{}, rsp); err != nil {
+			writeContentsError(w, r, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res := kernelSpecs{
+			Default:     rsp.Default,
+			KernelSpecs: make(map[string]*kernelSpec, len(rsp.Kernelspecs)),
+		}
+
+		for _, spec := range rsp.Kernelspecs {
+			res.KernelSpecs[spec.Name] = newKernelSpec(spec)
+		}
+
+		render.JSON(w, r, res)
+	}
+}
+
+// getKernelSpec returns the model of an allowed kernel.
+func getKernelSpec(handle proto.NotebookHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		spec, err := allowedKernel(r, handle)
+
+		if err != nil {
+			writeContentsError(w, r, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if spec == nil {
+			writeContentsError(w, r, http.StatusNotFound, "Kernel spec "+chi.URLParam(r, "name")+" not found")
+			return
+		}
+
+		render.JSON(w, r, newKernelSpec(spec))
+	}
+}
+
+// kernelSpecResource serves a logo or another resource of an allowed
+// kernel.
+func kernelSpecResource(handle proto.NotebookHandler, manager *kernelspec.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		spec, err := allowedKernel(r, handle)
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if spec == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		installed, err := manager.Get(spec.Name)
+
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		p, err := installed.Resource(chi.URLParam(r, "file"))
+
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		http.ServeFile(w, r, p)
+	}
+}
+
+// allowedKernel returns the kernel named in the request if it is allowed,
+// nil otherwise.
+func allowedKernel(r *http.Request, handle proto.NotebookHandler) (*proto.KernelSpec, error) {
+	rsp := &proto.ListKernelSpecsResponse{}
+
+	if err := handle.ListKernelSpecs(r.Context(), &proto.ListKernelSpecsRequest{}, rsp); err != nil {
+		return nil, err
+	}
+
+	for _, spec := range rsp.Kernelspecs {
+		if spec.Name == chi.URLParam(r, "name") {
+			return spec, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// newKernelSpec returns the model of a kernel.
+func newKernelSpec(spec *proto.KernelSpec) *kernelSpec {
+	res := &kernelSpec{
+		Name: spec.Name,
+		Spec: kernelSpecFile{
+			Argv:          spec.Argv,
+			DisplayName:   spec.DisplayName,
+			Language:      spec.Language,
+			InterruptMode: spec.InterruptMode,
+			Env:           spec.Env,
+		},
+		Resources: spec.Resources,
+	}
+
+	if spec.Metadata != "" {
+		res.Spec.Metadata = json.RawMessage(spec.Metadata)
+	}
+
+	if res.Resources == nil {
+		res.Resources = map[string]string{}
+	}
+
+	return res
+}
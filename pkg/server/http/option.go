@@ -5,7 +5,11 @@ import (
 
 	"github.com/micro/cli/v2"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/contents"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/metrics"
+	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
 	"github.com/owncloud/ocis/ocis-pkg/log"
 )
 
@@ -14,12 +18,16 @@ type Option func(o *Options)
 
 // Options defines the available options for this package.
 type Options struct {
-	Name    string
-	Logger  log.Logger
-	Context context.Context
-	Config  *config.Config
-	Metrics *metrics.Metrics
-	Flags   []cli.Flag
+	Name        string
+	Logger      log.Logger
+	Context     context.Context
+	Config      *config.Config
+	Metrics     *metrics.Metrics
+	Storage     storage.Driver
+	Cache       *svc.RenderCache
+	Contents    *contents.Manager
+	KernelSpecs *kernelspec.Manager
+	Flags       []cli.Flag
 }
 
 // newOptions initializes the available default options.
@@ -68,6 +76,34 @@ func Metrics(val *metrics.Metrics) Option {
 	}
 }
 
+// Storage provides a function to set the storage option.
+func Storage(val storage.Driver) Option {
+	return func(o *Options) {
+		o.Storage = val
+	}
+}
+
+// Contents provides a function to set the contents manager option.
+func Contents(val *contents.Manager) Option {
+	return func(o *Options) {
+		o.Contents = val
+	}
+}
+
+// KernelSpecs provides a function to set the kernel spec manager option.
+func KernelSpecs(val *kernelspec.Manager) Option {
+	return func(o *Options) {
+		o.KernelSpecs = val
+	}
+}
+
+// Cache provides a function to set the render cache option.
+func Cache(val *svc.RenderCache) Option {
+	return func(o *Options) {
+		o.Cache = val
+	}
+}
+
 // Flags provides a function to set the flags option.
 func Flags(val []cli.Flag) Option {
 	return func(o *Options) {
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+// renderNotebook serves a stored notebook as HTML page, e.g. for a read-only
+// preview within ocis-web.
+func renderNotebook(handle proto.NotebookHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := &proto.RenderRequest{
+			Path:       r.URL.Query().Get("path"),
+			ResourceId: r.URL.Query().Get("id"),
+			Theme:      r.URL.Query().Get("theme"),
+		}
+
+		if req.Path == "" && req.ResourceId == "" {
+			http.Error(w, "missing notebook path or id", http.StatusBadRequest)
+			return
+		}
+
+		rsp := &proto.RenderResponse{}
+
+		if err := handle.Render(r.Context(), req, rsp); err != nil {
+			http.Error(w, err.Error(), storageStatus(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+
+		_, _ = io.WriteString(w, rsp.Html)
+	}
+}
+
+// compareVersions serves two versions of a stored notebook side by side as
+// HTML page, e.g. for the versions panel of ocis-web.
+func compareVersions(handle proto.NotebookHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := &proto.CompareVersionsRequest{
+			Path:       r.URL.Query().Get("path"),
+			ResourceId: r.URL.Query().Get("id"),
+			From:       r.URL.Query().Get("from"),
+			To:         r.URL.Query().Get("to"),
+			Theme:      r.URL.Query().Get("theme"),
+		}
+
+		if req.Path == "" && req.ResourceId == "" {
+			http.Error(w, "missing notebook path or id", http.StatusBadRequest)
+			return
+		}
+
+		rsp := &proto.CompareVersionsResponse{}
+
+		if err := handle.CompareVersions(r.Context(), req, rsp); err != nil {
+			http.Error(w, err.Error(), storageStatus(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+
+		_, _ = io.WriteString(w, rsp.Html)
+	}
+}
+
+// storageStatus returns the HTTP status for errors of reading or writing a
+// notebook.
+func storageStatus(err error) int {
+	switch {
+	case errors.Is(err, svc.ErrNoStorage):
+		return http.StatusNotImplemented
+	case errors.Is(err, storage.ErrConflict):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, storage.ErrMissingToken):
+		return http.StatusUnauthorized
+	case errors.Is(err, storage.ErrPermissionDenied):
+		return http.StatusForbidden
+	case errors.Is(err, storage.ErrNotFound):
+		return http.StatusNotFound
+	}
+
+	return http.StatusBadRequest
+}
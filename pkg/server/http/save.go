@@ -0,0 +1,97 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	"github.com/go-chi/render"
+)
+
+// savePath is the path of the generated route of Save.
+const savePath = "/api/v0/notebooks/save"
+
+// conflict is the body of the response if a notebook changed since it was
+// read, the UI offers to reload the current version or to overwrite it.
+type conflict struct {
+	Message string  `json:"message"`
+	Current version `json:"current"`
+}
+
+// version describes the stored version of a notebook.
+type version struct {
+	ETag     string     `json:"etag"`
+	Size     uint64     `json:"size"`
+	Modified *time.Time `json:"modified,omitempty"`
+}
+
+// withSave serves the route of Save with saveNotebook. It wraps the
+// generated routes, so it does not depend on the order routes are
+// registered in.
+func withSave(handle proto.NotebookHandler) func(http.Handler) http.Handler {
+	save := saveNotebook(handle)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, savePath) {
+				save.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// saveNotebook writes a notebook back to the storage. It replaces the
+// generated handler of Save, which maps every error to 400. The ETag of the
+// version the changes are based on is either sent in the request body or in
+// the If-Match header.
+func saveNotebook(handle proto.NotebookHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := &proto.SaveRequest{}
+
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if req.Etag == "" {
+			req.Etag = r.Header.Get("If-Match")
+		}
+
+		rsp := &proto.SaveResponse{}
+
+		if err := handle.Save(r.Context(), req, rsp); err != nil {
+			var ce *storage.ConflictError
+
+			if errors.As(err, &ce) && ce.Current != nil {
+				body := conflict{
+					Message: err.Error(),
+					Current: version{
+						ETag: ce.Current.ETag,
+						Size: ce.Current.Size,
+					},
+				}
+
+				if !ce.Current.Modified.IsZero() {
+					body.Current.Modified = &ce.Current.Modified
+				}
+
+				render.Status(r, http.StatusPreconditionFailed)
+				render.JSON(w, r, body)
+				return
+			}
+
+			http.Error(w, err.Error(), storageStatus(err))
+			return
+		}
+
+		w.Header().Set("ETag", rsp.Etag)
+		render.JSON(w, r, rsp)
+	}
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+
+	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+const testNotebook = `{"cells": [], "metadata": {}, "nbformat": 4, "nbformat_minor": 4}`
+
+func TestSaveNotebook(t *testing.T) {
+	drv := storage.NewMemory()
+	ref := storage.Reference{Path: "/analysis.ipynb"}
+
+	etag, err := drv.Write(context.Background(), ref, []byte(testNotebook), "")
+	assert.Nil(t, err)
+
+	mux := chi.NewRouter()
+	notebookRoutes(mux, svc.NewService(svc.Storage(drv)))
+
+	save := func(body map[string]string, header http.Header) *httptest.ResponseRecorder {
+		b, _ := json.Marshal(body)
+		req := httptest.NewRequest(http.MethodPost, savePath, strings.NewReader(string(b)))
+
+		for key, values := range header {
+			req.Header[key] = values
+		}
+
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := save(map[string]string{"content": testNotebook, "path": ref.Path, "etag": etag}, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	current := rec.Header().Get("ETag")
+	assert.NotEmpty(t, current)
+	assert.NotEqual(t, etag, current)
+
+	// The stale ETag is sent in the body and in the If-Match header.
+	for _, rec := range []*httptest.ResponseRecorder{
+		save(map[string]string{"content": testNotebook, "path": ref.Path, "etag": etag}, nil),
+		save(map[string]string{"content": testNotebook, "path": ref.Path}, http.Header{"If-Match": {etag}}),
+	} {
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+		body := conflict{}
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.NotEmpty(t, body.Message)
+		assert.Equal(t, current, body.Current.ETag)
+		assert.NotZero(t, body.Current.Size)
+	}
+
+	rec = save(map[string]string{"content": testNotebook, "path": "/missing.ipynb", "etag": etag}, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
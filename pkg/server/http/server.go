@@ -1,11 +1,11 @@
 package http
 
 import (
-	"github.com/go-chi/chi"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/assets"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
 	svc "github.com/anaswaratrajan/ocis-jupyter/pkg/service/v0"
 	"github.com/anaswaratrajan/ocis-jupyter/pkg/version"
+	"github.com/go-chi/chi"
 	"github.com/owncloud/ocis/ocis-pkg/account"
 	"github.com/owncloud/ocis/ocis-pkg/middleware"
 	"github.com/owncloud/ocis/ocis-pkg/service/http"
@@ -25,7 +25,22 @@ func Server(opts ...Option) http.Service {
 		http.Flags(options.Flags...),
 	)
 
-	handle := svc.NewService()
+	// The APIs of Jupyter share the managers with the service.
+	if options.Contents == nil {
+		options.Contents = svc.NewContents(options.Config, options.Storage)
+	}
+
+	if options.KernelSpecs == nil {
+		options.KernelSpecs = svc.NewKernelSpecs(options.Config)
+	}
+
+	handle := svc.NewService(
+		svc.Config(options.Config),
+		svc.Storage(options.Storage),
+		svc.Cache(options.Cache),
+		svc.Contents(options.Contents),
+		svc.KernelSpecs(options.KernelSpecs),
+	)
 
 	{
 		handle = svc.NewInstrument(handle, options.Metrics)
@@ -45,6 +60,8 @@ func Server(opts ...Option) http.Service {
 		account.JWTSecret(options.Config.TokenManager.JWTSecret)),
 	)
 
+	mux.Use(accessToken)
+
 	mux.Use(middleware.Version(
 		options.Name,
 		version.String,
@@ -66,7 +83,10 @@ func Server(opts ...Option) http.Service {
 		604800))
 
 	mux.Route(options.Config.HTTP.Root, func(r chi.Router) {
-		proto.RegisterHelloWeb(r, handle)
+		notebookRoutes(r, handle)
+
+		r.Mount("/api/contents", contentsRouter(options.Contents, options.Config.HTTP.Root))
+		r.Mount("/api/kernelspecs", kernelSpecsRouter(handle, options.KernelSpecs))
 	})
 
 	service.Handle(
@@ -79,3 +99,11 @@ func Server(opts ...Option) http.Service {
 	}
 	return service
 }
+
+// notebookRoutes registers the generated routes of the notebook service and
+// the routes serving HTML pages.
+func notebookRoutes(r chi.Router, handle proto.NotebookHandler) {
+	proto.RegisterNotebookWeb(r.With(withSave(handle)), handle)
+	r.Get("/api/v0/notebooks/render", renderNotebook(handle))
+	r.Get("/api/v0/notebooks/versions/compare", compareVersions(handle))
+}
@@ -0,0 +1,23 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+// accessToken passes the reva token and the bearer token of a request on to
+// the storage, so notebooks are accessed on behalf of the user.
+func accessToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.Header.Get(storage.TokenHeader); token != "" {
+			r = r.WithContext(storage.ContextWithToken(r.Context(), token))
+		}
+
+		if authorization := r.Header.Get(storage.AuthorizationHeader); authorization != "" {
+			r = r.WithContext(storage.ContextWithAuthorization(r.Context(), authorization))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,164 @@
+package svc
+
+import (
+	"container/list"
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/events"
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+// RenderCache keeps the rendered pages of stored notebooks, so unchanged
+// notebooks are not rendered again. Pages are only used while the ETag of
+// the notebook matches, the least recently used ones are dropped if the cache
+// is full. As a handler of storage events it drops the pages of changed
+// notebooks right away, rebuilding them requires the credentials of a user.
+type RenderCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// renderEntry is a rendered page of a notebook.
+type renderEntry struct {
+	key  string
+	ref  storage.Reference
+	etag string
+
+	html    string
+	upgrade *v0proto.Upgrade
+	trusted bool
+}
+
+// NewRenderCache returns a cache for up to size pages, it returns nil if the
+// size is not positive. A nil cache keeps no pages.
+func NewRenderCache(size int) *RenderCache {
+	if size <= 0 {
+		return nil
+	}
+
+	return &RenderCache{
+		size:    size,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+// Len returns the number of cached pages.
+func (c *RenderCache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// Invalidate drops all pages of a notebook.
+func (c *RenderCache) Invalidate(ref storage.Reference) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, elem := range c.entries {
+		entry := elem.Value.(*renderEntry)
+
+		if ref.Path != "" && entry.ref.Path == ref.Path ||
+			ref.ResourceID != "" && entry.ref.ResourceID == ref.ResourceID {
+			c.remove(elem)
+		}
+	}
+}
+
+// Handle implements the events.Handler interface.
+func (c *RenderCache) Handle(ctx context.Context, e *events.Event) error {
+	for _, p := range e.Paths() {
+		c.Invalidate(storage.Reference{Path: p})
+	}
+
+	if e.ResourceID != "" {
+		c.Invalidate(storage.Reference{ResourceID: e.ResourceID})
+	}
+
+	return nil
+}
+
+// get fills the response with a cached page of the given ETag.
+func (c *RenderCache) get(ref storage.Reference, etag, theme string, trusted bool, rsp *v0proto.RenderResponse) bool {
+	if c == nil || etag == "" {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[renderKey(ref, theme, trusted)]
+
+	if !ok {
+		return false
+	}
+
+	entry := elem.Value.(*renderEntry)
+
+	if entry.etag != etag {
+		c.remove(elem)
+		return false
+	}
+
+	c.order.MoveToFront(elem)
+
+	rsp.Html = entry.html
+	rsp.Upgrade = entry.upgrade
+	rsp.Trusted = entry.trusted
+	return true
+}
+
+// put caches the page of a response.
+func (c *RenderCache) put(ref storage.Reference, etag, theme string, rsp *v0proto.RenderResponse) {
+	if c == nil || etag == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &renderEntry{
+		key:     renderKey(ref, theme, rsp.Trusted),
+		ref:     ref,
+		etag:    etag,
+		html:    rsp.Html,
+		upgrade: rsp.Upgrade,
+		trusted: rsp.Trusted,
+	}
+
+	if elem, ok := c.entries[entry.key]; ok {
+		c.remove(elem)
+	}
+
+	c.entries[entry.key] = c.order.PushFront(entry)
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove drops a page, the lock has to be held.
+func (c *RenderCache) remove(elem *list.Element) {
+	delete(c.entries, elem.Value.(*renderEntry).key)
+	c.order.Remove(elem)
+}
+
+// renderKey returns the key of a page, it differs by the theme and the
+// trust of the notebook.
+func renderKey(ref storage.Reference, theme string, trusted bool) string {
+	return ref.Path + "\x00" + ref.ResourceID + "\x00" + theme + "\x00" + strconv.FormatBool(trusted)
+}
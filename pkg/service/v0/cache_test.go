@@ -0,0 +1,57 @@
+package svc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/events"
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotebook_RenderCache(t *testing.T) {
+	drv := newMemory(t, map[string]string{
+		"/analysis.ipynb": testNotebook,
+		"/report.ipynb":   testNotebook,
+	})
+
+	cache := NewRenderCache(1)
+	s := NewService(Storage(drv), Cache(cache))
+	ctx := context.Background()
+
+	rsp := &v0proto.RenderResponse{}
+	err := s.Render(ctx, &v0proto.RenderRequest{Path: "/analysis.ipynb"}, rsp)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, cache.Len())
+
+	cached := &v0proto.RenderResponse{}
+	err = s.Render(ctx, &v0proto.RenderRequest{Path: "/analysis.ipynb"}, cached)
+	assert.Nil(t, err)
+	assert.Equal(t, rsp.Html, cached.Html)
+
+	changed := strings.Replace(testNotebook, "Some text", "Other text", 1)
+	_, err = drv.Write(ctx, storage.Reference{Path: "/analysis.ipynb"}, []byte(changed), "")
+	assert.Nil(t, err)
+
+	rsp = &v0proto.RenderResponse{}
+	err = s.Render(ctx, &v0proto.RenderRequest{Path: "/analysis.ipynb"}, rsp)
+	assert.Nil(t, err)
+	assert.Contains(t, rsp.Html, "Other text")
+
+	err = s.Render(ctx, &v0proto.RenderRequest{Path: "/report.ipynb"}, &v0proto.RenderResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, cache.Len())
+
+	err = cache.Handle(ctx, &events.Event{Type: events.Moved, Path: "/other.ipynb", OldPath: "/report.ipynb"})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, cache.Len())
+
+	err = s.Render(ctx, &v0proto.RenderRequest{Content: testNotebook}, &v0proto.RenderResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, cache.Len())
+
+	assert.Nil(t, NewRenderCache(0))
+	assert.Equal(t, 0, NewRenderCache(0).Len())
+}
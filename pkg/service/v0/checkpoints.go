@@ -0,0 +1,87 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/contents"
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+)
+
+// CreateCheckpoint implements the NotebookHandler interface.
+func (s Notebook) CreateCheckpoint(ctx context.Context, req *v0proto.CreateCheckpointRequest, rsp *v0proto.CreateCheckpointResponse) error {
+	if err := s.checkStored(req.Path); err != nil {
+		return err
+	}
+
+	checkpoint, err := s.contents.CreateCheckpoint(ctx, req.Path)
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Checkpoint = newCheckpoint(checkpoint)
+	return nil
+}
+
+// ListCheckpoints implements the NotebookHandler interface.
+func (s Notebook) ListCheckpoints(ctx context.Context, req *v0proto.ListCheckpointsRequest, rsp *v0proto.ListCheckpointsResponse) error {
+	if err := s.checkStored(req.Path); err != nil {
+		return err
+	}
+
+	checkpoints, err := s.contents.ListCheckpoints(ctx, req.Path)
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Checkpoints = make([]*v0proto.Checkpoint, 0, len(checkpoints))
+
+	for _, checkpoint := range checkpoints {
+		rsp.Checkpoints = append(rsp.Checkpoints, newCheckpoint(checkpoint))
+	}
+
+	return nil
+}
+
+// RestoreCheckpoint implements the NotebookHandler interface.
+func (s Notebook) RestoreCheckpoint(ctx context.Context, req *v0proto.RestoreCheckpointRequest, rsp *v0proto.RestoreCheckpointResponse) error {
+	if err := s.checkStored(req.Path); err != nil {
+		return err
+	}
+
+	return s.contents.RestoreCheckpoint(ctx, req.Path, req.CheckpointId)
+}
+
+// DeleteCheckpoint implements the NotebookHandler interface.
+func (s Notebook) DeleteCheckpoint(ctx context.Context, req *v0proto.DeleteCheckpointRequest, rsp *v0proto.DeleteCheckpointResponse) error {
+	if err := s.checkStored(req.Path); err != nil {
+		return err
+	}
+
+	return s.contents.DeleteCheckpoint(ctx, req.Path, req.CheckpointId)
+}
+
+// checkStored returns an error unless the path of a stored notebook is given
+// and a storage is available.
+func (s Notebook) checkStored(p string) error {
+	if p == "" {
+		return ErrMissingPath
+	}
+
+	if s.storage == nil {
+		return fmt.Errorf("%w: %s", ErrNoStorage, p)
+	}
+
+	return nil
+}
+
+// newCheckpoint converts a checkpoint for a response.
+func newCheckpoint(checkpoint *contents.Checkpoint) *v0proto.Checkpoint {
+	return &v0proto.Checkpoint{
+		Id:           checkpoint.ID,
+		LastModified: checkpoint.LastModified.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,54 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotebook_Checkpoints(t *testing.T) {
+	drv := newMemory(t, map[string]string{"/analysis.ipynb": testNotebook})
+	s := NewService(Storage(drv))
+	ctx := context.Background()
+
+	created := &v0proto.CreateCheckpointResponse{}
+	err := s.CreateCheckpoint(ctx, &v0proto.CreateCheckpointRequest{Path: "/analysis.ipynb"}, created)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", created.Checkpoint.Id)
+	assert.NotEmpty(t, created.Checkpoint.LastModified)
+
+	_, err = drv.Write(ctx, storage.Reference{Path: "/analysis.ipynb"}, []byte("changed"), "")
+	assert.Nil(t, err)
+
+	err = s.RestoreCheckpoint(ctx, &v0proto.RestoreCheckpointRequest{Path: "/analysis.ipynb", CheckpointId: "1"}, &v0proto.RestoreCheckpointResponse{})
+	assert.Nil(t, err)
+
+	b, err := drv.Read(ctx, storage.Reference{Path: "/analysis.ipynb"})
+	assert.Nil(t, err)
+	assert.Equal(t, testNotebook, string(b))
+
+	list := &v0proto.ListCheckpointsResponse{}
+	err = s.ListCheckpoints(ctx, &v0proto.ListCheckpointsRequest{Path: "/analysis.ipynb"}, list)
+	assert.Nil(t, err)
+	assert.Len(t, list.Checkpoints, 1)
+
+	err = s.DeleteCheckpoint(ctx, &v0proto.DeleteCheckpointRequest{Path: "/analysis.ipynb", CheckpointId: "1"}, &v0proto.DeleteCheckpointResponse{})
+	assert.Nil(t, err)
+
+	err = s.ListCheckpoints(ctx, &v0proto.ListCheckpointsRequest{Path: "/analysis.ipynb"}, list)
+	assert.Nil(t, err)
+	assert.Empty(t, list.Checkpoints)
+
+	err = s.DeleteCheckpoint(ctx, &v0proto.DeleteCheckpointRequest{Path: "/analysis.ipynb", CheckpointId: "1"}, &v0proto.DeleteCheckpointResponse{})
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+
+	err = s.CreateCheckpoint(ctx, &v0proto.CreateCheckpointRequest{}, created)
+	assert.Equal(t, ErrMissingPath, err)
+
+	err = NewService().CreateCheckpoint(ctx, &v0proto.CreateCheckpointRequest{Path: "/analysis.ipynb"}, created)
+	assert.True(t, errors.Is(err, ErrNoStorage))
+}
@@ -0,0 +1,143 @@
+package svc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/ansi"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+const (
+	// formatNotebook converts to a normalized .ipynb document.
+	formatNotebook = "ipynb"
+
+	// formatScript converts to a source file of the notebook language.
+	formatScript = "script"
+
+	// formatHTML converts to a self-contained HTML document.
+	formatHTML = "html"
+
+	// formatText converts cells and their outputs to plain text.
+	formatText = "text"
+)
+
+// commentPrefixes maps languages to their line comment prefix.
+var commentPrefixes = map[string]string{
+	"python":     "# ",
+	"r":          "# ",
+	"julia":      "# ",
+	"ruby":       "# ",
+	"bash":       "# ",
+	"scala":      "// ",
+	"java":       "// ",
+	"javascript": "// ",
+	"typescript": "// ",
+	"go":         "// ",
+	"c++":        "// ",
+	"sql":        "-- ",
+	"haskell":    "-- ",
+	"octave":     "% ",
+	"matlab":     "% ",
+}
+
+// exportScript joins all code cells into a source file, markdown cells are
+// kept as comments. It returns the content, mime type and file extension.
+func exportScript(nb *notebook.Notebook) (string, string, string) {
+	mimeType, extension := "text/plain", ".txt"
+
+	if info := nb.LanguageInfo(); info != nil {
+		if info.MimeType != "" {
+			mimeType = info.MimeType
+		}
+
+		if info.FileExtension != "" {
+			extension = info.FileExtension
+		}
+	}
+
+	prefix, ok := commentPrefixes[strings.ToLower(nb.Language())]
+
+	if !ok {
+		prefix = "# "
+	}
+
+	blocks := []string{}
+
+	for _, cell := range nb.Cells {
+		if cell.Source.IsEmpty() {
+			continue
+		}
+
+		switch cell.CellType {
+		case notebook.CellTypeCode:
+			blocks = append(blocks, strings.TrimRight(cell.Source.String(), "\n"))
+		case notebook.CellTypeMarkdown:
+			lines := strings.Split(strings.TrimRight(cell.Source.String(), "\n"), "\n")
+
+			for i := range lines {
+				lines[i] = strings.TrimRight(prefix+lines[i], " ")
+			}
+
+			blocks = append(blocks, strings.Join(lines, "\n"))
+		}
+	}
+
+	return strings.Join(blocks, "\n\n") + "\n", mimeType, extension
+}
+
+// exportText writes all cells with the plain text of their outputs, the way
+// they are shown in a terminal. ANSI escape sequences are removed.
+func exportText(nb *notebook.Notebook) string {
+	blocks := []string{}
+
+	for _, cell := range nb.Cells {
+		switch cell.CellType {
+		case notebook.CellTypeCode:
+			blocks = append(blocks, prompt("In ", cell.ExecutionCount)+"\n"+strings.TrimRight(cell.Source.String(), "\n"))
+
+			for _, o := range cell.Outputs {
+				if text := outputText(o); text != "" {
+					blocks = append(blocks, text)
+				}
+			}
+		default:
+			if !cell.Source.IsEmpty() {
+				blocks = append(blocks, strings.TrimRight(cell.Source.String(), "\n"))
+			}
+		}
+	}
+
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+// outputText returns the plain text of an output, empty if it has none.
+func outputText(o *notebook.Output) string {
+	var text string
+
+	switch o.OutputType {
+	case notebook.OutputTypeStream:
+		text = o.Text.String()
+	case notebook.OutputTypeError:
+		text = strings.Join(o.Traceback, "\n")
+	case notebook.OutputTypeExecuteResult, notebook.OutputTypeDisplayData:
+		text, _ = o.Data.Text("text/plain")
+	}
+
+	text = strings.TrimRight(ansi.Strip(text), "\n")
+
+	if text != "" && o.OutputType == notebook.OutputTypeExecuteResult {
+		text = prompt("Out", o.ExecutionCount) + "\n" + text
+	}
+
+	return text
+}
+
+// prompt formats an input or output prompt like the classic notebook.
+func prompt(kind string, count *int) string {
+	if count == nil {
+		return kind + "[ ]:"
+	}
+
+	return fmt.Sprintf("%s[%d]:", kind, *count)
+}
@@ -0,0 +1,188 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/diff"
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+const (
+	// diffJSON returns the changes only.
+	diffJSON = "json"
+
+	// diffPatch returns a JSON Patch along with the changes.
+	diffPatch = "patch"
+
+	// diffText returns a readable diff along with the changes.
+	diffText = "text"
+)
+
+// Diff implements the NotebookHandler interface.
+func (s Notebook) Diff(ctx context.Context, req *v0proto.DiffRequest, rsp *v0proto.DiffResponse) error {
+	switch req.Format {
+	case "", diffJSON, diffPatch, diffText:
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedDiffFormat, req.Format)
+	}
+
+	oldRef := storage.Reference{
+		Path:       req.OldPath,
+		ResourceID: req.OldResourceId,
+	}
+
+	newRef := storage.Reference{
+		Path:       req.NewPath,
+		ResourceID: req.NewResourceId,
+	}
+
+	old, err := s.fetchNotebook(ctx, "old", req.OldContent, oldRef)
+
+	if err != nil {
+		return err
+	}
+
+	new, err := s.fetchNotebook(ctx, "new", req.NewContent, newRef)
+
+	if err != nil {
+		return err
+	}
+
+	d := diff.Notebooks(old, new)
+
+	rsp.Cells = newCellDiffs(d.Cells)
+	rsp.Changed = d.Changed()
+	rsp.Metadata = newPatchOperations(d.Metadata)
+
+	switch req.Format {
+	case diffPatch:
+		ops := d.Patch()
+
+		if ops == nil {
+			ops = []*diff.Patch{}
+		}
+
+		b, err := json.Marshal(ops)
+
+		if err != nil {
+			return err
+		}
+
+		rsp.Content = string(b)
+		rsp.MimeType = "application/json-patch+json"
+	case diffText:
+		buf := &bytes.Buffer{}
+
+		if err := d.WriteText(buf, diff.TextOptions{
+			OldName: diffName(oldRef, "old"),
+			NewName: diffName(newRef, "new"),
+		}); err != nil {
+			return err
+		}
+
+		rsp.Content = buf.String()
+		rsp.MimeType = "text/plain"
+	}
+
+	return nil
+}
+
+// diffName labels a compared notebook by its path or resource id.
+func diffName(ref storage.Reference, fallback string) string {
+	switch {
+	case ref.Path != "":
+		return ref.Path
+	case ref.ResourceID != "":
+		return ref.ResourceID
+	}
+
+	return fallback
+}
+
+// newCellDiffs converts the changes of cells for a response.
+func newCellDiffs(cells []*diff.Cell) []*v0proto.CellDiff {
+	c := make([]*v0proto.CellDiff, 0, len(cells))
+
+	for _, cell := range cells {
+		c = append(c, newCellDiff(cell))
+	}
+
+	return c
+}
+
+// newCellDiff converts the change of a cell for a response.
+func newCellDiff(cell *diff.Cell) *v0proto.CellDiff {
+	c := &v0proto.CellDiff{
+		Op:                 string(cell.Op),
+		OldIndex:           int32(cell.OldIndex),
+		NewIndex:           int32(cell.NewIndex),
+		Id:                 cell.ID,
+		CellType:           string(cell.CellType),
+		Source:             newLineDiffs(cell.Source),
+		MetadataChanged:    len(cell.Metadata) > 0,
+		Metadata:           newPatchOperations(cell.Metadata),
+		AttachmentsChanged: cell.AttachmentsChanged,
+	}
+
+	for _, o := range cell.Outputs {
+		c.OutputsChanged = c.OutputsChanged || o.Op != diff.OpUnchanged
+		c.Outputs = append(c.Outputs, newOutputDiff(o))
+	}
+
+	return c
+}
+
+// newOutputDiff converts the change of an output for a response.
+func newOutputDiff(o *diff.Output) *v0proto.OutputDiff {
+	d := &v0proto.OutputDiff{
+		Op:         string(o.Op),
+		OldIndex:   int32(o.OldIndex),
+		NewIndex:   int32(o.NewIndex),
+		OutputType: string(o.OutputType),
+		Text:       newLineDiffs(o.Text),
+		Metadata:   newPatchOperations(o.Metadata),
+	}
+
+	for _, data := range o.Data {
+		d.Data = append(d.Data, &v0proto.MimeDiff{
+			Op:       string(data.Op),
+			MimeType: data.MimeType,
+			Lines:    newLineDiffs(data.Lines),
+		})
+	}
+
+	return d
+}
+
+// newLineDiffs converts the changes of lines for a response.
+func newLineDiffs(lines []diff.Line) []*v0proto.LineDiff {
+	l := make([]*v0proto.LineDiff, 0, len(lines))
+
+	for _, line := range lines {
+		l = append(l, &v0proto.LineDiff{
+			Op:   string(line.Op),
+			Text: line.Text,
+		})
+	}
+
+	return l
+}
+
+// newPatchOperations converts JSON Patch operations for a response.
+func newPatchOperations(ops []*diff.Patch) []*v0proto.PatchOperation {
+	p := make([]*v0proto.PatchOperation, 0, len(ops))
+
+	for _, op := range ops {
+		p = append(p, &v0proto.PatchOperation{
+			Op:    op.Op,
+			Path:  op.Path,
+			Value: string(op.Value),
+		})
+	}
+
+	return p
+}
@@ -0,0 +1,54 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotebook_Diff(t *testing.T) {
+	drv := newMemory(t, map[string]string{"/analysis.ipynb": testNotebook})
+	s := NewService(Storage(drv))
+	ctx := context.Background()
+
+	changed := strings.Replace(testNotebook, `["print(1)"]`, `["print(2)"]`, 1)
+
+	rsp := &v0proto.DiffResponse{}
+	err := s.Diff(ctx, &v0proto.DiffRequest{OldPath: "/analysis.ipynb", NewContent: changed}, rsp)
+	assert.Nil(t, err)
+	assert.True(t, rsp.Changed)
+	assert.Empty(t, rsp.Content)
+
+	if assert.Len(t, rsp.Cells, 2) {
+		assert.Equal(t, "modified", rsp.Cells[1].Op)
+	}
+
+	rsp = &v0proto.DiffResponse{}
+	err = s.Diff(ctx, &v0proto.DiffRequest{OldPath: "/analysis.ipynb", NewContent: changed, Format: "patch"}, rsp)
+	assert.Nil(t, err)
+	assert.Equal(t, "application/json-patch+json", rsp.MimeType)
+	assert.Contains(t, rsp.Content, `"op":"replace"`)
+
+	rsp = &v0proto.DiffResponse{}
+	err = s.Diff(ctx, &v0proto.DiffRequest{OldPath: "/analysis.ipynb", NewContent: changed, Format: "text"}, rsp)
+	assert.Nil(t, err)
+	assert.Equal(t, "text/plain", rsp.MimeType)
+	assert.True(t, strings.HasPrefix(rsp.Content, "--- /analysis.ipynb\n+++ new\n"))
+	assert.Contains(t, rsp.Content, "  - print(1)\n  + print(2)\n")
+
+	rsp = &v0proto.DiffResponse{}
+	err = s.Diff(ctx, &v0proto.DiffRequest{OldContent: testNotebook, NewPath: "/analysis.ipynb", Format: "patch"}, rsp)
+	assert.Nil(t, err)
+	assert.False(t, rsp.Changed)
+	assert.Equal(t, "[]", rsp.Content)
+
+	err = s.Diff(ctx, &v0proto.DiffRequest{OldContent: testNotebook, NewContent: changed, Format: "html"}, &v0proto.DiffResponse{})
+	assert.True(t, errors.Is(err, ErrUnsupportedDiffFormat))
+
+	err = s.Diff(ctx, &v0proto.DiffRequest{OldContent: testNotebook}, &v0proto.DiffResponse{})
+	assert.True(t, errors.Is(err, ErrMissingContent))
+}
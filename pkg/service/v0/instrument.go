@@ -9,7 +9,7 @@ import (
 )
 
 // NewInstrument returns a service that instruments metrics.
-func NewInstrument(next v0proto.HelloHandler, metrics *metrics.Metrics) v0proto.HelloHandler {
+func NewInstrument(next v0proto.NotebookHandler, metrics *metrics.Metrics) v0proto.NotebookHandler {
 	return instrument{
 		next:    next,
 		metrics: metrics,
@@ -17,25 +17,144 @@ func NewInstrument(next v0proto.HelloHandler, metrics *metrics.Metrics) v0proto.
 }
 
 type instrument struct {
-	next    v0proto.HelloHandler
+	next    v0proto.NotebookHandler
 	metrics *metrics.Metrics
 }
 
-// Greet implements the HelloHandler interface.
-func (i instrument) Greet(ctx context.Context, req *v0proto.GreetRequest, rsp *v0proto.GreetResponse) error {
+// Render implements the NotebookHandler interface.
+func (i instrument) Render(ctx context.Context, req *v0proto.RenderRequest, rsp *v0proto.RenderResponse) error {
+	return i.observe("Render", func() error {
+		return i.next.Render(ctx, req, rsp)
+	})
+}
+
+// Validate implements the NotebookHandler interface.
+func (i instrument) Validate(ctx context.Context, req *v0proto.ValidateRequest, rsp *v0proto.ValidateResponse) error {
+	return i.observe("Validate", func() error {
+		return i.next.Validate(ctx, req, rsp)
+	})
+}
+
+// GetInfo implements the NotebookHandler interface.
+func (i instrument) GetInfo(ctx context.Context, req *v0proto.GetInfoRequest, rsp *v0proto.GetInfoResponse) error {
+	return i.observe("GetInfo", func() error {
+		return i.next.GetInfo(ctx, req, rsp)
+	})
+}
+
+// Convert implements the NotebookHandler interface.
+func (i instrument) Convert(ctx context.Context, req *v0proto.ConvertRequest, rsp *v0proto.ConvertResponse) error {
+	return i.observe("Convert", func() error {
+		return i.next.Convert(ctx, req, rsp)
+	})
+}
+
+// Trust implements the NotebookHandler interface.
+func (i instrument) Trust(ctx context.Context, req *v0proto.TrustRequest, rsp *v0proto.TrustResponse) error {
+	return i.observe("Trust", func() error {
+		return i.next.Trust(ctx, req, rsp)
+	})
+}
+
+// Save implements the NotebookHandler interface.
+func (i instrument) Save(ctx context.Context, req *v0proto.SaveRequest, rsp *v0proto.SaveResponse) error {
+	return i.observe("Save", func() error {
+		return i.next.Save(ctx, req, rsp)
+	})
+}
+
+// CreateCheckpoint implements the NotebookHandler interface.
+func (i instrument) CreateCheckpoint(ctx context.Context, req *v0proto.CreateCheckpointRequest, rsp *v0proto.CreateCheckpointResponse) error {
+	return i.observe("CreateCheckpoint", func() error {
+		return i.next.CreateCheckpoint(ctx, req, rsp)
+	})
+}
+
+// ListCheckpoints implements the NotebookHandler interface.
+func (i instrument) ListCheckpoints(ctx context.Context, req *v0proto.ListCheckpointsRequest, rsp *v0proto.ListCheckpointsResponse) error {
+	return i.observe("ListCheckpoints", func() error {
+		return i.next.ListCheckpoints(ctx, req, rsp)
+	})
+}
+
+// RestoreCheckpoint implements the NotebookHandler interface.
+func (i instrument) RestoreCheckpoint(ctx context.Context, req *v0proto.RestoreCheckpointRequest, rsp *v0proto.RestoreCheckpointResponse) error {
+	return i.observe("RestoreCheckpoint", func() error {
+		return i.next.RestoreCheckpoint(ctx, req, rsp)
+	})
+}
+
+// DeleteCheckpoint implements the NotebookHandler interface.
+func (i instrument) DeleteCheckpoint(ctx context.Context, req *v0proto.DeleteCheckpointRequest, rsp *v0proto.DeleteCheckpointResponse) error {
+	return i.observe("DeleteCheckpoint", func() error {
+		return i.next.DeleteCheckpoint(ctx, req, rsp)
+	})
+}
+
+// ListVersions implements the NotebookHandler interface.
+func (i instrument) ListVersions(ctx context.Context, req *v0proto.ListVersionsRequest, rsp *v0proto.ListVersionsResponse) error {
+	return i.observe("ListVersions", func() error {
+		return i.next.ListVersions(ctx, req, rsp)
+	})
+}
+
+// GetVersion implements the NotebookHandler interface.
+func (i instrument) GetVersion(ctx context.Context, req *v0proto.GetVersionRequest, rsp *v0proto.GetVersionResponse) error {
+	return i.observe("GetVersion", func() error {
+		return i.next.GetVersion(ctx, req, rsp)
+	})
+}
+
+// DiffVersions implements the NotebookHandler interface.
+func (i instrument) DiffVersions(ctx context.Context, req *v0proto.DiffVersionsRequest, rsp *v0proto.DiffVersionsResponse) error {
+	return i.observe("DiffVersions", func() error {
+		return i.next.DiffVersions(ctx, req, rsp)
+	})
+}
+
+// CompareVersions implements the NotebookHandler interface.
+func (i instrument) CompareVersions(ctx context.Context, req *v0proto.CompareVersionsRequest, rsp *v0proto.CompareVersionsResponse) error {
+	return i.observe("CompareVersions", func() error {
+		return i.next.CompareVersions(ctx, req, rsp)
+	})
+}
+
+// Diff implements the NotebookHandler interface.
+func (i instrument) Diff(ctx context.Context, req *v0proto.DiffRequest, rsp *v0proto.DiffResponse) error {
+	return i.observe("Diff", func() error {
+		return i.next.Diff(ctx, req, rsp)
+	})
+}
+
+// Merge implements the NotebookHandler interface.
+func (i instrument) Merge(ctx context.Context, req *v0proto.MergeRequest, rsp *v0proto.MergeResponse) error {
+	return i.observe("Merge", func() error {
+		return i.next.Merge(ctx, req, rsp)
+	})
+}
+
+// ListKernelSpecs implements the NotebookHandler interface.
+func (i instrument) ListKernelSpecs(ctx context.Context, req *v0proto.ListKernelSpecsRequest, rsp *v0proto.ListKernelSpecsResponse) error {
+	return i.observe("ListKernelSpecs", func() error {
+		return i.next.ListKernelSpecs(ctx, req, rsp)
+	})
+}
+
+// observe records latency, duration and successful calls of a method.
+func (i instrument) observe(method string, call func() error) error {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(func(v float64) {
 		us := v * 1000000
 
-		i.metrics.Latency.WithLabelValues().Observe(us)
-		i.metrics.Duration.WithLabelValues().Observe(v)
+		i.metrics.Latency.WithLabelValues(method).Observe(us)
+		i.metrics.Duration.WithLabelValues(method).Observe(v)
 	}))
 
 	defer timer.ObserveDuration()
 
-	err := i.next.Greet(ctx, req, rsp)
+	err := call()
 
 	if err == nil {
-		i.metrics.Counter.WithLabelValues().Inc()
+		i.metrics.Counter.WithLabelValues(method).Inc()
 	}
 
 	return err
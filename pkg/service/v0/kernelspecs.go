@@ -0,0 +1,64 @@
+package svc
+
+import (
+	"context"
+	"path"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+)
+
+// ListKernelSpecs implements the NotebookHandler interface.
+func (s Notebook) ListKernelSpecs(ctx context.Context, req *v0proto.ListKernelSpecsRequest, rsp *v0proto.ListKernelSpecsResponse) error {
+	rsp.Kernelspecs = s.allowedKernels(ctx)
+
+	for _, spec := range rsp.Kernelspecs {
+		if spec.Name == s.defaultKernel {
+			rsp.Default = spec.Name
+		}
+	}
+
+	if rsp.Default == "" && len(rsp.Kernelspecs) > 0 {
+		rsp.Default = rsp.Kernelspecs[0].Name
+	}
+
+	return nil
+}
+
+// allowedKernels returns the installed kernels which are allowed in the
+// settings.
+func (s Notebook) allowedKernels(ctx context.Context) []*v0proto.KernelSpec {
+	allowed := getAllowedKernels(ctx, s.values, s.systemAccount)
+	specs := []*v0proto.KernelSpec{}
+
+	for _, spec := range s.kernels.List() {
+		if !allowed[spec.Name] {
+			continue
+		}
+
+		specs = append(specs, newKernelSpec(spec, s.root))
+	}
+
+	return specs
+}
+
+// newKernelSpec returns the message of a kernel, resources are referenced
+// by their URL below the kernelspecs API of the HTTP server.
+func newKernelSpec(spec *kernelspec.KernelSpec, root string) *v0proto.KernelSpec {
+	res := &v0proto.KernelSpec{
+		Name:          spec.Name,
+		DisplayName:   spec.DisplayName,
+		Language:      spec.Language,
+		Argv:          spec.Argv,
+		InterruptMode: spec.InterruptMode,
+		Env:           spec.Env,
+		Metadata:      string(spec.Metadata),
+		Resources:     make(map[string]string, len(spec.Resources)),
+	}
+
+	for name, file := range spec.Resources {
+		res.Resources[name] = path.Join("/", root, "api", "kernelspecs", spec.Name, file)
+	}
+
+	return res
+}
@@ -0,0 +1,207 @@
+package svc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	mclient "github.com/micro/go-micro/v2/client"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis/ocis-pkg/log"
+	"github.com/owncloud/ocis/ocis-pkg/middleware"
+	settings "github.com/owncloud/ocis/settings/pkg/proto/v0"
+	ssvc "github.com/owncloud/ocis/settings/pkg/service/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeValues is a settings value service which only knows the system wide
+// value of the allowed kernels setting.
+type fakeValues struct {
+	settings.ValueService
+
+	account string
+	allowed []string
+	err     error
+}
+
+func (f fakeValues) GetValueByUniqueIdentifiers(ctx context.Context, req *settings.GetValueByUniqueIdentifiersRequest, opts ...mclient.CallOption) (*settings.GetValueResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	account := f.account
+	if account == "" {
+		account = bundleIDKernels
+	}
+
+	if req.AccountUuid != account || req.SettingId != settingIDAllowedKernels {
+		return nil, merrors.NotFound("com.owncloud.api.settings", "no value")
+	}
+
+	values := []*settings.ListOptionValue{}
+	for _, name := range f.allowed {
+		values = append(values, &settings.ListOptionValue{
+			Option: &settings.ListOptionValue_StringValue{StringValue: name},
+		})
+	}
+
+	return &settings.GetValueResponse{
+		Value: &settings.ValueWithIdentifier{
+			Value: &settings.Value{
+				AccountUuid: req.AccountUuid,
+				SettingId:   settingIDAllowedKernels,
+				Value: &settings.Value_ListValue{
+					ListValue: &settings.ListValue{Values: values},
+				},
+			},
+		},
+	}, nil
+}
+
+// userContext returns the context of an HTTP request by a user with the
+// given role.
+func userContext(uuid, role string) context.Context {
+	return context.WithValue(grpcContext(uuid, role), middleware.UUIDKey, uuid)
+}
+
+// grpcContext returns the context of a gRPC request by a user with the given
+// role, the account is only known from the metadata.
+func grpcContext(uuid, role string) context.Context {
+	ctx := metadata.Set(context.Background(), middleware.AccountID, uuid)
+	return metadata.Set(ctx, middleware.RoleIDs, `["`+role+`"]`)
+}
+
+// newKernels installs kernels with the given kernel.json files in a
+// temporary kernels directory.
+func newKernels(t *testing.T, specs map[string]string) string {
+	dir, err := ioutil.TempDir("", "kernels")
+	assert.Nil(t, err)
+
+	for name, spec := range specs {
+		assert.Nil(t, os.MkdirAll(filepath.Join(dir, name), 0700))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, name, kernelspec.File), []byte(spec), 0600))
+	}
+
+	return dir
+}
+
+func TestNotebook_ListKernelSpecs(t *testing.T) {
+	dir := newKernels(t, map[string]string{
+		"ir":      `{"argv": ["R", "{connection_file}"], "display_name": "R", "language": "R"}`,
+		"python3": `{"argv": ["python3", "-f", "{connection_file}"], "display_name": "Python 3", "language": "python", "metadata": {"debugger": true}}`,
+	})
+	defer os.RemoveAll(dir)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "python3", "logo-64x64.png"), []byte("png"), 0600))
+
+	cfg := config.New()
+	cfg.HTTP.Root = "/jupyter"
+	cfg.Kernels.Default = "python3"
+
+	s := NewService(Config(cfg), Values(fakeValues{allowed: []string{"ir", "python3"}})).(Notebook)
+	s.kernels = kernelspec.New(kernelspec.DataPaths(), kernelspec.Paths(dir))
+	ctx := userContext("4c510ada-c86b-4815-8820-42cdf82c3d51", ssvc.BundleUUIDRoleUser)
+
+	rsp := &v0proto.ListKernelSpecsResponse{}
+	err := s.ListKernelSpecs(ctx, &v0proto.ListKernelSpecsRequest{}, rsp)
+	assert.Nil(t, err)
+	assert.Equal(t, "python3", rsp.Default)
+
+	if assert.Len(t, rsp.Kernelspecs, 2) {
+		python := rsp.Kernelspecs[1]
+
+		assert.Equal(t, "ir", rsp.Kernelspecs[0].Name)
+		assert.Equal(t, "Python 3", python.DisplayName)
+		assert.Equal(t, []string{"python3", "-f", "{connection_file}"}, python.Argv)
+		assert.JSONEq(t, `{"debugger": true}`, python.Metadata)
+		assert.Equal(t, map[string]string{"logo-64x64": "/jupyter/api/kernelspecs/python3/logo-64x64.png"}, python.Resources)
+	}
+
+	// The first kernel is the default if the configured one is missing.
+	s.defaultKernel = "julia-1.5"
+
+	rsp = &v0proto.ListKernelSpecsResponse{}
+	err = s.ListKernelSpecs(ctx, &v0proto.ListKernelSpecsRequest{}, rsp)
+	assert.Nil(t, err)
+	assert.Equal(t, "ir", rsp.Default)
+}
+
+func TestNotebook_ListKernelSpecs_Allowed(t *testing.T) {
+	dir := newKernels(t, map[string]string{
+		"ir":      `{"argv": ["R", "{connection_file}"], "display_name": "R", "language": "R"}`,
+		"python3": `{"argv": ["python3", "-f", "{connection_file}"], "display_name": "Python 3", "language": "python"}`,
+	})
+	defer os.RemoveAll(dir)
+
+	cfg := config.New()
+	cfg.Kernels.Default = "ir"
+
+	// The administrators only allowed python3 in the system wide value, it
+	// applies to every other account.
+	s := NewService(Config(cfg), Values(fakeValues{allowed: []string{"python3"}})).(Notebook)
+	s.kernels = kernelspec.New(kernelspec.DataPaths(), kernelspec.Paths(dir))
+
+	for _, role := range []string{ssvc.BundleUUIDRoleUser, ssvc.BundleUUIDRoleGuest} {
+		rsp := &v0proto.ListKernelSpecsResponse{}
+		err := s.ListKernelSpecs(userContext("4c510ada-c86b-4815-8820-42cdf82c3d51", role), &v0proto.ListKernelSpecsRequest{}, rsp)
+		assert.Nil(t, err)
+		assert.Equal(t, "python3", rsp.Default)
+
+		if assert.Len(t, rsp.Kernelspecs, 1) {
+			assert.Equal(t, "python3", rsp.Kernelspecs[0].Name)
+		}
+	}
+
+	// Failed lookups are not allowed any kernel.
+	s.values = fakeValues{err: merrors.InternalServerError("com.owncloud.api.settings", "unavailable")}
+
+	rsp := &v0proto.ListKernelSpecsResponse{}
+	err := s.ListKernelSpecs(userContext("4c510ada-c86b-4815-8820-42cdf82c3d51", ssvc.BundleUUIDRoleAdmin), &v0proto.ListKernelSpecsRequest{}, rsp)
+	assert.Nil(t, err)
+	assert.Empty(t, rsp.Kernelspecs)
+	assert.Empty(t, rsp.Default)
+}
+
+func TestNotebook_ListKernelSpecs_GRPC(t *testing.T) {
+	dir := newKernels(t, map[string]string{
+		"ir":      `{"argv": ["R", "{connection_file}"], "display_name": "R", "language": "R"}`,
+		"python3": `{"argv": ["python3", "-f", "{connection_file}"], "display_name": "Python 3", "language": "python"}`,
+	})
+	defer os.RemoveAll(dir)
+
+	cfg := config.New()
+	cfg.Kernels.Paths = []string{dir}
+	cfg.Kernels.SystemAccount = "system"
+
+	// The handler as the gRPC server registers it, the requests carry no
+	// account in their context.
+	handler := NewLogging(
+		NewService(
+			Config(cfg),
+			KernelSpecs(kernelspec.New(kernelspec.DataPaths(), kernelspec.Paths(dir))),
+			Values(fakeValues{account: "system", allowed: []string{"ir"}}),
+		),
+		log.NewLogger(log.Level("error")),
+	)
+
+	for _, ctx := range []context.Context{
+		grpcContext("4c510ada-c86b-4815-8820-42cdf82c3d51", ssvc.BundleUUIDRoleAdmin),
+		grpcContext("4c510ada-c86b-4815-8820-42cdf82c3d51", ssvc.BundleUUIDRoleUser),
+		context.Background(),
+	} {
+		rsp := &v0proto.ListKernelSpecsResponse{}
+		err := handler.ListKernelSpecs(ctx, &v0proto.ListKernelSpecsRequest{}, rsp)
+		assert.Nil(t, err)
+		assert.Equal(t, "ir", rsp.Default)
+
+		if assert.Len(t, rsp.Kernelspecs, 1) {
+			assert.Equal(t, "ir", rsp.Kernelspecs[0].Name)
+		}
+	}
+}
@@ -9,7 +9,7 @@ import (
 )
 
 // NewLogging returns a service that logs messages.
-func NewLogging(next v0proto.HelloHandler, logger log.Logger) v0proto.HelloHandler {
+func NewLogging(next v0proto.NotebookHandler, logger log.Logger) v0proto.NotebookHandler {
 	return logging{
 		next:   next,
 		logger: logger,
@@ -17,17 +17,167 @@ func NewLogging(next v0proto.HelloHandler, logger log.Logger) v0proto.HelloHandl
 }
 
 type logging struct {
-	next   v0proto.HelloHandler
+	next   v0proto.NotebookHandler
 	logger log.Logger
 }
 
-// Greet implements the HelloHandler interface.
-func (l logging) Greet(ctx context.Context, req *v0proto.GreetRequest, rsp *v0proto.GreetResponse) error {
+// Render implements the NotebookHandler interface.
+func (l logging) Render(ctx context.Context, req *v0proto.RenderRequest, rsp *v0proto.RenderResponse) error {
 	start := time.Now()
-	err := l.next.Greet(ctx, req, rsp)
+	err := l.next.Render(ctx, req, rsp)
 
+	l.log("Notebook.Render", start, err)
+	return err
+}
+
+// Validate implements the NotebookHandler interface.
+func (l logging) Validate(ctx context.Context, req *v0proto.ValidateRequest, rsp *v0proto.ValidateResponse) error {
+	start := time.Now()
+	err := l.next.Validate(ctx, req, rsp)
+
+	l.log("Notebook.Validate", start, err)
+	return err
+}
+
+// GetInfo implements the NotebookHandler interface.
+func (l logging) GetInfo(ctx context.Context, req *v0proto.GetInfoRequest, rsp *v0proto.GetInfoResponse) error {
+	start := time.Now()
+	err := l.next.GetInfo(ctx, req, rsp)
+
+	l.log("Notebook.GetInfo", start, err)
+	return err
+}
+
+// Convert implements the NotebookHandler interface.
+func (l logging) Convert(ctx context.Context, req *v0proto.ConvertRequest, rsp *v0proto.ConvertResponse) error {
+	start := time.Now()
+	err := l.next.Convert(ctx, req, rsp)
+
+	l.log("Notebook.Convert", start, err)
+	return err
+}
+
+// Trust implements the NotebookHandler interface.
+func (l logging) Trust(ctx context.Context, req *v0proto.TrustRequest, rsp *v0proto.TrustResponse) error {
+	start := time.Now()
+	err := l.next.Trust(ctx, req, rsp)
+
+	l.log("Notebook.Trust", start, err)
+	return err
+}
+
+// Save implements the NotebookHandler interface.
+func (l logging) Save(ctx context.Context, req *v0proto.SaveRequest, rsp *v0proto.SaveResponse) error {
+	start := time.Now()
+	err := l.next.Save(ctx, req, rsp)
+
+	l.log("Notebook.Save", start, err)
+	return err
+}
+
+// CreateCheckpoint implements the NotebookHandler interface.
+func (l logging) CreateCheckpoint(ctx context.Context, req *v0proto.CreateCheckpointRequest, rsp *v0proto.CreateCheckpointResponse) error {
+	start := time.Now()
+	err := l.next.CreateCheckpoint(ctx, req, rsp)
+
+	l.log("Notebook.CreateCheckpoint", start, err)
+	return err
+}
+
+// ListCheckpoints implements the NotebookHandler interface.
+func (l logging) ListCheckpoints(ctx context.Context, req *v0proto.ListCheckpointsRequest, rsp *v0proto.ListCheckpointsResponse) error {
+	start := time.Now()
+	err := l.next.ListCheckpoints(ctx, req, rsp)
+
+	l.log("Notebook.ListCheckpoints", start, err)
+	return err
+}
+
+// RestoreCheckpoint implements the NotebookHandler interface.
+func (l logging) RestoreCheckpoint(ctx context.Context, req *v0proto.RestoreCheckpointRequest, rsp *v0proto.RestoreCheckpointResponse) error {
+	start := time.Now()
+	err := l.next.RestoreCheckpoint(ctx, req, rsp)
+
+	l.log("Notebook.RestoreCheckpoint", start, err)
+	return err
+}
+
+// DeleteCheckpoint implements the NotebookHandler interface.
+func (l logging) DeleteCheckpoint(ctx context.Context, req *v0proto.DeleteCheckpointRequest, rsp *v0proto.DeleteCheckpointResponse) error {
+	start := time.Now()
+	err := l.next.DeleteCheckpoint(ctx, req, rsp)
+
+	l.log("Notebook.DeleteCheckpoint", start, err)
+	return err
+}
+
+// ListVersions implements the NotebookHandler interface.
+func (l logging) ListVersions(ctx context.Context, req *v0proto.ListVersionsRequest, rsp *v0proto.ListVersionsResponse) error {
+	start := time.Now()
+	err := l.next.ListVersions(ctx, req, rsp)
+
+	l.log("Notebook.ListVersions", start, err)
+	return err
+}
+
+// GetVersion implements the NotebookHandler interface.
+func (l logging) GetVersion(ctx context.Context, req *v0proto.GetVersionRequest, rsp *v0proto.GetVersionResponse) error {
+	start := time.Now()
+	err := l.next.GetVersion(ctx, req, rsp)
+
+	l.log("Notebook.GetVersion", start, err)
+	return err
+}
+
+// DiffVersions implements the NotebookHandler interface.
+func (l logging) DiffVersions(ctx context.Context, req *v0proto.DiffVersionsRequest, rsp *v0proto.DiffVersionsResponse) error {
+	start := time.Now()
+	err := l.next.DiffVersions(ctx, req, rsp)
+
+	l.log("Notebook.DiffVersions", start, err)
+	return err
+}
+
+// CompareVersions implements the NotebookHandler interface.
+func (l logging) CompareVersions(ctx context.Context, req *v0proto.CompareVersionsRequest, rsp *v0proto.CompareVersionsResponse) error {
+	start := time.Now()
+	err := l.next.CompareVersions(ctx, req, rsp)
+
+	l.log("Notebook.CompareVersions", start, err)
+	return err
+}
+
+// Diff implements the NotebookHandler interface.
+func (l logging) Diff(ctx context.Context, req *v0proto.DiffRequest, rsp *v0proto.DiffResponse) error {
+	start := time.Now()
+	err := l.next.Diff(ctx, req, rsp)
+
+	l.log("Notebook.Diff", start, err)
+	return err
+}
+
+// Merge implements the NotebookHandler interface.
+func (l logging) Merge(ctx context.Context, req *v0proto.MergeRequest, rsp *v0proto.MergeResponse) error {
+	start := time.Now()
+	err := l.next.Merge(ctx, req, rsp)
+
+	l.log("Notebook.Merge", start, err)
+	return err
+}
+
+// ListKernelSpecs implements the NotebookHandler interface.
+func (l logging) ListKernelSpecs(ctx context.Context, req *v0proto.ListKernelSpecsRequest, rsp *v0proto.ListKernelSpecsResponse) error {
+	start := time.Now()
+	err := l.next.ListKernelSpecs(ctx, req, rsp)
+
+	l.log("Notebook.ListKernelSpecs", start, err)
+	return err
+}
+
+// log writes the outcome of a method call.
+func (l logging) log(method string, start time.Time, err error) {
 	logger := l.logger.With().
-		Str("method", "Hello.Greet").
+		Str("method", method).
 		Dur("duration", time.Since(start)).
 		Logger()
 
@@ -39,6 +189,4 @@ func (l logging) Greet(ctx context.Context, req *v0proto.GreetRequest, rsp *v0pr
 		logger.Debug().
 			Msg("")
 	}
-
-	return err
 }
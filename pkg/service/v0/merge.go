@@ -0,0 +1,62 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/merge"
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+// Merge implements the NotebookHandler interface.
+func (s Notebook) Merge(ctx context.Context, req *v0proto.MergeRequest, rsp *v0proto.MergeResponse) error {
+	base, err := s.fetchNotebook(ctx, "base", req.BaseContent, storage.Reference{
+		Path:       req.BasePath,
+		ResourceID: req.BaseResourceId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	local, err := s.fetchNotebook(ctx, "local", req.LocalContent, storage.Reference{
+		Path:       req.LocalPath,
+		ResourceID: req.LocalResourceId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	remote, err := s.fetchNotebook(ctx, "remote", req.RemoteContent, storage.Reference{
+		Path:       req.RemotePath,
+		ResourceID: req.RemoteResourceId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	res := merge.Notebooks(base, local, remote, merge.ConflictCells(req.ConflictCells))
+
+	b, err := res.Notebook.Marshal()
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Content = string(b)
+	rsp.Conflicted = len(res.Conflicts) > 0
+	rsp.Conflicts = make([]*v0proto.MergeConflict, 0, len(res.Conflicts))
+
+	for _, c := range res.Conflicts {
+		rsp.Conflicts = append(rsp.Conflicts, &v0proto.MergeConflict{
+			Path:   c.Path,
+			Base:   string(c.Base),
+			Local:  string(c.Local),
+			Remote: string(c.Remote),
+		})
+	}
+
+	return nil
+}
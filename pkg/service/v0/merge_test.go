@@ -0,0 +1,51 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotebook_Merge(t *testing.T) {
+	drv := newMemory(t, map[string]string{"/analysis.ipynb": testNotebook})
+	s := NewService(Storage(drv))
+	ctx := context.Background()
+
+	local := strings.Replace(testNotebook, `["print(1)"]`, `["print(2)"]`, 1)
+	remote := strings.Replace(testNotebook, `["print(1)"]`, `["print(3)"]`, 1)
+
+	rsp := &v0proto.MergeResponse{}
+	err := s.Merge(ctx, &v0proto.MergeRequest{BasePath: "/analysis.ipynb", LocalContent: local, RemoteContent: testNotebook}, rsp)
+	assert.Nil(t, err)
+	assert.False(t, rsp.Conflicted)
+	assert.Empty(t, rsp.Conflicts)
+	assert.Contains(t, rsp.Content, `"print(2)"`)
+
+	rsp = &v0proto.MergeResponse{}
+	err = s.Merge(ctx, &v0proto.MergeRequest{BasePath: "/analysis.ipynb", LocalContent: local, RemoteContent: remote}, rsp)
+	assert.Nil(t, err)
+	assert.True(t, rsp.Conflicted)
+
+	if assert.Len(t, rsp.Conflicts, 1) {
+		assert.Equal(t, &v0proto.MergeConflict{
+			Path:   "/cells/1/source",
+			Base:   `["print(1)"]`,
+			Local:  `["print(2)"]`,
+			Remote: `["print(3)"]`,
+		}, rsp.Conflicts[0])
+	}
+
+	rsp = &v0proto.MergeResponse{}
+	err = s.Merge(ctx, &v0proto.MergeRequest{BasePath: "/analysis.ipynb", LocalContent: local, RemoteContent: remote, ConflictCells: true}, rsp)
+	assert.Nil(t, err)
+	assert.Contains(t, rsp.Content, `"print(2)"`)
+	assert.Contains(t, rsp.Content, `"print(3)"`)
+	assert.Contains(t, rsp.Content, `"merge_conflict"`)
+
+	err = s.Merge(ctx, &v0proto.MergeRequest{BasePath: "/analysis.ipynb", LocalContent: local}, &v0proto.MergeResponse{})
+	assert.True(t, errors.Is(err, ErrMissingContent))
+}
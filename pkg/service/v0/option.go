@@ -0,0 +1,116 @@
+package svc
+
+import (
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/contents"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	mclient "github.com/micro/go-micro/v2/client"
+	settings "github.com/owncloud/ocis/settings/pkg/proto/v0"
+)
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	Config  *config.Config
+	Storage storage.Driver
+	Cache   *RenderCache
+
+	Contents    *contents.Manager
+	KernelSpecs *kernelspec.Manager
+	Values      settings.ValueService
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		Config: config.New(),
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	if opt.Contents == nil {
+		opt.Contents = NewContents(opt.Config, opt.Storage)
+	}
+
+	if opt.KernelSpecs == nil {
+		opt.KernelSpecs = NewKernelSpecs(opt.Config)
+	}
+
+	if opt.Values == nil {
+		opt.Values = settings.NewValueService("com.owncloud.api.settings", mclient.DefaultClient)
+	}
+
+	return opt
+}
+
+// NewContents returns the contents manager of the storage with the
+// checkpoint settings of the config.
+func NewContents(cfg *config.Config, drv storage.Driver) *contents.Manager {
+	return contents.New(
+		drv,
+		contents.CheckpointFolder(cfg.Checkpoints.Folder),
+		contents.CheckpointRetention(cfg.Checkpoints.Retention),
+	)
+}
+
+// NewKernelSpecs returns the kernel spec manager searching the kernel paths
+// of the config.
+func NewKernelSpecs(cfg *config.Config) *kernelspec.Manager {
+	return kernelspec.New(
+		kernelspec.Paths(cfg.Kernels.Paths...),
+	)
+}
+
+// Config provides a function to set the config option.
+func Config(val *config.Config) Option {
+	return func(o *Options) {
+		o.Config = val
+	}
+}
+
+// Storage provides a function to set the storage option. Without it stored
+// notebooks are not available.
+func Storage(val storage.Driver) Option {
+	return func(o *Options) {
+		o.Storage = val
+	}
+}
+
+// Cache provides a function to set the cache option, it can be shared by
+// several services. Without it stored notebooks are rendered on every
+// request.
+func Cache(val *RenderCache) Option {
+	return func(o *Options) {
+		o.Cache = val
+	}
+}
+
+// Contents provides a function to set the contents option, it can be shared
+// with the Contents API. Without it a manager of the storage is created.
+func Contents(val *contents.Manager) Option {
+	return func(o *Options) {
+		o.Contents = val
+	}
+}
+
+// KernelSpecs provides a function to set the kernel specs option, it can be
+// shared with the kernelspecs API. Without it the configured kernel paths
+// are searched.
+func KernelSpecs(val *kernelspec.Manager) Option {
+	return func(o *Options) {
+		o.KernelSpecs = val
+	}
+}
+
+// Values provides a function to set the settings values option, the allowed
+// kernels are read from it. Without it the settings service is queried.
+func Values(val settings.ValueService) Option {
+	return func(o *Options) {
+		o.Values = val
+	}
+}
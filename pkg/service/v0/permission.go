@@ -0,0 +1,26 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/owncloud/ocis/ocis-pkg/roles"
+	ssvc "github.com/owncloud/ocis/settings/pkg/service/v0"
+)
+
+// isAdmin returns true if the account of the request has the admin role.
+// The role ids are added to the request metadata by the account middleware.
+func isAdmin(ctx context.Context) bool {
+	roleIDs, ok := roles.ReadRoleIDsFromContext(ctx)
+
+	if !ok {
+		return false
+	}
+
+	for _, id := range roleIDs {
+		if id == ssvc.BundleUUIDRoleAdmin {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,11 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strconv"
-	"strings"
 
-	mclient "github.com/micro/go-micro/v2/client"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/contents"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/highlight"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/kernelspec"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
 	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/render"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/trust"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/validate"
+	mclient "github.com/micro/go-micro/v2/client"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/metadata"
 	olog "github.com/owncloud/ocis/ocis-pkg/log"
 	"github.com/owncloud/ocis/ocis-pkg/middleware"
 	settings "github.com/owncloud/ocis/settings/pkg/proto/v0"
@@ -16,45 +28,419 @@ import (
 )
 
 var (
-	// ErrMissingName defines the error if name is missing.
-	ErrMissingName = errors.New("missing a name")
+	// ErrMissingContent defines the error if the notebook content is missing.
+	ErrMissingContent = errors.New("missing notebook content")
+
+	// ErrUnsupportedFormat defines the error if a conversion target is unknown.
+	ErrUnsupportedFormat = errors.New("unsupported conversion format")
+
+	// ErrNoStorage defines the error if a notebook is requested by path but no
+	// storage is available.
+	ErrNoStorage = errors.New("no notebook storage configured")
+
+	// ErrPermissionDenied defines the error if the account lacks the required role.
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrLegacyTrust defines the error if a notebook of an older nbformat gets
+	// trusted, its upgrade is not stable enough to keep the signature valid.
+	ErrLegacyTrust = errors.New("notebooks have to be saved in nbformat v4 to be trusted")
+
+	// ErrUnknownTheme defines the error if a highlighting theme does not exist.
+	ErrUnknownTheme = errors.New("unknown highlighting theme")
+
+	// ErrNotStored defines the error if a notebook is saved without a path or
+	// resource id to save it to.
+	ErrNotStored = errors.New("only stored notebooks can be saved")
+
+	// ErrMissingPath defines the error if the path of a stored notebook is
+	// missing.
+	ErrMissingPath = errors.New("missing notebook path")
+
+	// ErrMissingReference defines the error if neither the path nor the
+	// resource id of a stored notebook is given.
+	ErrMissingReference = errors.New("missing notebook path or resource id")
 
-	bundleIDGreeting       = "21fb587b-7b69-4aa6-b0a7-93c74af1918f"
-	settingIDGreeterPhrase = "b3584ea8-caec-4951-a2c1-92cbc70071b7"
+	// ErrUnsupportedDiffFormat defines the error if the format of a diff is
+	// unknown.
+	ErrUnsupportedDiffFormat = errors.New("unsupported diff format")
+
+	bundleIDNotebook        = "21fb587b-7b69-4aa6-b0a7-93c74af1918f"
+	settingIDHighlightTheme = "2787d4ee-b302-41c4-ad02-86a267c69254"
+	bundleIDKernels         = "563ca841-8713-4704-9342-2d4c52b753d6"
+	settingIDAllowedKernels = "6610ebb2-8508-44c3-9917-6f3940bfbc15"
 
 	// maxRetries indicates how many times to try a request for network reasons.
 	maxRetries = 5
 )
 
-// NewService returns a service implementation for HelloHandler.
-func NewService() v0proto.HelloHandler {
-	return Hello{}
+// NewService returns a service implementation for NotebookHandler.
+func NewService(opts ...Option) v0proto.NotebookHandler {
+	options := newOptions(opts...)
+
+	return Notebook{
+		renderer: render.New(
+			render.Priority(options.Config.Render.MimePriority),
+			render.Disabled(options.Config.Render.DisabledMimeTypes),
+			render.Theme(options.Config.Render.Theme),
+			render.Sanitize(render.Policy{
+				Disabled:    options.Config.Render.DisableSanitizer,
+				URLSchemes:  options.Config.Render.URLSchemes,
+				AllowStyles: options.Config.Render.AllowStyles,
+			}),
+		),
+		signer: trust.NewSigner(
+			options.Config.TokenManager.TrustSecret,
+			trust.NewStore(options.Config.Trust.Database),
+		),
+		storage:       options.Storage,
+		contents:      options.Contents,
+		cache:         options.Cache,
+		kernels:       options.KernelSpecs,
+		values:        options.Values,
+		systemAccount: SystemAccount(options.Config),
+		defaultKernel: options.Config.Kernels.Default,
+		root:          options.Config.HTTP.Root,
+	}
+}
+
+// Notebook implements the business logic for NotebookHandler.
+type Notebook struct {
+	renderer *render.Renderer
+	signer   *trust.Signer
+	storage  storage.Driver
+	contents *contents.Manager
+	cache    *RenderCache
+	kernels  *kernelspec.Manager
+	values   settings.ValueService
+
+	// defaultKernel is the kernel of new notebooks if it is allowed.
+	defaultKernel string
+
+	// systemAccount is the account the allowed kernels are stored for.
+	systemAccount string
+
+	// root is the root of the HTTP server which serves kernel resources.
+	root string
+}
+
+// Render implements the NotebookHandler interface.
+func (s Notebook) Render(ctx context.Context, req *v0proto.RenderRequest, rsp *v0proto.RenderResponse) error {
+	theme, err := themeFor(ctx, req.Theme)
+
+	if err != nil {
+		return err
+	}
+
+	doc, err := s.fetch(ctx, req.Content, storage.Reference{
+		Path:       req.Path,
+		ResourceID: req.ResourceId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	nb, upgrade, err := load(doc.content)
+
+	if err != nil {
+		return err
+	}
+
+	renderer, trusted, err := s.rendererFor(nb, upgrade)
+
+	if err != nil {
+		return err
+	}
+
+	if s.cache.get(doc.ref, doc.etag, theme, trusted, rsp) {
+		return nil
+	}
+
+	if theme != "" {
+		renderer = renderer.WithTheme(theme)
+	}
+
+	html, err := renderer.String(nb, doc.title)
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Html = html
+	rsp.Upgrade = upgrade
+	rsp.Trusted = trusted
+
+	s.cache.put(doc.ref, doc.etag, theme, rsp)
+	return nil
+}
+
+// Validate implements the NotebookHandler interface.
+func (s Notebook) Validate(ctx context.Context, req *v0proto.ValidateRequest, rsp *v0proto.ValidateResponse) error {
+	if req.Content == "" {
+		return ErrMissingContent
+	}
+
+	content := []byte(req.Content)
+
+	// Legacy notebooks are checked in the form they get upgraded to.
+	if major, _, err := notebook.Version(content); err == nil && major < notebook.Major {
+		nb, upgrade, err := load(req.Content)
+
+		if err != nil {
+			rsp.Errors = append(rsp.Errors, &v0proto.ValidationError{
+				Message: err.Error(),
+			})
+
+			return nil
+		}
+
+		if content, err = nb.Marshal(); err != nil {
+			return err
+		}
+
+		rsp.Upgrade = upgrade
+	}
+
+	result, err := validate.Validate(content)
+
+	if err != nil {
+		rsp.Errors = append(rsp.Errors, &v0proto.ValidationError{
+			Message: err.Error(),
+		})
+
+		return nil
+	}
+
+	for _, e := range result.Errors {
+		rsp.Errors = append(rsp.Errors, &v0proto.ValidationError{
+			Message:       e.Message,
+			Pointer:       e.Pointer,
+			SchemaPointer: e.SchemaPointer,
+		})
+	}
+
+	rsp.Schema = result.Schema
+	rsp.Valid = result.Valid()
+	return nil
+}
+
+// GetInfo implements the NotebookHandler interface.
+func (s Notebook) GetInfo(ctx context.Context, req *v0proto.GetInfoRequest, rsp *v0proto.GetInfoResponse) error {
+	nb, upgrade, err := load(req.Content)
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Upgrade = upgrade
+	rsp.Nbformat = int32(nb.NBFormat)
+	rsp.NbformatMinor = int32(nb.NBFormatMinor)
+	rsp.Language = nb.Language()
+
+	if spec := nb.KernelSpec(); spec != nil {
+		rsp.KernelName = spec.Name
+		rsp.KernelDisplayName = spec.DisplayName
+	}
+
+	mimeTypes := map[string]bool{}
+
+	for _, cell := range nb.Cells {
+		rsp.Cells++
+
+		switch cell.CellType {
+		case notebook.CellTypeCode:
+			rsp.CodeCells++
+		case notebook.CellTypeMarkdown:
+			rsp.MarkdownCells++
+		case notebook.CellTypeRaw:
+			rsp.RawCells++
+		}
+
+		for _, output := range cell.Outputs {
+			rsp.Outputs++
+
+			for _, mime := range output.Data.Types() {
+				mimeTypes[mime] = true
+			}
+		}
+	}
+
+	for mime := range mimeTypes {
+		rsp.MimeTypes = append(rsp.MimeTypes, mime)
+	}
+
+	sort.Strings(rsp.MimeTypes)
+	return nil
+}
+
+// Convert implements the NotebookHandler interface.
+func (s Notebook) Convert(ctx context.Context, req *v0proto.ConvertRequest, rsp *v0proto.ConvertResponse) error {
+	doc, err := s.fetch(ctx, req.Content, storage.Reference{
+		Path:       req.Path,
+		ResourceID: req.ResourceId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	nb, upgrade, err := load(doc.content)
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Upgrade = upgrade
+	rsp.Etag = doc.etag
+
+	if req.Save && req.To != "" && req.To != formatNotebook {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, req.To)
+	}
+
+	switch req.To {
+	case "", formatNotebook:
+		b, err := nb.Marshal()
+
+		if err != nil {
+			return err
+		}
+
+		if req.Save {
+			if rsp.Etag, err = s.store(ctx, doc.ref, b, doc.etag); err != nil {
+				return err
+			}
+		}
+
+		rsp.Content = string(b)
+		rsp.MimeType = "application/x-ipynb+json"
+		rsp.FileExtension = ".ipynb"
+	case formatScript:
+		rsp.Content, rsp.MimeType, rsp.FileExtension = exportScript(nb)
+	case formatHTML:
+		renderer, _, err := s.rendererFor(nb, upgrade)
+
+		if err != nil {
+			return err
+		}
+
+		if theme := getHighlightTheme(ctx); theme != "" {
+			renderer = renderer.WithTheme(theme)
+		}
+
+		html, err := renderer.String(nb, doc.title)
+
+		if err != nil {
+			return err
+		}
+
+		rsp.Content = html
+		rsp.MimeType = "text/html"
+		rsp.FileExtension = ".html"
+	case formatText:
+		rsp.Content = exportText(nb)
+		rsp.MimeType = "text/plain"
+		rsp.FileExtension = ".txt"
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, req.To)
+	}
+
+	return nil
 }
 
-// Hello defines implements the business logic for HelloHandler.
-type Hello struct {
-	// Add database handlers here.
+// Trust implements the NotebookHandler interface.
+func (s Notebook) Trust(ctx context.Context, req *v0proto.TrustRequest, rsp *v0proto.TrustResponse) error {
+	if !isAdmin(ctx) {
+		return ErrPermissionDenied
+	}
+
+	nb, upgrade, err := load(req.Content)
+
+	if err != nil {
+		return err
+	}
+
+	if upgrade != nil {
+		return ErrLegacyTrust
+	}
+
+	signature, trusted, err := s.signer.Sign(nb)
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Algorithm = trust.Algorithm
+	rsp.Signature = signature
+	rsp.AlreadyTrusted = trusted
+	return nil
 }
 
-// Greet implements the HelloHandler interface.
-func (s Hello) Greet(ctx context.Context, req *v0proto.GreetRequest, rsp *v0proto.GreetResponse) error {
-	if req.Name == "" {
-		return ErrMissingName
+// Save implements the NotebookHandler interface.
+func (s Notebook) Save(ctx context.Context, req *v0proto.SaveRequest, rsp *v0proto.SaveResponse) error {
+	nb, upgrade, err := load(req.Content)
+
+	if err != nil {
+		return err
+	}
+
+	b, err := nb.Marshal()
+
+	if err != nil {
+		return err
 	}
 
-	phrase := getGreetingPhrase(ctx)
-	rsp.Message = fmt.Sprintf(phrase, req.Name)
+	etag, err := s.store(ctx, storage.Reference{
+		Path:       req.Path,
+		ResourceID: req.ResourceId,
+	}, b, req.Etag)
 
+	if err != nil {
+		return err
+	}
+
+	rsp.Etag = etag
+	rsp.Upgrade = upgrade
 	return nil
 }
 
-func getGreetingPhrase(ctx context.Context) string {
+// rendererFor returns the renderer matching the trust of the notebook.
+// Upgraded notebooks differ from the signed content, they are never trusted.
+func (s Notebook) rendererFor(nb *notebook.Notebook, upgrade *v0proto.Upgrade) (*render.Renderer, bool, error) {
+	if upgrade != nil {
+		return s.renderer, false, nil
+	}
+
+	trusted, err := s.signer.Check(nb)
+
+	if err != nil || !trusted {
+		return s.renderer, false, err
+	}
+
+	return s.renderer.Trusted(), true, nil
+}
+
+// themeFor returns the highlighting theme of a request, the theme in the
+// settings of the user applies if none is requested. It is empty if neither
+// is set.
+func themeFor(ctx context.Context, requested string) (string, error) {
+	if requested == "" {
+		return getHighlightTheme(ctx), nil
+	}
+
+	if !highlight.HasTheme(requested) {
+		return "", fmt.Errorf("%w: %s", ErrUnknownTheme, requested)
+	}
+
+	return requested, nil
+}
+
+func getHighlightTheme(ctx context.Context) string {
 	ownAccountUUID := ctx.Value(middleware.UUIDKey)
 	if ownAccountUUID != nil {
 		// request to the settings service requires to have the account uuid of the authenticated user available in the context
 		rq := settings.GetValueByUniqueIdentifiersRequest{
 			AccountUuid: ownAccountUUID.(string),
-			SettingId:   settingIDGreeterPhrase,
+			SettingId:   settingIDHighlightTheme,
 		}
 
 		// TODO this won't work with a registry other than mdns. Look into Micro's client initialization.
@@ -62,47 +448,129 @@ func getGreetingPhrase(ctx context.Context) string {
 		valueService := settings.NewValueService("com.owncloud.api.settings", mclient.DefaultClient)
 		response, err := valueService.GetValueByUniqueIdentifiers(ctx, &rq)
 		if err == nil {
-			value, ok := response.Value.Value.Value.(*settings.Value_StringValue)
-			if ok {
-				trimmedPhrase := strings.Trim(
-					value.StringValue,
-					" \t",
-				)
-				if trimmedPhrase != "" {
-					return trimmedPhrase + " %s"
+			value, ok := response.Value.Value.Value.(*settings.Value_ListValue)
+			if ok && len(value.ListValue.Values) > 0 {
+				theme := value.ListValue.Values[0].GetStringValue()
+				if highlight.HasTheme(theme) {
+					return theme
 				}
 			}
 		}
 	}
-	return "Hello %s"
+	return ""
+}
+
+// getAllowedKernels returns the kernels the administrators allowed in the
+// system wide value of the allowed kernels setting. It does not depend on
+// the account of the request, failed lookups are not allowed any kernel.
+func getAllowedKernels(ctx context.Context, values settings.ValueService, account string) map[string]bool {
+	allowed := map[string]bool{}
+
+	rq := settings.GetValueByUniqueIdentifiersRequest{
+		AccountUuid: account,
+		SettingId:   settingIDAllowedKernels,
+	}
+
+	response, err := values.GetValueByUniqueIdentifiers(ctx, &rq)
+	if err != nil {
+		return allowed
+	}
+
+	for _, v := range response.GetValue().GetValue().GetListValue().GetValues() {
+		allowed[v.GetStringValue()] = true
+	}
+	return allowed
+}
+
+// SystemAccount returns the account the system wide value of the allowed
+// kernels setting is stored for. Unless it is configured the id of the
+// kernels bundle is used, so every instance of the service agrees on it
+// without a real account.
+func SystemAccount(cfg *config.Config) string {
+	if cfg.Kernels.SystemAccount != "" {
+		return cfg.Kernels.SystemAccount
+	}
+
+	return bundleIDKernels
+}
+
+// load decodes the notebook content of a request. Notebooks of an older
+// nbformat are upgraded to v4 and the upgrade is described by the returned
+// message, which is nil otherwise.
+func load(content string) (*notebook.Notebook, *v0proto.Upgrade, error) {
+	if content == "" {
+		return nil, nil, ErrMissingContent
+	}
+
+	nb, report, err := notebook.Load([]byte(content))
+
+	if err != nil || report == nil {
+		return nb, nil, err
+	}
+
+	return nb, &v0proto.Upgrade{
+		Upgraded:          true,
+		FromNbformat:      int32(report.FromMajor),
+		FromNbformatMinor: int32(report.FromMinor),
+		LossyChanges:      report.Lossy,
+	}, nil
 }
 
 // RegisterSettingsBundles pushes the settings bundle definitions for this extension to the ocis-settings service.
-func RegisterSettingsBundles(l *olog.Logger) {
-	request := &settings.SaveBundleRequest{
-		Bundle: &settings.Bundle{
-			Id:          bundleIDGreeting,
-			Name:        "greeting",
-			DisplayName: "Greeting",
-			Extension:   "ocis-jupyter",
-			Type:        settings.Bundle_TYPE_DEFAULT,
-			Resource: &settings.Resource{
-				Type: settings.Resource_TYPE_SYSTEM,
-			},
-			Settings: []*settings.Setting{
-				{
-					Id:          settingIDGreeterPhrase,
-					Name:        "phrase",
-					DisplayName: "Phrase",
-					Description: "Phrase for replies on the greet request",
-					Resource: &settings.Resource{
-						Type: settings.Resource_TYPE_SYSTEM,
+// The given kernels are the choices of the allowed kernels setting.
+func RegisterSettingsBundles(l *olog.Logger, cfg *config.Config, kernels []*kernelspec.KernelSpec) {
+	requests := []*settings.SaveBundleRequest{
+		{
+			Bundle: &settings.Bundle{
+				Id:          bundleIDNotebook,
+				Name:        "notebook",
+				DisplayName: "Notebook",
+				Extension:   "ocis-jupyter",
+				Type:        settings.Bundle_TYPE_DEFAULT,
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_SYSTEM,
+				},
+				Settings: []*settings.Setting{
+					{
+						Id:          settingIDHighlightTheme,
+						Name:        "highlight-theme",
+						DisplayName: "Highlighting theme",
+						Description: "Theme for the syntax highlighting of code cells",
+						Resource: &settings.Resource{
+							Type: settings.Resource_TYPE_USER,
+						},
+						Value: &settings.Setting_SingleChoiceValue{
+							SingleChoiceValue: &settings.SingleChoiceList{
+								Options: themeOptions(),
+							},
+						},
 					},
-					Value: &settings.Setting_StringValue{
-						StringValue: &settings.String{
-							Required:  true,
-							Default:   "Hello",
-							MaxLength: 15,
+				},
+			},
+		},
+		{
+			Bundle: &settings.Bundle{
+				Id:          bundleIDKernels,
+				Name:        "kernels",
+				DisplayName: "Kernels",
+				Extension:   "ocis-jupyter",
+				Type:        settings.Bundle_TYPE_DEFAULT,
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_SYSTEM,
+				},
+				Settings: []*settings.Setting{
+					{
+						Id:          settingIDAllowedKernels,
+						Name:        "allowed-kernels",
+						DisplayName: "Allowed kernels",
+						Description: "Kernels users can run notebooks with",
+						Resource: &settings.Resource{
+							Type: settings.Resource_TYPE_SYSTEM,
+						},
+						Value: &settings.Setting_MultiChoiceValue{
+							MultiChoiceValue: &settings.MultiChoiceList{
+								Options: kernelOptions(kernels),
+							},
 						},
 					},
 				},
@@ -113,35 +581,14 @@ func RegisterSettingsBundles(l *olog.Logger) {
 	// TODO this won't work with a registry other than mdns. Look into Micro's client initialization.
 	// https://github.com/owncloud/ocis-proxy/issues/38
 	bundleService := settings.NewBundleService("com.owncloud.api.settings", mclient.DefaultClient)
-	response, err := bundleService.SaveBundle(context.Background(), request)
-	if err != nil {
-		l.Warn().Msg("error registering settings bundle at first try. retrying")
-		for i := 1; i <= maxRetries; i++ {
-			if _, err := bundleService.SaveBundle(context.Background(), request); err != nil {
-				l.Warn().
-					Str("bundle_name", request.Bundle.Name).
-					Str("attempt", fmt.Sprintf("%v/%v", strconv.Itoa(i), strconv.Itoa(maxRetries))).
-					Msgf("error creating bundle")
-				continue
-			} else {
-				l.Info().
-					Str("bundle_name", request.Bundle.Name).
-					Str("after", fmt.Sprintf("%v retries", strconv.Itoa(i))).
-					Str("bundleName", request.Bundle.Name).
-					Str("bundleId", request.Bundle.Id).
-					Msg("default settings bundle registered")
-				goto OUT
-			}
-		}
-		l.Err(err).Str("setting_name", request.Bundle.Name).Msg("bundle could not be registered. max number of retries reached")
-	} else {
-		l.Info().
-			Str("bundleName", response.Bundle.Name).
-			Str("bundleId", response.Bundle.Id).
-			Msg("default settings bundle registered")
+
+	for _, request := range requests {
+		saveBundle(bundleService, request, l)
 	}
 
-OUT:
+	valueService := settings.NewValueService("com.owncloud.api.settings", mclient.DefaultClient)
+	saveAllowedKernels(valueService, SystemAccount(cfg), kernels, l)
+
 	permissionRequests := []*settings.AddSettingToBundleRequest{
 		{
 			BundleId: ssvc.BundleUUIDRoleAdmin,
@@ -149,9 +596,26 @@ OUT:
 				Id: "d5f42c4b-e1b6-4b59-8eca-fc4b9e9f2320",
 				Resource: &settings.Resource{
 					Type: settings.Resource_TYPE_SETTING,
-					Id:   settingIDGreeterPhrase,
+					Id:   settingIDHighlightTheme,
+				},
+				Name: "highlight-theme-admin-readwrite",
+				Value: &settings.Setting_PermissionValue{
+					PermissionValue: &settings.Permission{
+						Operation:  settings.Permission_OPERATION_READWRITE,
+						Constraint: settings.Permission_CONSTRAINT_OWN,
+					},
+				},
+			},
+		},
+		{
+			BundleId: ssvc.BundleUUIDRoleUser,
+			Setting: &settings.Setting{
+				Id: "69a03184-dd07-4eee-b2da-dda802a10396",
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_SETTING,
+					Id:   settingIDHighlightTheme,
 				},
-				Name: "phrase-admin-read",
+				Name: "highlight-theme-user-readwrite",
 				Value: &settings.Setting_PermissionValue{
 					PermissionValue: &settings.Permission{
 						Operation:  settings.Permission_OPERATION_READWRITE,
@@ -160,6 +624,74 @@ OUT:
 				},
 			},
 		},
+		{
+			BundleId: ssvc.BundleUUIDRoleGuest,
+			Setting: &settings.Setting{
+				Id: "3bde047a-2f01-4918-9931-75024a902029",
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_SETTING,
+					Id:   settingIDHighlightTheme,
+				},
+				Name: "highlight-theme-guest-readwrite",
+				Value: &settings.Setting_PermissionValue{
+					PermissionValue: &settings.Permission{
+						Operation:  settings.Permission_OPERATION_READWRITE,
+						Constraint: settings.Permission_CONSTRAINT_OWN,
+					},
+				},
+			},
+		},
+		{
+			BundleId: ssvc.BundleUUIDRoleAdmin,
+			Setting: &settings.Setting{
+				Id: "b7abcb41-5502-44e1-b2af-ea7e04b4425d",
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_SETTING,
+					Id:   settingIDAllowedKernels,
+				},
+				Name: "allowed-kernels-admin-readwrite",
+				Value: &settings.Setting_PermissionValue{
+					PermissionValue: &settings.Permission{
+						Operation:  settings.Permission_OPERATION_READWRITE,
+						Constraint: settings.Permission_CONSTRAINT_ALL,
+					},
+				},
+			},
+		},
+		{
+			BundleId: ssvc.BundleUUIDRoleUser,
+			Setting: &settings.Setting{
+				Id: "a874205a-7c1c-40bf-a9b0-78dc000108d6",
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_SETTING,
+					Id:   settingIDAllowedKernels,
+				},
+				Name: "allowed-kernels-user-read",
+				Value: &settings.Setting_PermissionValue{
+					PermissionValue: &settings.Permission{
+						Operation:  settings.Permission_OPERATION_READ,
+						Constraint: settings.Permission_CONSTRAINT_ALL,
+					},
+				},
+			},
+		},
+		{
+			BundleId: ssvc.BundleUUIDRoleGuest,
+			Setting: &settings.Setting{
+				Id: "0b4ef357-2ed1-446a-ba74-3bf1f5fae963",
+				Resource: &settings.Resource{
+					Type: settings.Resource_TYPE_SETTING,
+					Id:   settingIDAllowedKernels,
+				},
+				Name: "allowed-kernels-guest-read",
+				Value: &settings.Setting_PermissionValue{
+					PermissionValue: &settings.Permission{
+						Operation:  settings.Permission_OPERATION_READ,
+						Constraint: settings.Permission_CONSTRAINT_ALL,
+					},
+				},
+			},
+		},
 	}
 
 	for i := range permissionRequests {
@@ -172,6 +704,135 @@ OUT:
 	}
 }
 
+// saveBundle saves a settings bundle, the request is retried for network reasons.
+func saveBundle(bundleService settings.BundleService, request *settings.SaveBundleRequest, l *olog.Logger) {
+	response, err := bundleService.SaveBundle(context.Background(), request)
+	if err == nil {
+		l.Info().
+			Str("bundleName", response.Bundle.Name).
+			Str("bundleId", response.Bundle.Id).
+			Msg("default settings bundle registered")
+		return
+	}
+
+	l.Warn().Str("bundle_name", request.Bundle.Name).Msg("error registering settings bundle at first try. retrying")
+	for i := 1; i <= maxRetries; i++ {
+		if _, err := bundleService.SaveBundle(context.Background(), request); err != nil {
+			l.Warn().
+				Str("bundle_name", request.Bundle.Name).
+				Str("attempt", fmt.Sprintf("%v/%v", strconv.Itoa(i), strconv.Itoa(maxRetries))).
+				Msgf("error creating bundle")
+			continue
+		}
+
+		l.Info().
+			Str("bundle_name", request.Bundle.Name).
+			Str("after", fmt.Sprintf("%v retries", strconv.Itoa(i))).
+			Str("bundleName", request.Bundle.Name).
+			Str("bundleId", request.Bundle.Id).
+			Msg("default settings bundle registered")
+		return
+	}
+	l.Err(err).Str("setting_name", request.Bundle.Name).Msg("bundle could not be registered. max number of retries reached")
+}
+
+// saveAllowedKernels allows the given kernels in the system wide value of the
+// allowed kernels setting unless it exists already, the request is retried for
+// network reasons. The value is written on behalf of the system account with
+// the admin role, which may write the values of all accounts.
+func saveAllowedKernels(valueService settings.ValueService, account string, kernels []*kernelspec.KernelSpec, l *olog.Logger) {
+	values := make([]*settings.ListOptionValue, 0, len(kernels))
+	for _, spec := range kernels {
+		values = append(values, &settings.ListOptionValue{
+			Option: &settings.ListOptionValue_StringValue{
+				StringValue: spec.Name,
+			},
+		})
+	}
+
+	ctx := metadata.Set(context.Background(), middleware.AccountID, account)
+	ctx = metadata.Set(ctx, middleware.RoleIDs, `["`+ssvc.BundleUUIDRoleAdmin+`"]`)
+
+	getRequest := &settings.GetValueByUniqueIdentifiersRequest{
+		AccountUuid: account,
+		SettingId:   settingIDAllowedKernels,
+	}
+	saveRequest := &settings.SaveValueRequest{
+		Value: &settings.Value{
+			BundleId:    bundleIDKernels,
+			SettingId:   settingIDAllowedKernels,
+			AccountUuid: account,
+			Resource: &settings.Resource{
+				Type: settings.Resource_TYPE_SYSTEM,
+			},
+			Value: &settings.Value_ListValue{
+				ListValue: &settings.ListValue{
+					Values: values,
+				},
+			},
+		},
+	}
+
+	for i := 1; i <= maxRetries; i++ {
+		_, err := valueService.GetValueByUniqueIdentifiers(ctx, getRequest)
+		if err == nil {
+			return
+		}
+
+		if merrors.Parse(err.Error()).Code == http.StatusNotFound {
+			if _, err = valueService.SaveValue(ctx, saveRequest); err == nil {
+				l.Info().Int("kernels", len(values)).Msg("default allowed kernels saved")
+				return
+			}
+		}
+
+		l.Warn().
+			Err(err).
+			Str("attempt", fmt.Sprintf("%v/%v", strconv.Itoa(i), strconv.Itoa(maxRetries))).
+			Msg("error saving the default allowed kernels")
+	}
+	l.Error().Msg("default allowed kernels could not be saved. max number of retries reached")
+}
+
+// themeOptions returns the choices of the highlighting theme setting.
+func themeOptions() []*settings.ListOption {
+	options := make([]*settings.ListOption, 0, len(highlight.Themes))
+
+	for _, theme := range highlight.Themes {
+		options = append(options, &settings.ListOption{
+			Value: &settings.ListOptionValue{
+				Option: &settings.ListOptionValue_StringValue{
+					StringValue: theme.Name,
+				},
+			},
+			DisplayValue: theme.DisplayName,
+			Default:      theme.Name == highlight.DefaultTheme,
+		})
+	}
+
+	return options
+}
+
+// kernelOptions returns the choices of the allowed kernels setting, every
+// kernel is allowed by default.
+func kernelOptions(kernels []*kernelspec.KernelSpec) []*settings.ListOption {
+	options := make([]*settings.ListOption, 0, len(kernels))
+
+	for _, spec := range kernels {
+		options = append(options, &settings.ListOption{
+			Value: &settings.ListOptionValue{
+				Option: &settings.ListOptionValue_StringValue{
+					StringValue: spec.Name,
+				},
+			},
+			DisplayValue: spec.DisplayName,
+			Default:      true,
+		})
+	}
+
+	return options
+}
+
 // proposal: the retry logic should live in the settings service.
 func retryPermissionRequests(ctx context.Context, bs settings.BundleService, setting *settings.AddSettingToBundleRequest, maxRetries int, l *olog.Logger) {
 	for i := 1; i < maxRetries; i++ {
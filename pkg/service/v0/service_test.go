@@ -2,37 +2,399 @@ package svc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
 
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis/ocis-pkg/middleware"
+	ssvc "github.com/owncloud/ocis/settings/pkg/service/v0"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/webdav"
 
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
 	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/trust"
 )
 
-func TestHello_Greet(t *testing.T) {
+const testNotebook = `{
+ "cells": [
+  {"cell_type": "markdown", "metadata": {}, "source": ["# Title\n", "Some text"]},
+  {"cell_type": "code", "execution_count": 1, "metadata": {}, "source": ["print(1)"],
+   "outputs": [
+    {"output_type": "stream", "name": "stdout", "text": ["1\n"]},
+    {"output_type": "execute_result", "execution_count": 1, "metadata": {},
+     "data": {"text/plain": ["2"], "text/html": ["<b>2</b>"]}}
+   ]}
+ ],
+ "metadata": {
+  "kernelspec": {"name": "python3", "display_name": "Python 3", "language": "python"},
+  "language_info": {"name": "python", "mimetype": "text/x-python", "file_extension": ".py"}
+ },
+ "nbformat": 4,
+ "nbformat_minor": 4
+}`
+
+func TestNotebook_GetInfo(t *testing.T) {
+	s := NewService()
+	rsp := &v0proto.GetInfoResponse{}
+
+	err := s.GetInfo(context.Background(), &v0proto.GetInfoRequest{Content: testNotebook}, rsp)
+	assert.Nil(t, err)
+
+	assert.Equal(t, int32(4), rsp.Nbformat)
+	assert.Equal(t, int32(4), rsp.NbformatMinor)
+	assert.Equal(t, "python", rsp.Language)
+	assert.Equal(t, "python3", rsp.KernelName)
+	assert.Equal(t, "Python 3", rsp.KernelDisplayName)
+	assert.Equal(t, int32(2), rsp.Cells)
+	assert.Equal(t, int32(1), rsp.CodeCells)
+	assert.Equal(t, int32(1), rsp.MarkdownCells)
+	assert.Equal(t, int32(2), rsp.Outputs)
+	assert.Equal(t, []string{"text/html", "text/plain"}, rsp.MimeTypes)
+}
+
+func TestNotebook_GetInfoUpgrade(t *testing.T) {
+	s := NewService()
+	rsp := &v0proto.GetInfoResponse{}
+
+	legacy := `{"metadata": {"name": "old"}, "nbformat": 3, "nbformat_minor": 0, "worksheets": [{"cells": [
+		{"cell_type": "heading", "level": 1, "metadata": {}, "source": "Title"},
+		{"cell_type": "code", "input": "1 + 1", "language": "python", "metadata": {}, "outputs": [], "prompt_number": 1}
+	]}]}`
+
+	err := s.GetInfo(context.Background(), &v0proto.GetInfoRequest{Content: legacy}, rsp)
+	assert.Nil(t, err)
+
+	assert.Equal(t, int32(4), rsp.Nbformat)
+	assert.Equal(t, int32(1), rsp.CodeCells)
+	assert.Equal(t, int32(1), rsp.MarkdownCells)
+	assert.True(t, rsp.Upgrade.Upgraded)
+	assert.Equal(t, int32(3), rsp.Upgrade.FromNbformat)
+	assert.Equal(t, []string{`/metadata/name: notebook name "old" dropped`}, rsp.Upgrade.LossyChanges)
+}
+
+func TestNotebook_Convert(t *testing.T) {
 	tests := []struct {
-		name                 string
-		req                  string
-		expectedErrorMessage interface{}
+		name                  string
+		to                    string
+		expectedContent       string
+		expectedFileExtension string
+		expectedErrorMessage  interface{}
 	}{
-		{"simple", "simple", nil},
-		{"UTF", "मिलन", nil},
-		{"special char", `%&# /\`, nil},
-		{"empty", "", "missing a name"},
+		{"script", "script", "# # Title\n# Some text\n\nprint(1)\n", ".py", nil},
+		{"html", "html", "<!DOCTYPE html>", ".html", nil},
+		{"text", "text", "# Title\nSome text\n\nIn [1]:\nprint(1)\n\n1\n\nOut[1]:\n2\n", ".txt", nil},
+		{"unknown", "pdf", "", "", "unsupported conversion format: pdf"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := Hello{}
-			req := &v0proto.GreetRequest{Name: tt.req}
-			var rsp = &v0proto.GreetResponse{}
+			s := NewService()
+			rsp := &v0proto.ConvertResponse{}
 
-			err := s.Greet(context.Background(), req, rsp)
+			err := s.Convert(context.Background(), &v0proto.ConvertRequest{Content: testNotebook, To: tt.to}, rsp)
 
 			if tt.expectedErrorMessage != nil || err != nil {
 				assert.EqualError(t, err, tt.expectedErrorMessage.(string))
 			} else {
-				assert.Equal(t, "Hello "+tt.req, rsp.Message)
+				assert.True(t, strings.HasPrefix(rsp.Content, tt.expectedContent))
+				assert.Equal(t, tt.expectedFileExtension, rsp.FileExtension)
 			}
 		})
 	}
 }
+
+func TestNotebook_ConvertText(t *testing.T) {
+	s := NewService()
+	rsp := &v0proto.ConvertResponse{}
+
+	content := `{"nbformat": 4, "nbformat_minor": 4, "metadata": {}, "cells": [
+		{"cell_type": "code", "execution_count": 2, "metadata": {}, "source": "train()", "outputs": [
+			{"output_type": "stream", "name": "stderr", "text": " 50%|\u001b[32m#####     \u001b[0m|\r100%|\u001b[32m##########\u001b[0m|\n"},
+			{"output_type": "error", "ename": "ValueError", "evalue": "bad", "traceback": ["\u001b[0;31mValueError\u001b[0m: bad"]}
+		]}
+	]}`
+
+	err := s.Convert(context.Background(), &v0proto.ConvertRequest{Content: content, To: "text"}, rsp)
+	assert.Nil(t, err)
+	assert.Equal(t, "In [2]:\ntrain()\n\n100%|##########|\n\nValueError: bad\n", rsp.Content)
+	assert.Equal(t, "text/plain", rsp.MimeType)
+}
+
+func TestNotebook_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectedValid bool
+	}{
+		{"valid", testNotebook, true},
+		{"broken json", `{"nbformat": 4,`, false},
+		{"unknown cell type", `{"nbformat": 4, "nbformat_minor": 4, "metadata": {}, "cells": [{"cell_type": "foo"}]}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewService()
+			rsp := &v0proto.ValidateResponse{}
+
+			err := s.Validate(context.Background(), &v0proto.ValidateRequest{Content: tt.content}, rsp)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedValid, rsp.Valid)
+			assert.Equal(t, tt.expectedValid, len(rsp.Errors) == 0)
+		})
+	}
+}
+
+func TestNotebook_ValidateErrors(t *testing.T) {
+	s := NewService()
+	rsp := &v0proto.ValidateResponse{}
+
+	content := `{"nbformat": 4, "nbformat_minor": 4, "metadata": {}, "cells": [
+		{"cell_type": "markdown", "metadata": {}, "source": ""},
+		{"cell_type": "code", "execution_count": 1, "metadata": {}, "source": "", "outputs": [{"output_type": "stream", "text": ""}]}
+	]}`
+
+	err := s.Validate(context.Background(), &v0proto.ValidateRequest{Content: content}, rsp)
+	assert.Nil(t, err)
+	assert.False(t, rsp.Valid)
+	assert.Equal(t, "nbformat.v4.4.schema.json", rsp.Schema)
+	assert.Len(t, rsp.Errors, 1)
+	assert.Equal(t, "/cells/1/outputs/0", rsp.Errors[0].Pointer)
+	assert.Equal(t, "missing properties: 'name'", rsp.Errors[0].Message)
+
+	rsp = &v0proto.ValidateResponse{}
+	legacy := `{"metadata": {}, "nbformat": 3, "nbformat_minor": 0, "worksheets": [{"cells": [
+		{"cell_type": "code", "input": "1", "language": "python", "metadata": {}, "outputs": []}
+	]}]}`
+
+	err = s.Validate(context.Background(), &v0proto.ValidateRequest{Content: legacy}, rsp)
+	assert.Nil(t, err)
+	assert.True(t, rsp.Valid)
+	assert.True(t, rsp.Upgrade.Upgraded)
+	assert.Equal(t, "nbformat.v4.5.schema.json", rsp.Schema)
+}
+
+func TestNotebook_Render(t *testing.T) {
+	s := NewService()
+	rsp := &v0proto.RenderResponse{}
+
+	err := s.Render(context.Background(), &v0proto.RenderRequest{}, rsp)
+	assert.EqualError(t, err, "missing notebook content")
+
+	err = s.Render(context.Background(), &v0proto.RenderRequest{Path: "/home/analysis.ipynb"}, rsp)
+	assert.True(t, errors.Is(err, ErrNoStorage))
+
+	cs3 := storage.NewCS3("127.0.0.1:9142", nil)
+
+	err = NewService(Storage(cs3)).Render(context.Background(), &v0proto.RenderRequest{ResourceId: "a:b"}, rsp)
+	assert.True(t, errors.Is(err, storage.ErrMissingToken))
+
+	dav, stop := newWebDAV(t, map[string]string{"/analysis.ipynb": testNotebook})
+	defer stop()
+
+	ctx := storage.ContextWithAuthorization(context.Background(), "Bearer token")
+	err = NewService(Storage(dav)).Render(ctx, &v0proto.RenderRequest{Path: "/analysis.ipynb"}, rsp)
+	assert.Nil(t, err)
+	assert.Contains(t, rsp.Html, `<title>analysis.ipynb</title>`)
+
+	err = s.Render(context.Background(), &v0proto.RenderRequest{Content: testNotebook}, rsp)
+	assert.Nil(t, err)
+	assert.Contains(t, rsp.Html, `<title>Notebook</title>`)
+	assert.Contains(t, rsp.Html, `<h1>Title</h1>`)
+	assert.Contains(t, rsp.Html, `<div class="prompt">In [1]:</div>`)
+	assert.Contains(t, rsp.Html, `<code class="language-python"><span class="line"><span class="cl"><span class="nb">print</span>`)
+	assert.Contains(t, rsp.Html, `data-mime-type="text/html">
+<b>2</b>`)
+}
+
+func TestNotebook_RenderTheme(t *testing.T) {
+	s := NewService(Config(&config.Config{
+		Render: config.Render{
+			Theme: "github",
+		},
+	}))
+	rsp := &v0proto.RenderResponse{}
+
+	err := s.Render(context.Background(), &v0proto.RenderRequest{Content: testNotebook, Theme: "unknown"}, rsp)
+	assert.True(t, errors.Is(err, ErrUnknownTheme))
+
+	err = s.Render(context.Background(), &v0proto.RenderRequest{Content: testNotebook}, rsp)
+	assert.Nil(t, err)
+	assert.Contains(t, rsp.Html, `.chroma .nb { color: #0086b3 }`)
+
+	err = s.Render(context.Background(), &v0proto.RenderRequest{Content: testNotebook, Theme: "monokai"}, rsp)
+	assert.Nil(t, err)
+	assert.Contains(t, rsp.Html, `.chroma .k { color: #66d9ef }`)
+}
+
+// newWebDAV starts a WebDAV server with the given files, it returns the
+// driver accessing it. Like oCIS it checks the If-Match header of uploads.
+func newWebDAV(t *testing.T, files map[string]string) (storage.Driver, func()) {
+	fs := webdav.NewMemFS()
+
+	for name, content := range files {
+		f, err := fs.OpenFile(context.Background(), name, os.O_CREATE|os.O_WRONLY, 0600)
+		assert.Nil(t, err)
+		_, err = f.Write([]byte(content))
+		assert.Nil(t, err)
+		assert.Nil(t, f.Close())
+	}
+
+	handler := &webdav.Handler{
+		Prefix:     "/files",
+		FileSystem: fs,
+		LockSystem: webdav.NewMemLS(),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(storage.AuthorizationHeader) != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if etag := r.Header.Get("If-Match"); r.Method == http.MethodPut && etag != "" {
+			fi, err := fs.Stat(r.Context(), strings.TrimPrefix(r.URL.Path, handler.Prefix))
+
+			if err != nil || etag != fmt.Sprintf(`"%x%x"`, fi.ModTime().UnixNano(), fi.Size()) {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+		}
+
+		handler.ServeHTTP(w, r)
+	}))
+
+	return storage.NewWebDAV(server.URL+"/files", nil), server.Close
+}
+
+// newMemory returns a memory storage with the given files.
+func newMemory(t *testing.T, files map[string]string) storage.Driver {
+	m := storage.NewMemory()
+
+	for name, content := range files {
+		_, err := m.Write(context.Background(), storage.Reference{Path: name}, []byte(content), "")
+		assert.Nil(t, err)
+	}
+
+	return m
+}
+
+func TestNotebook_Save(t *testing.T) {
+	s := NewService(Storage(newMemory(t, map[string]string{"/analysis.ipynb": testNotebook})))
+	ctx := context.Background()
+
+	read := &v0proto.ConvertResponse{}
+	err := s.Convert(ctx, &v0proto.ConvertRequest{Path: "/analysis.ipynb"}, read)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, read.Etag)
+
+	rsp := &v0proto.SaveResponse{}
+	err = s.Save(ctx, &v0proto.SaveRequest{Content: read.Content, Path: "/analysis.ipynb", Etag: read.Etag}, rsp)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, rsp.Etag)
+	assert.NotEqual(t, read.Etag, rsp.Etag)
+	assert.Nil(t, rsp.Upgrade)
+
+	current := rsp.Etag
+
+	err = s.Save(ctx, &v0proto.SaveRequest{Content: read.Content, Path: "/analysis.ipynb", Etag: read.Etag}, rsp)
+	assert.True(t, errors.Is(err, storage.ErrConflict))
+
+	var conflict *storage.ConflictError
+
+	if assert.True(t, errors.As(err, &conflict)) {
+		assert.Equal(t, current, conflict.Current.ETag)
+	}
+
+	err = s.Save(ctx, &v0proto.SaveRequest{Content: read.Content, Path: "/analysis.ipynb"}, rsp)
+	assert.Nil(t, err)
+
+	err = s.Save(ctx, &v0proto.SaveRequest{Content: read.Content}, rsp)
+	assert.Equal(t, ErrNotStored, err)
+
+	err = s.Save(ctx, &v0proto.SaveRequest{Path: "/analysis.ipynb"}, rsp)
+	assert.Equal(t, ErrMissingContent, err)
+
+	err = NewService().Save(ctx, &v0proto.SaveRequest{Content: read.Content, Path: "/analysis.ipynb"}, rsp)
+	assert.True(t, errors.Is(err, ErrNoStorage))
+}
+
+func TestNotebook_ConvertSave(t *testing.T) {
+	legacy := `{"metadata": {}, "nbformat": 3, "nbformat_minor": 0, "worksheets": [{"cells": [
+		{"cell_type": "code", "input": "1 + 1", "language": "python", "metadata": {}, "outputs": [], "prompt_number": 1}
+	]}]}`
+
+	s := NewService(Storage(newMemory(t, map[string]string{"/legacy.ipynb": legacy})))
+	ctx := context.Background()
+	rsp := &v0proto.ConvertResponse{}
+
+	err := s.Convert(ctx, &v0proto.ConvertRequest{Path: "/legacy.ipynb", To: "html", Save: true}, rsp)
+	assert.True(t, errors.Is(err, ErrUnsupportedFormat))
+
+	err = s.Convert(ctx, &v0proto.ConvertRequest{Path: "/legacy.ipynb", Save: true}, rsp)
+	assert.Nil(t, err)
+	assert.True(t, rsp.Upgrade.Upgraded)
+
+	saved := &v0proto.ConvertResponse{}
+	err = s.Convert(ctx, &v0proto.ConvertRequest{Path: "/legacy.ipynb"}, saved)
+	assert.Nil(t, err)
+	assert.Nil(t, saved.Upgrade)
+	assert.Equal(t, rsp.Etag, saved.Etag)
+	assert.Equal(t, rsp.Content, saved.Content)
+
+	err = s.Convert(ctx, &v0proto.ConvertRequest{Content: legacy, Save: true}, rsp)
+	assert.Equal(t, ErrNotStored, err)
+}
+
+// adminContext returns the context of a request by an account with the admin role.
+func adminContext() context.Context {
+	return metadata.Set(context.Background(), middleware.RoleIDs, `["`+ssvc.BundleUUIDRoleAdmin+`"]`)
+}
+
+func TestNotebook_Trust(t *testing.T) {
+	cfg := config.New()
+	cfg.TokenManager.TrustSecret = "secret"
+
+	s := NewService(Config(cfg))
+	content := `{"nbformat": 4, "nbformat_minor": 4, "metadata": {}, "cells": [
+		{"cell_type": "code", "execution_count": 1, "metadata": {}, "source": "", "outputs": [
+			{"output_type": "display_data", "metadata": {}, "data": {"text/html": "<script>run()</script>"}}
+		]}
+	]}`
+
+	render := &v0proto.RenderResponse{}
+	err := s.Render(context.Background(), &v0proto.RenderRequest{Content: content}, render)
+	assert.Nil(t, err)
+	assert.False(t, render.Trusted)
+	assert.NotContains(t, render.Html, "<script>run()</script>")
+
+	rsp := &v0proto.TrustResponse{}
+	err = s.Trust(context.Background(), &v0proto.TrustRequest{Content: content}, rsp)
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	err = s.Trust(adminContext(), &v0proto.TrustRequest{Content: content}, rsp)
+	assert.Nil(t, err)
+	assert.Equal(t, "sha256", rsp.Algorithm)
+	assert.Len(t, rsp.Signature, 64)
+	assert.False(t, rsp.AlreadyTrusted)
+
+	err = s.Render(context.Background(), &v0proto.RenderRequest{Content: content}, render)
+	assert.Nil(t, err)
+	assert.True(t, render.Trusted)
+	assert.Contains(t, render.Html, "<script>run()</script>")
+
+	err = s.Trust(adminContext(), &v0proto.TrustRequest{Content: content}, rsp)
+	assert.Nil(t, err)
+	assert.True(t, rsp.AlreadyTrusted)
+
+	legacy := `{"metadata": {}, "nbformat": 3, "nbformat_minor": 0, "worksheets": []}`
+	err = s.Trust(adminContext(), &v0proto.TrustRequest{Content: legacy}, rsp)
+	assert.Equal(t, ErrLegacyTrust, err)
+
+	err = NewService().Trust(adminContext(), &v0proto.TrustRequest{Content: content}, rsp)
+	assert.Equal(t, trust.ErrNoSecret, err)
+}
@@ -0,0 +1,91 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+// document is the notebook of a request, either sent along or stored.
+type document struct {
+	content string
+	title   string
+
+	// ref and etag are set for stored notebooks.
+	ref  storage.Reference
+	etag string
+}
+
+// fetch returns the notebook of a request. Without content in the request
+// the notebook is read from the storage.
+func (s Notebook) fetch(ctx context.Context, content string, ref storage.Reference) (*document, error) {
+	doc := &document{
+		content: content,
+		title:   "Notebook",
+	}
+
+	if ref.Path != "" {
+		doc.title = path.Base(ref.Path)
+	}
+
+	if content != "" || ref.IsEmpty() {
+		return doc, nil
+	}
+
+	if s.storage == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoStorage, ref)
+	}
+
+	info, err := s.storage.Stat(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.storage.Read(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	doc.content = string(b)
+	doc.title = info.Name()
+	doc.ref = ref
+	doc.etag = info.ETag
+	return doc, nil
+}
+
+// fetchNotebook returns a decoded notebook of a request, the name of the
+// notebook is added to decoding errors.
+func (s Notebook) fetchNotebook(ctx context.Context, name, content string, ref storage.Reference) (*notebook.Notebook, error) {
+	doc, err := s.fetch(ctx, content, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nb, _, err := load(doc.content)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s notebook: %w", name, err)
+	}
+
+	return nb, nil
+}
+
+// store writes a notebook to the storage and returns its new ETag. With an
+// ETag given the notebook is only written if it did not change since.
+func (s Notebook) store(ctx context.Context, ref storage.Reference, content []byte, etag string) (string, error) {
+	if ref.IsEmpty() {
+		return "", ErrNotStored
+	}
+
+	if s.storage == nil {
+		return "", fmt.Errorf("%w: %s", ErrNoStorage, ref)
+	}
+
+	return s.storage.Write(ctx, ref, content, etag)
+}
@@ -8,24 +8,243 @@ import (
 )
 
 // NewTracing returns a service that instruments traces.
-func NewTracing(next v0proto.HelloHandler) v0proto.HelloHandler {
+func NewTracing(next v0proto.NotebookHandler) v0proto.NotebookHandler {
 	return tracing{
 		next: next,
 	}
 }
 
 type tracing struct {
-	next v0proto.HelloHandler
+	next v0proto.NotebookHandler
 }
 
-// Greet implements the HelloHandler interface.
-func (t tracing) Greet(ctx context.Context, req *v0proto.GreetRequest, rsp *v0proto.GreetResponse) error {
-	ctx, span := trace.StartSpan(ctx, "Hello.Greet")
+// Render implements the NotebookHandler interface.
+func (t tracing) Render(ctx context.Context, req *v0proto.RenderRequest, rsp *v0proto.RenderResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.Render")
 	defer span.End()
 
 	span.Annotate([]trace.Attribute{
-		trace.StringAttribute("name", req.Name),
-	}, "Execute Hello.Greet handler")
+		trace.Int64Attribute("content_length", int64(len(req.Content))),
+		trace.StringAttribute("path", req.Path),
+		trace.StringAttribute("resource_id", req.ResourceId),
+		trace.StringAttribute("theme", req.Theme),
+	}, "Execute Notebook.Render handler")
 
-	return t.next.Greet(ctx, req, rsp)
+	return t.next.Render(ctx, req, rsp)
+}
+
+// Validate implements the NotebookHandler interface.
+func (t tracing) Validate(ctx context.Context, req *v0proto.ValidateRequest, rsp *v0proto.ValidateResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.Validate")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.Int64Attribute("content_length", int64(len(req.Content))),
+	}, "Execute Notebook.Validate handler")
+
+	return t.next.Validate(ctx, req, rsp)
+}
+
+// GetInfo implements the NotebookHandler interface.
+func (t tracing) GetInfo(ctx context.Context, req *v0proto.GetInfoRequest, rsp *v0proto.GetInfoResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.GetInfo")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.Int64Attribute("content_length", int64(len(req.Content))),
+	}, "Execute Notebook.GetInfo handler")
+
+	return t.next.GetInfo(ctx, req, rsp)
+}
+
+// Convert implements the NotebookHandler interface.
+func (t tracing) Convert(ctx context.Context, req *v0proto.ConvertRequest, rsp *v0proto.ConvertResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.Convert")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.Int64Attribute("content_length", int64(len(req.Content))),
+		trace.StringAttribute("path", req.Path),
+		trace.StringAttribute("resource_id", req.ResourceId),
+		trace.StringAttribute("to", req.To),
+		trace.BoolAttribute("save", req.Save),
+	}, "Execute Notebook.Convert handler")
+
+	return t.next.Convert(ctx, req, rsp)
+}
+
+// Trust implements the NotebookHandler interface.
+func (t tracing) Trust(ctx context.Context, req *v0proto.TrustRequest, rsp *v0proto.TrustResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.Trust")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.Int64Attribute("content_length", int64(len(req.Content))),
+	}, "Execute Notebook.Trust handler")
+
+	return t.next.Trust(ctx, req, rsp)
+}
+
+// Save implements the NotebookHandler interface.
+func (t tracing) Save(ctx context.Context, req *v0proto.SaveRequest, rsp *v0proto.SaveResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.Save")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.Int64Attribute("content_length", int64(len(req.Content))),
+		trace.StringAttribute("path", req.Path),
+		trace.StringAttribute("resource_id", req.ResourceId),
+		trace.StringAttribute("etag", req.Etag),
+	}, "Execute Notebook.Save handler")
+
+	return t.next.Save(ctx, req, rsp)
+}
+
+// CreateCheckpoint implements the NotebookHandler interface.
+func (t tracing) CreateCheckpoint(ctx context.Context, req *v0proto.CreateCheckpointRequest, rsp *v0proto.CreateCheckpointResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.CreateCheckpoint")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("path", req.Path),
+	}, "Execute Notebook.CreateCheckpoint handler")
+
+	return t.next.CreateCheckpoint(ctx, req, rsp)
+}
+
+// ListCheckpoints implements the NotebookHandler interface.
+func (t tracing) ListCheckpoints(ctx context.Context, req *v0proto.ListCheckpointsRequest, rsp *v0proto.ListCheckpointsResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.ListCheckpoints")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("path", req.Path),
+	}, "Execute Notebook.ListCheckpoints handler")
+
+	return t.next.ListCheckpoints(ctx, req, rsp)
+}
+
+// RestoreCheckpoint implements the NotebookHandler interface.
+func (t tracing) RestoreCheckpoint(ctx context.Context, req *v0proto.RestoreCheckpointRequest, rsp *v0proto.RestoreCheckpointResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.RestoreCheckpoint")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("path", req.Path),
+		trace.StringAttribute("checkpoint_id", req.CheckpointId),
+	}, "Execute Notebook.RestoreCheckpoint handler")
+
+	return t.next.RestoreCheckpoint(ctx, req, rsp)
+}
+
+// DeleteCheckpoint implements the NotebookHandler interface.
+func (t tracing) DeleteCheckpoint(ctx context.Context, req *v0proto.DeleteCheckpointRequest, rsp *v0proto.DeleteCheckpointResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.DeleteCheckpoint")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("path", req.Path),
+		trace.StringAttribute("checkpoint_id", req.CheckpointId),
+	}, "Execute Notebook.DeleteCheckpoint handler")
+
+	return t.next.DeleteCheckpoint(ctx, req, rsp)
+}
+
+// ListVersions implements the NotebookHandler interface.
+func (t tracing) ListVersions(ctx context.Context, req *v0proto.ListVersionsRequest, rsp *v0proto.ListVersionsResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.ListVersions")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("path", req.Path),
+		trace.StringAttribute("resource_id", req.ResourceId),
+	}, "Execute Notebook.ListVersions handler")
+
+	return t.next.ListVersions(ctx, req, rsp)
+}
+
+// GetVersion implements the NotebookHandler interface.
+func (t tracing) GetVersion(ctx context.Context, req *v0proto.GetVersionRequest, rsp *v0proto.GetVersionResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.GetVersion")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("path", req.Path),
+		trace.StringAttribute("resource_id", req.ResourceId),
+		trace.StringAttribute("key", req.Key),
+	}, "Execute Notebook.GetVersion handler")
+
+	return t.next.GetVersion(ctx, req, rsp)
+}
+
+// DiffVersions implements the NotebookHandler interface.
+func (t tracing) DiffVersions(ctx context.Context, req *v0proto.DiffVersionsRequest, rsp *v0proto.DiffVersionsResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.DiffVersions")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("path", req.Path),
+		trace.StringAttribute("resource_id", req.ResourceId),
+		trace.StringAttribute("from", req.From),
+		trace.StringAttribute("to", req.To),
+	}, "Execute Notebook.DiffVersions handler")
+
+	return t.next.DiffVersions(ctx, req, rsp)
+}
+
+// CompareVersions implements the NotebookHandler interface.
+func (t tracing) CompareVersions(ctx context.Context, req *v0proto.CompareVersionsRequest, rsp *v0proto.CompareVersionsResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.CompareVersions")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("path", req.Path),
+		trace.StringAttribute("resource_id", req.ResourceId),
+		trace.StringAttribute("from", req.From),
+		trace.StringAttribute("to", req.To),
+		trace.StringAttribute("theme", req.Theme),
+	}, "Execute Notebook.CompareVersions handler")
+
+	return t.next.CompareVersions(ctx, req, rsp)
+}
+
+// Diff implements the NotebookHandler interface.
+func (t tracing) Diff(ctx context.Context, req *v0proto.DiffRequest, rsp *v0proto.DiffResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.Diff")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("old_path", req.OldPath),
+		trace.StringAttribute("old_resource_id", req.OldResourceId),
+		trace.StringAttribute("new_path", req.NewPath),
+		trace.StringAttribute("new_resource_id", req.NewResourceId),
+		trace.StringAttribute("format", req.Format),
+	}, "Execute Notebook.Diff handler")
+
+	return t.next.Diff(ctx, req, rsp)
+}
+
+// Merge implements the NotebookHandler interface.
+func (t tracing) Merge(ctx context.Context, req *v0proto.MergeRequest, rsp *v0proto.MergeResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.Merge")
+	defer span.End()
+
+	span.Annotate([]trace.Attribute{
+		trace.StringAttribute("base_path", req.BasePath),
+		trace.StringAttribute("local_path", req.LocalPath),
+		trace.StringAttribute("remote_path", req.RemotePath),
+		trace.BoolAttribute("conflict_cells", req.ConflictCells),
+	}, "Execute Notebook.Merge handler")
+
+	return t.next.Merge(ctx, req, rsp)
+}
+
+// ListKernelSpecs implements the NotebookHandler interface.
+func (t tracing) ListKernelSpecs(ctx context.Context, req *v0proto.ListKernelSpecsRequest, rsp *v0proto.ListKernelSpecsResponse) error {
+	ctx, span := trace.StartSpan(ctx, "Notebook.ListKernelSpecs")
+	defer span.End()
+
+	span.Annotate(nil, "Execute Notebook.ListKernelSpecs handler")
+
+	return t.next.ListKernelSpecs(ctx, req, rsp)
 }
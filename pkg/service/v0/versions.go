@@ -0,0 +1,190 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/diff"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+)
+
+// ListVersions implements the NotebookHandler interface.
+func (s Notebook) ListVersions(ctx context.Context, req *v0proto.ListVersionsRequest, rsp *v0proto.ListVersionsResponse) error {
+	ref := storage.Reference{
+		Path:       req.Path,
+		ResourceID: req.ResourceId,
+	}
+
+	if err := s.checkVersioned(ref); err != nil {
+		return err
+	}
+
+	versions, err := s.storage.Versions(ctx, ref)
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Versions = make([]*v0proto.Version, 0, len(versions))
+
+	for _, version := range versions {
+		rsp.Versions = append(rsp.Versions, &v0proto.Version{
+			Key:          version.Key,
+			Etag:         version.ETag,
+			Size:         version.Size,
+			LastModified: version.Modified.Format(time.RFC3339),
+		})
+	}
+
+	return nil
+}
+
+// GetVersion implements the NotebookHandler interface.
+func (s Notebook) GetVersion(ctx context.Context, req *v0proto.GetVersionRequest, rsp *v0proto.GetVersionResponse) error {
+	ref := storage.Reference{
+		Path:       req.Path,
+		ResourceID: req.ResourceId,
+	}
+
+	if err := s.checkVersioned(ref); err != nil {
+		return err
+	}
+
+	b, err := s.readVersion(ctx, ref, req.Key)
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Content = string(b)
+	return nil
+}
+
+// DiffVersions implements the NotebookHandler interface.
+func (s Notebook) DiffVersions(ctx context.Context, req *v0proto.DiffVersionsRequest, rsp *v0proto.DiffVersionsResponse) error {
+	ref := storage.Reference{
+		Path:       req.Path,
+		ResourceID: req.ResourceId,
+	}
+
+	if err := s.checkVersioned(ref); err != nil {
+		return err
+	}
+
+	old, new, err := s.loadVersions(ctx, ref, req.From, req.To)
+
+	if err != nil {
+		return err
+	}
+
+	d := diff.Notebooks(old, new)
+
+	rsp.Cells = newCellDiffs(d.Cells)
+	rsp.Changed = d.Changed()
+	rsp.Metadata = newPatchOperations(d.Metadata)
+
+	return nil
+}
+
+// CompareVersions implements the NotebookHandler interface.
+func (s Notebook) CompareVersions(ctx context.Context, req *v0proto.CompareVersionsRequest, rsp *v0proto.CompareVersionsResponse) error {
+	theme, err := themeFor(ctx, req.Theme)
+
+	if err != nil {
+		return err
+	}
+
+	ref := storage.Reference{
+		Path:       req.Path,
+		ResourceID: req.ResourceId,
+	}
+
+	if err := s.checkVersioned(ref); err != nil {
+		return err
+	}
+
+	old, new, err := s.loadVersions(ctx, ref, req.From, req.To)
+
+	if err != nil {
+		return err
+	}
+
+	// Outputs are always sanitized, signatures only cover the current version.
+	renderer := s.renderer
+
+	if theme != "" {
+		renderer = renderer.WithTheme(theme)
+	}
+
+	html, err := renderer.CompareString(old, new, versionTitle(req.From), versionTitle(req.To))
+
+	if err != nil {
+		return err
+	}
+
+	rsp.Html = html
+	return nil
+}
+
+// checkVersioned returns an error unless a stored notebook is referenced and
+// a storage is available.
+func (s Notebook) checkVersioned(ref storage.Reference) error {
+	if ref.IsEmpty() {
+		return ErrMissingReference
+	}
+
+	if s.storage == nil {
+		return fmt.Errorf("%w: %s", ErrNoStorage, ref)
+	}
+
+	return nil
+}
+
+// readVersion returns the content of a version of a stored notebook, the
+// current one if the key is empty.
+func (s Notebook) readVersion(ctx context.Context, ref storage.Reference, key string) ([]byte, error) {
+	if key == "" {
+		return s.storage.Read(ctx, ref)
+	}
+
+	return s.storage.ReadVersion(ctx, ref, key)
+}
+
+// loadVersions returns two versions of a stored notebook.
+func (s Notebook) loadVersions(ctx context.Context, ref storage.Reference, from, to string) (*notebook.Notebook, *notebook.Notebook, error) {
+	b, err := s.readVersion(ctx, ref, from)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	old, _, err := load(string(b))
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("version %q: %w", from, err)
+	}
+
+	if b, err = s.readVersion(ctx, ref, to); err != nil {
+		return nil, nil, err
+	}
+
+	new, _, err := load(string(b))
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("version %q: %w", to, err)
+	}
+
+	return old, new, nil
+}
+
+// versionTitle labels a version of a stored notebook.
+func versionTitle(key string) string {
+	if key == "" {
+		return "Current version"
+	}
+
+	return "Version " + key
+}
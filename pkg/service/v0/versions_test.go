@@ -0,0 +1,75 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v0proto "github.com/anaswaratrajan/ocis-jupyter/pkg/proto/v0"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotebook_Versions(t *testing.T) {
+	drv := newMemory(t, map[string]string{"/analysis.ipynb": testNotebook})
+	s := NewService(Storage(drv))
+	ctx := context.Background()
+
+	changed := strings.Replace(testNotebook, `["print(1)"]`, `["print(2)"]`, 1)
+	_, err := drv.Write(ctx, storage.Reference{Path: "/analysis.ipynb"}, []byte(changed), "")
+	assert.Nil(t, err)
+
+	list := &v0proto.ListVersionsResponse{}
+	err = s.ListVersions(ctx, &v0proto.ListVersionsRequest{Path: "/analysis.ipynb"}, list)
+	assert.Nil(t, err)
+
+	if !assert.NotEmpty(t, list.Versions) {
+		return
+	}
+
+	first := list.Versions[len(list.Versions)-1]
+	assert.NotEmpty(t, first.LastModified)
+
+	version := &v0proto.GetVersionResponse{}
+	err = s.GetVersion(ctx, &v0proto.GetVersionRequest{Path: "/analysis.ipynb", Key: first.Key}, version)
+	assert.Nil(t, err)
+	assert.Equal(t, testNotebook, version.Content)
+
+	rsp := &v0proto.DiffVersionsResponse{}
+	err = s.DiffVersions(ctx, &v0proto.DiffVersionsRequest{Path: "/analysis.ipynb", From: first.Key}, rsp)
+	assert.Nil(t, err)
+	assert.True(t, rsp.Changed)
+
+	if assert.Len(t, rsp.Cells, 2) {
+		assert.Equal(t, "unchanged", rsp.Cells[0].Op)
+		assert.Equal(t, "modified", rsp.Cells[1].Op)
+		assert.Equal(t, []*v0proto.LineDiff{
+			{Op: "removed", Text: "print(1)"},
+			{Op: "added", Text: "print(2)"},
+		}, rsp.Cells[1].Source)
+	}
+
+	compare := &v0proto.CompareVersionsResponse{}
+	err = s.CompareVersions(ctx, &v0proto.CompareVersionsRequest{Path: "/analysis.ipynb", From: first.Key}, compare)
+	assert.Nil(t, err)
+	assert.Contains(t, compare.Html, "<title>Version "+first.Key+" - Current version</title>")
+	assert.Contains(t, compare.Html, `<div class="row row-modified code-row">`)
+
+	err = s.CompareVersions(ctx, &v0proto.CompareVersionsRequest{Path: "/analysis.ipynb", Theme: "unknown"}, compare)
+	assert.True(t, errors.Is(err, ErrUnknownTheme))
+
+	rsp = &v0proto.DiffVersionsResponse{}
+	err = s.DiffVersions(ctx, &v0proto.DiffVersionsRequest{Path: "/analysis.ipynb", From: first.Key, To: first.Key}, rsp)
+	assert.Nil(t, err)
+	assert.False(t, rsp.Changed)
+
+	err = s.GetVersion(ctx, &v0proto.GetVersionRequest{Path: "/analysis.ipynb", Key: "42"}, &v0proto.GetVersionResponse{})
+	assert.True(t, errors.Is(err, storage.ErrNotFound))
+
+	err = s.ListVersions(ctx, &v0proto.ListVersionsRequest{}, &v0proto.ListVersionsResponse{})
+	assert.Equal(t, ErrMissingReference, err)
+
+	err = NewService().ListVersions(ctx, &v0proto.ListVersionsRequest{Path: "/analysis.ipynb"}, &v0proto.ListVersionsResponse{})
+	assert.True(t, errors.Is(err, ErrNoStorage))
+}
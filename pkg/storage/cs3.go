@@ -0,0 +1,524 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"google.golang.org/grpc"
+	gmetadata "google.golang.org/grpc/metadata"
+)
+
+const (
+	// transferHeader carries the token of the data gateway for a download.
+	transferHeader = "X-Reva-Transfer"
+
+	// simpleProtocol is the download protocol of plain HTTP requests.
+	simpleProtocol = "simple"
+)
+
+// CS3 reads files through the CS3 gateway of reva.
+type CS3 struct {
+	address string
+	client  *http.Client
+
+	once    sync.Once
+	gateway gateway.GatewayAPIClient
+	err     error
+
+	writes keyedMutex
+}
+
+// keyedMutex serializes operations on the same key.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+// keyedLock is the lock of a key, it is dropped when nobody waits for it.
+type keyedLock struct {
+	sync.Mutex
+	waiting int
+}
+
+// lock locks the key and returns the function unlocking it.
+func (m *keyedMutex) lock(key string) func() {
+	m.mu.Lock()
+
+	if m.locks == nil {
+		m.locks = map[string]*keyedLock{}
+	}
+
+	l, ok := m.locks[key]
+
+	if !ok {
+		l = &keyedLock{}
+		m.locks[key] = l
+	}
+
+	l.waiting++
+	m.mu.Unlock()
+
+	l.Lock()
+
+	return func() {
+		l.Unlock()
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if l.waiting--; l.waiting == 0 {
+			delete(m.locks, key)
+		}
+	}
+}
+
+// NewCS3 initializes a new client for the gateway at the given address. The
+// connection is established with the first request.
+func NewCS3(address string, client *http.Client) *CS3 {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &CS3{
+		address: address,
+		client:  client,
+	}
+}
+
+// Stat returns the info of a file.
+func (c *CS3) Stat(ctx context.Context, ref Reference) (*Info, error) {
+	gw, ctx, cref, err := c.prepare(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := gw.Stat(ctx, &provider.StatRequest{
+		Ref: cref,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStatus(res.Status, ref); err != nil {
+		return nil, err
+	}
+
+	return newInfo(res.Info), nil
+}
+
+// Read downloads the content of a file through the data gateway.
+func (c *CS3) Read(ctx context.Context, ref Reference) ([]byte, error) {
+	gw, ctx, cref, err := c.prepare(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := gw.InitiateFileDownload(ctx, &provider.InitiateFileDownloadRequest{
+		Ref: cref,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStatus(res.Status, ref); err != nil {
+		return nil, err
+	}
+
+	var endpoint, transfer string
+
+	for _, p := range res.Protocols {
+		if p.Protocol == simpleProtocol {
+			endpoint, transfer = p.DownloadEndpoint, p.Token
+		}
+	}
+
+	if endpoint == "" {
+		return nil, fmt.Errorf("%s: no download endpoint for the %s protocol", ref, simpleProtocol)
+	}
+
+	rsp, err := c.transfer(ctx, http.MethodGet, endpoint, transfer, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rsp.Body.Close()
+
+	if err := checkResponse(rsp, ref, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadAll(rsp.Body)
+}
+
+// Write uploads the content of a file through the data gateway. With an ETag
+// given the file is only replaced if it did not change in the meantime. The
+// gateway offers no conditional uploads, so the ETag is compared in advance
+// and the writes of a file are serialized, which only guards against the
+// writes of this service.
+func (c *CS3) Write(ctx context.Context, ref Reference, content []byte, etag string) (string, error) {
+	current, err := c.Stat(ctx, ref)
+
+	if err != nil && (etag != "" || !errors.Is(err, ErrNotFound)) {
+		return "", err
+	}
+
+	// References by path and by id of the same file share the lock.
+	key := ref.String()
+
+	if current != nil && current.ID != "" {
+		key = current.ID
+	}
+
+	unlock := c.writes.lock(key)
+	defer unlock()
+
+	if etag != "" {
+		current, err := c.Stat(ctx, ref)
+
+		if err != nil {
+			return "", err
+		}
+
+		if current.ETag != etag {
+			return "", &ConflictError{Ref: ref, Current: current}
+		}
+	}
+
+	gw, gctx, cref, err := c.prepare(ctx, ref)
+
+	if err != nil {
+		return "", err
+	}
+
+	res, err := gw.InitiateFileUpload(gctx, &provider.InitiateFileUploadRequest{
+		Ref: cref,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := checkStatus(res.Status, ref); err != nil {
+		return "", err
+	}
+
+	var endpoint, transfer string
+
+	for _, p := range res.Protocols {
+		if p.Protocol == simpleProtocol {
+			endpoint, transfer = p.UploadEndpoint, p.Token
+		}
+	}
+
+	if endpoint == "" {
+		return "", fmt.Errorf("%s: no upload endpoint for the %s protocol", ref, simpleProtocol)
+	}
+
+	rsp, err := c.transfer(gctx, http.MethodPut, endpoint, transfer, bytes.NewReader(content))
+
+	if err != nil {
+		return "", err
+	}
+
+	defer rsp.Body.Close()
+
+	if err := checkResponse(rsp, ref, http.StatusOK, http.StatusCreated, http.StatusNoContent); err != nil {
+		return "", err
+	}
+
+	info, err := c.Stat(ctx, ref)
+
+	if err != nil {
+		return "", err
+	}
+
+	return info.ETag, nil
+}
+
+// List returns the infos of the files in a folder.
+func (c *CS3) List(ctx context.Context, ref Reference) ([]*Info, error) {
+	gw, ctx, cref, err := c.prepare(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := gw.ListContainer(ctx, &provider.ListContainerRequest{
+		Ref: cref,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStatus(res.Status, ref); err != nil {
+		return nil, err
+	}
+
+	infos := make([]*Info, 0, len(res.Infos))
+
+	for _, ri := range res.Infos {
+		infos = append(infos, newInfo(ri))
+	}
+
+	return infos, nil
+}
+
+// MakeDir creates a folder.
+func (c *CS3) MakeDir(ctx context.Context, ref Reference) error {
+	gw, ctx, cref, err := c.prepare(ctx, ref)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := gw.CreateContainer(ctx, &provider.CreateContainerRequest{
+		Ref: cref,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return checkStatus(res.Status, ref)
+}
+
+// Delete removes a file or a folder with all files in it.
+func (c *CS3) Delete(ctx context.Context, ref Reference) error {
+	gw, ctx, cref, err := c.prepare(ctx, ref)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := gw.Delete(ctx, &provider.DeleteRequest{
+		Ref: cref,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return checkStatus(res.Status, ref)
+}
+
+// Move renames a file or a folder.
+func (c *CS3) Move(ctx context.Context, from, to Reference) error {
+	if _, err := c.Stat(ctx, to); err == nil {
+		return fmt.Errorf("%w: %s", ErrExists, to)
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	gw, ctx, src, err := c.prepare(ctx, from)
+
+	if err != nil {
+		return err
+	}
+
+	dst, err := reference(to)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := gw.Move(ctx, &provider.MoveRequest{
+		Source:      src,
+		Destination: dst,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return checkStatus(res.Status, from)
+}
+
+// Versions returns the earlier versions of a file, the latest first.
+func (c *CS3) Versions(ctx context.Context, ref Reference) ([]*Version, error) {
+	gw, ctx, cref, err := c.prepare(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := gw.ListFileVersions(ctx, &provider.ListFileVersionsRequest{
+		Ref: cref,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStatus(res.Status, ref); err != nil {
+		return nil, err
+	}
+
+	versions := make([]*Version, 0, len(res.Versions))
+
+	for _, v := range res.Versions {
+		versions = append(versions, &Version{
+			Key:      v.GetKey(),
+			Size:     v.GetSize(),
+			ETag:     v.GetEtag(),
+			Modified: time.Unix(int64(v.GetMtime()), 0).UTC(),
+		})
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Modified.After(versions[j].Modified)
+	})
+
+	return versions, nil
+}
+
+// ReadVersion downloads an earlier version of a file. The storage providers
+// of oCIS address versions by the resource id of the file, with the version
+// key appended to the opaque id.
+func (c *CS3) ReadVersion(ctx context.Context, ref Reference, key string) ([]byte, error) {
+	info, err := c.Stat(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if info.ID == "" {
+		return nil, fmt.Errorf("%w: %s has no resource id", ErrNotSupported, ref)
+	}
+
+	b, err := c.Read(ctx, Reference{ResourceID: info.ID + ".REV." + key})
+
+	if errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("%w: version %s of %s", ErrNotFound, key, ref)
+	}
+
+	return b, err
+}
+
+// transfer sends a request to the data gateway, on behalf of the user of the
+// context.
+func (c *CS3) transfer(ctx context.Context, method, endpoint, transfer string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	token, _ := TokenFromContext(ctx)
+	req.Header.Set(TokenHeader, token)
+
+	if transfer != "" {
+		req.Header.Set(transferHeader, transfer)
+	}
+
+	return c.client.Do(req)
+}
+
+// prepare returns the gateway client, the context with the access token of
+// the user for outgoing requests and the CS3 reference of a file.
+func (c *CS3) prepare(ctx context.Context, ref Reference) (gateway.GatewayAPIClient, context.Context, *provider.Reference, error) {
+	token, ok := TokenFromContext(ctx)
+
+	if !ok {
+		return nil, nil, nil, ErrMissingToken
+	}
+
+	cref, err := reference(ref)
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	c.once.Do(func() {
+		var conn *grpc.ClientConn
+
+		conn, c.err = grpc.Dial(c.address, grpc.WithInsecure())
+		c.gateway = gateway.NewGatewayAPIClient(conn)
+	})
+
+	if c.err != nil {
+		return nil, nil, nil, c.err
+	}
+
+	ctx = gmetadata.AppendToOutgoingContext(ctx, TokenHeader, token)
+	return c.gateway, ctx, cref, nil
+}
+
+// reference converts a reference to its CS3 counterpart.
+func reference(ref Reference) (*provider.Reference, error) {
+	if ref.Path != "" {
+		return &provider.Reference{
+			Spec: &provider.Reference_Path{Path: ref.Path},
+		}, nil
+	}
+
+	storageID, opaqueID, err := ParseResourceID(ref.ResourceID)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, ref.ResourceID)
+	}
+
+	return &provider.Reference{
+		Spec: &provider.Reference_Id{
+			Id: &provider.ResourceId{
+				StorageId: storageID,
+				OpaqueId:  opaqueID,
+			},
+		},
+	}, nil
+}
+
+// checkStatus converts the status of a CS3 response to an error.
+func checkStatus(status *rpc.Status, ref Reference) error {
+	switch status.GetCode() {
+	case rpc.Code_CODE_OK:
+		return nil
+	case rpc.Code_CODE_NOT_FOUND:
+		return fmt.Errorf("%w: %s", ErrNotFound, ref)
+	case rpc.Code_CODE_PERMISSION_DENIED, rpc.Code_CODE_UNAUTHENTICATED:
+		return fmt.Errorf("%w: %s", ErrPermissionDenied, ref)
+	case rpc.Code_CODE_ALREADY_EXISTS:
+		return fmt.Errorf("%w: %s", ErrExists, ref)
+	case rpc.Code_CODE_UNIMPLEMENTED:
+		return fmt.Errorf("%w: %s", ErrNotSupported, ref)
+	}
+
+	return fmt.Errorf("%s: %s: %s", ref, status.GetCode(), status.GetMessage())
+}
+
+// newInfo converts the resource info of a CS3 response.
+func newInfo(ri *provider.ResourceInfo) *Info {
+	info := &Info{
+		Path:     ri.GetPath(),
+		Size:     ri.GetSize(),
+		ETag:     ri.GetEtag(),
+		MimeType: ri.GetMimeType(),
+		IsDir:    ri.GetType() == provider.ResourceType_RESOURCE_TYPE_CONTAINER,
+	}
+
+	if id := ri.GetId(); id != nil {
+		info.ID = id.GetStorageId() + ":" + id.GetOpaqueId()
+	}
+
+	if mtime := ri.GetMtime(); mtime != nil {
+		info.Modified = time.Unix(int64(mtime.GetSeconds()), int64(mtime.GetNanos())).UTC()
+	}
+
+	return info
+}
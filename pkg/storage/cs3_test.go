@@ -0,0 +1,363 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	gmetadata "google.golang.org/grpc/metadata"
+)
+
+const (
+	testToken    = "reva-token"
+	testContent  = `{"cells": [], "metadata": {}, "nbformat": 4, "nbformat_minor": 4}`
+	testPath     = "/home/analysis.ipynb"
+	testStorage  = "1284d238-aa92-42ce-bdc4-0b0000009157"
+	testOpaqueID = "4c510ada-c86b-4815-8820-42cdf82c3d51"
+)
+
+// fakeGateway serves a single notebook to requests with the test token.
+type fakeGateway struct {
+	gateway.UnimplementedGatewayAPIServer
+
+	endpoint string
+
+	mu      sync.Mutex
+	content string
+	version int
+	history []string
+}
+
+// lookup returns the status of the file a request refers to.
+func (g *fakeGateway) lookup(ctx context.Context, ref *provider.Reference) *rpc.Status {
+	md, _ := gmetadata.FromIncomingContext(ctx)
+
+	if tokens := md.Get(TokenHeader); len(tokens) != 1 || tokens[0] != testToken {
+		return &rpc.Status{Code: rpc.Code_CODE_UNAUTHENTICATED}
+	}
+
+	if ref.GetPath() == testPath || ref.GetId().GetOpaqueId() == testOpaqueID || g.revision(ref) > 0 {
+		return &rpc.Status{Code: rpc.Code_CODE_OK}
+	}
+
+	return &rpc.Status{Code: rpc.Code_CODE_NOT_FOUND}
+}
+
+// revision returns the version a reference of a version refers to, 0 for
+// other references.
+func (g *fakeGateway) revision(ref *provider.Reference) int {
+	var v int
+
+	if _, err := fmt.Sscanf(ref.GetId().GetOpaqueId(), testOpaqueID+".REV.v%d", &v); err != nil {
+		return 0
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if v < 1 || v > len(g.history) {
+		return 0
+	}
+
+	return v
+}
+
+func (g *fakeGateway) Stat(ctx context.Context, req *provider.StatRequest) (*provider.StatResponse, error) {
+	status := g.lookup(ctx, req.Ref)
+
+	if status.Code != rpc.Code_CODE_OK {
+		return &provider.StatResponse{Status: status}, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return &provider.StatResponse{
+		Status: status,
+		Info: &provider.ResourceInfo{
+			Id:       &provider.ResourceId{StorageId: testStorage, OpaqueId: testOpaqueID},
+			Path:     testPath,
+			Size:     uint64(len(g.content)),
+			Etag:     fmt.Sprintf(`"%d"`, g.version),
+			MimeType: "application/x-ipynb+json",
+			Mtime:    &types.Timestamp{Seconds: 1600000000},
+		},
+	}, nil
+}
+
+func (g *fakeGateway) InitiateFileDownload(ctx context.Context, req *provider.InitiateFileDownloadRequest) (*gateway.InitiateFileDownloadResponse, error) {
+	status := g.lookup(ctx, req.Ref)
+
+	if status.Code != rpc.Code_CODE_OK {
+		return &gateway.InitiateFileDownloadResponse{Status: status}, nil
+	}
+
+	endpoint := g.endpoint + "/data"
+
+	if v := g.revision(req.Ref); v > 0 {
+		endpoint = fmt.Sprintf("%s/data/v%d", g.endpoint, v)
+	}
+
+	return &gateway.InitiateFileDownloadResponse{
+		Status: status,
+		Protocols: []*gateway.FileDownloadProtocol{
+			{Protocol: "spaces", DownloadEndpoint: g.endpoint + "/spaces"},
+			{Protocol: simpleProtocol, DownloadEndpoint: endpoint, Token: "transfer-token"},
+		},
+	}, nil
+}
+
+func (g *fakeGateway) InitiateFileUpload(ctx context.Context, req *provider.InitiateFileUploadRequest) (*gateway.InitiateFileUploadResponse, error) {
+	status := g.lookup(ctx, req.Ref)
+
+	if status.Code != rpc.Code_CODE_OK {
+		return &gateway.InitiateFileUploadResponse{Status: status}, nil
+	}
+
+	return &gateway.InitiateFileUploadResponse{
+		Status: status,
+		Protocols: []*gateway.FileUploadProtocol{
+			{Protocol: simpleProtocol, UploadEndpoint: g.endpoint + "/data", Token: "transfer-token"},
+		},
+	}, nil
+}
+
+func (g *fakeGateway) ListFileVersions(ctx context.Context, req *provider.ListFileVersionsRequest) (*provider.ListFileVersionsResponse, error) {
+	status := g.lookup(ctx, req.Ref)
+
+	if status.Code != rpc.Code_CODE_OK {
+		return &provider.ListFileVersionsResponse{Status: status}, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	versions := []*provider.FileVersion{}
+
+	for v := 1; v < g.version; v++ {
+		versions = append(versions, &provider.FileVersion{
+			Key:   fmt.Sprintf("v%d", v),
+			Etag:  fmt.Sprintf(`"%d"`, v),
+			Mtime: uint64(1600000000 + v),
+		})
+	}
+
+	return &provider.ListFileVersionsResponse{Status: status, Versions: versions}, nil
+}
+
+// ServeHTTP implements the data gateway.
+func (g *fakeGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get(transferHeader) != "transfer-token" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var v int
+
+	if _, err := fmt.Sscanf(r.URL.Path, "/data/v%d", &v); err == nil && v >= 1 && v <= len(g.history) {
+		_, _ = w.Write([]byte(g.history[v-1]))
+		return
+	}
+
+	if r.URL.Path != "/data" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if r.Method == http.MethodPut {
+		b, _ := ioutil.ReadAll(r.Body)
+		g.history = append(g.history, g.content)
+		g.content = string(b)
+		g.version++
+		return
+	}
+
+	_, _ = w.Write([]byte(g.content))
+}
+
+// newFakeGateway starts the gateway and its data server.
+func newFakeGateway(t *testing.T) (string, func()) {
+	fake := &fakeGateway{
+		content: testContent,
+		version: 1,
+	}
+
+	data := httptest.NewServer(fake)
+	fake.endpoint = data.URL
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	server := grpc.NewServer()
+	gateway.RegisterGatewayAPIServer(server, fake)
+
+	go func() {
+		_ = server.Serve(l)
+	}()
+
+	return l.Addr().String(), func() {
+		server.Stop()
+		data.Close()
+	}
+}
+
+func TestCS3_Read(t *testing.T) {
+	addr, stop := newFakeGateway(t)
+	defer stop()
+
+	c := NewCS3(addr, nil)
+	ctx := ContextWithToken(context.Background(), testToken)
+
+	b, err := c.Read(ctx, Reference{Path: testPath})
+	assert.Nil(t, err)
+	assert.Equal(t, testContent, string(b))
+
+	b, err = c.Read(ctx, Reference{ResourceID: testStorage + ":" + testOpaqueID})
+	assert.Nil(t, err)
+	assert.Equal(t, testContent, string(b))
+
+	_, err = c.Read(ctx, Reference{Path: "/home/missing.ipynb"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = c.Read(ContextWithToken(context.Background(), "other"), Reference{Path: testPath})
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+
+	_, err = c.Read(context.Background(), Reference{Path: testPath})
+	assert.True(t, errors.Is(err, ErrMissingToken))
+
+	_, err = c.Read(ctx, Reference{ResourceID: "invalid"})
+	assert.True(t, errors.Is(err, ErrInvalidReference))
+}
+
+func TestCS3_Stat(t *testing.T) {
+	addr, stop := newFakeGateway(t)
+	defer stop()
+
+	c := NewCS3(addr, nil)
+	ctx := ContextWithToken(context.Background(), testToken)
+
+	info, err := c.Stat(ctx, Reference{ResourceID: "MTI4NGQyMzgtYWE5Mi00MmNlLWJkYzQtMGIwMDAwMDA5MTU3OjRjNTEwYWRhLWM4NmItNDgxNS04ODIwLTQyY2RmODJjM2Q1MQ=="})
+	assert.Nil(t, err)
+	assert.Equal(t, "analysis.ipynb", info.Name())
+	assert.Equal(t, testStorage+":"+testOpaqueID, info.ID)
+	assert.Equal(t, uint64(len(testContent)), info.Size)
+	assert.Equal(t, `"1"`, info.ETag)
+	assert.Equal(t, int64(1600000000), info.Modified.Unix())
+}
+
+func TestCS3_Write(t *testing.T) {
+	addr, stop := newFakeGateway(t)
+	defer stop()
+
+	c := NewCS3(addr, nil)
+	ctx := ContextWithToken(context.Background(), testToken)
+	ref := Reference{Path: testPath}
+
+	etag, err := c.Write(ctx, ref, []byte(testContent+"\n"), `"1"`)
+	assert.Nil(t, err)
+	assert.Equal(t, `"2"`, etag)
+
+	b, err := c.Read(ctx, ref)
+	assert.Nil(t, err)
+	assert.Equal(t, testContent+"\n", string(b))
+
+	_, err = c.Write(ctx, ref, []byte(testContent), `"1"`)
+	assert.True(t, errors.Is(err, ErrConflict))
+
+	var conflict *ConflictError
+
+	if assert.True(t, errors.As(err, &conflict)) {
+		assert.Equal(t, `"2"`, conflict.Current.ETag)
+	}
+
+	etag, err = c.Write(ctx, ref, []byte(testContent), "")
+	assert.Nil(t, err)
+	assert.Equal(t, `"3"`, etag)
+
+	versions, err := c.Versions(ctx, ref)
+	assert.Nil(t, err)
+
+	if assert.Len(t, versions, 2) {
+		assert.Equal(t, "v2", versions[0].Key)
+		assert.Equal(t, `"2"`, versions[0].ETag)
+		assert.Equal(t, "v1", versions[1].Key)
+	}
+
+	b, err = c.ReadVersion(ctx, ref, "v1")
+	assert.Nil(t, err)
+	assert.Equal(t, testContent, string(b))
+
+	b, err = c.ReadVersion(ctx, ref, "v2")
+	assert.Nil(t, err)
+	assert.Equal(t, testContent+"\n", string(b))
+
+	_, err = c.ReadVersion(ctx, ref, "v3")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = c.Write(context.Background(), ref, []byte(testContent), "")
+	assert.True(t, errors.Is(err, ErrMissingToken))
+}
+
+func TestCS3_WriteConcurrent(t *testing.T) {
+	addr, stop := newFakeGateway(t)
+	defer stop()
+
+	c := NewCS3(addr, nil)
+	ctx := ContextWithToken(context.Background(), testToken)
+
+	// Both saves start from the same version, by path and by id.
+	refs := []Reference{{Path: testPath}, {ResourceID: testStorage + ":" + testOpaqueID}}
+	errs := make(chan error, len(refs))
+
+	for i, ref := range refs {
+		go func(i int, ref Reference) {
+			_, err := c.Write(ctx, ref, []byte(fmt.Sprintf("%s%d", testContent, i)), `"1"`)
+			errs <- err
+		}(i, ref)
+	}
+
+	var written, conflicts int
+
+	for range refs {
+		err := <-errs
+
+		switch {
+		case err == nil:
+			written++
+		case errors.Is(err, ErrConflict):
+			conflicts++
+		}
+	}
+
+	assert.Equal(t, 1, written)
+	assert.Equal(t, 1, conflicts)
+
+	info, err := c.Stat(ctx, refs[0])
+	assert.Nil(t, err)
+	assert.Equal(t, `"2"`, info.ETag)
+}
+
+func TestParseResourceID(t *testing.T) {
+	storageID, opaqueID, err := ParseResourceID("a:b:c")
+	assert.Nil(t, err)
+	assert.Equal(t, "a", storageID)
+	assert.Equal(t, "b:c", opaqueID)
+
+	_, _, err = ParseResourceID("abc")
+	assert.Equal(t, ErrInvalidReference, err)
+}
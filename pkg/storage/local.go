@@ -0,0 +1,362 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Local reads and writes files in a directory of the local filesystem, e.g.
+// for development or single node installs without reva. Paths are relative
+// to the root directory, files outside of it are never accessed, neither by
+// traversing up nor by following symlinks.
+type Local struct {
+	root string
+
+	// mu serializes writes, so the ETag of a file can not change between
+	// comparing and replacing it.
+	mu sync.Mutex
+}
+
+// NewLocal initializes a new storage rooted at the given directory.
+func NewLocal(root string) *Local {
+	return &Local{
+		root: root,
+	}
+}
+
+// Stat returns the info of a file.
+func (l *Local) Stat(ctx context.Context, ref Reference) (*Info, error) {
+	name, err := l.resolve(ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Stat(name)
+
+	if err != nil {
+		return nil, osError(err, ref)
+	}
+
+	return newLocalInfo(cleanPath(ref.Path), fi), nil
+}
+
+// List returns the infos of the files in a folder. Symlinks pointing outside
+// of the root are left out.
+func (l *Local) List(ctx context.Context, ref Reference) ([]*Info, error) {
+	name, err := l.resolve(ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fis, err := ioutil.ReadDir(name)
+
+	if err != nil {
+		return nil, osError(err, ref)
+	}
+
+	infos := make([]*Info, 0, len(fis))
+
+	for _, fi := range fis {
+		child := Reference{Path: path.Join(cleanPath(ref.Path), fi.Name())}
+
+		if fi.Mode()&os.ModeSymlink != 0 {
+			target, err := l.resolve(child)
+
+			if err != nil {
+				continue
+			}
+
+			if fi, err = os.Stat(target); err != nil {
+				continue
+			}
+		}
+
+		infos = append(infos, newLocalInfo(child.Path, fi))
+	}
+
+	return infos, nil
+}
+
+// Read returns the content of a file.
+func (l *Local) Read(ctx context.Context, ref Reference) ([]byte, error) {
+	name, err := l.resolve(ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := ioutil.ReadFile(name)
+
+	if err != nil {
+		return nil, osError(err, ref)
+	}
+
+	return b, nil
+}
+
+// Write replaces the content of a file, it returns the new ETag. With an ETag
+// given the file is only replaced if it did not change in the meantime. The
+// content is written to a temporary file first, which replaces the file.
+func (l *Local) Write(ctx context.Context, ref Reference, content []byte, etag string) (string, error) {
+	name, err := l.resolve(ref)
+
+	if err != nil {
+		return "", err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if etag != "" {
+		current, err := l.Stat(ctx, ref)
+
+		if err != nil {
+			return "", err
+		}
+
+		if current.ETag != etag {
+			return "", &ConflictError{Ref: ref, Current: current}
+		}
+	}
+
+	mode := os.FileMode(0644)
+
+	if fi, err := os.Stat(name); err == nil {
+		if fi.IsDir() {
+			return "", fmt.Errorf("%w: %s is a folder", ErrInvalidReference, ref)
+		}
+
+		mode = fi.Mode().Perm()
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(name), "."+filepath.Base(name)+".*")
+
+	if err != nil {
+		return "", osError(err, ref)
+	}
+
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return "", err
+	}
+
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	if err := os.Chmod(f.Name(), mode); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(f.Name(), name); err != nil {
+		return "", osError(err, ref)
+	}
+
+	info, err := l.Stat(ctx, ref)
+
+	if err != nil {
+		return "", err
+	}
+
+	return info.ETag, nil
+}
+
+// MakeDir creates a folder.
+func (l *Local) MakeDir(ctx context.Context, ref Reference) error {
+	name, err := l.resolveLink(ref)
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.Mkdir(name, 0755); err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("%w: %s", ErrExists, ref)
+		}
+
+		return osError(err, ref)
+	}
+
+	return nil
+}
+
+// Delete removes a file or a folder with all files in it. Symlinks are
+// removed, not the files they point to.
+func (l *Local) Delete(ctx context.Context, ref Reference) error {
+	name, err := l.resolveLink(ref)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Lstat(name); err != nil {
+		return osError(err, ref)
+	}
+
+	return osError(os.RemoveAll(name), ref)
+}
+
+// Move renames a file or a folder.
+func (l *Local) Move(ctx context.Context, from, to Reference) error {
+	src, err := l.resolveLink(from)
+
+	if err != nil {
+		return err
+	}
+
+	dst, err := l.resolve(to)
+
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := os.Lstat(src); err != nil {
+		return osError(err, from)
+	}
+
+	if _, err := os.Lstat(dst); err == nil {
+		return fmt.Errorf("%w: %s", ErrExists, to)
+	}
+
+	if strings.HasPrefix(dst, src+string(filepath.Separator)) {
+		return fmt.Errorf("%w: %s is within %s", ErrInvalidReference, to, from)
+	}
+
+	return osError(os.Rename(src, dst), from)
+}
+
+// Versions is not supported by the local storage.
+func (l *Local) Versions(ctx context.Context, ref Reference) ([]*Version, error) {
+	return nil, ErrNotSupported
+}
+
+// ReadVersion is not supported by the local storage.
+func (l *Local) ReadVersion(ctx context.Context, ref Reference, key string) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// resolveLink returns the name of a file within the root like resolve, but a
+// symlink is not followed, so it is removed or moved itself. The root can
+// neither be removed nor moved.
+func (l *Local) resolveLink(ref Reference) (string, error) {
+	if cleanPath(ref.Path) == "/" {
+		return "", fmt.Errorf("%w: the root can not be changed", ErrInvalidReference)
+	}
+
+	dir, err := l.resolve(Reference{Path: path.Dir(cleanPath(ref.Path))})
+
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, path.Base(cleanPath(ref.Path))), nil
+}
+
+// resolve returns the name of a file within the root. Paths leaving the root,
+// directly or through symlinks, are rejected. Files which do not exist yet
+// are resolved through their folder.
+func (l *Local) resolve(ref Reference) (string, error) {
+	if ref.Path == "" {
+		return "", fmt.Errorf("%w: resource ids are not supported by the local storage", ErrInvalidReference)
+	}
+
+	for _, segment := range strings.Split(filepath.ToSlash(ref.Path), "/") {
+		if segment == ".." {
+			return "", fmt.Errorf("%w: %s leaves the storage root", ErrPermissionDenied, ref)
+		}
+	}
+
+	root, err := filepath.Abs(l.root)
+
+	if err != nil {
+		return "", err
+	}
+
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		return "", fmt.Errorf("storage root: %w", err)
+	}
+
+	name := filepath.Join(root, filepath.FromSlash(cleanPath(ref.Path)))
+	resolved, err := filepath.EvalSymlinks(name)
+
+	if os.IsNotExist(err) {
+		var dir string
+
+		if dir, err = filepath.EvalSymlinks(filepath.Dir(name)); err == nil {
+			resolved = filepath.Join(dir, filepath.Base(name))
+		}
+	}
+
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, ref)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if resolved != root && !strings.HasPrefix(resolved, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %s leaves the storage root", ErrPermissionDenied, ref)
+	}
+
+	return resolved, nil
+}
+
+// osError converts errors of the filesystem.
+func osError(err error, ref Reference) error {
+	switch {
+	case os.IsNotExist(err):
+		return fmt.Errorf("%w: %s", ErrNotFound, ref)
+	case os.IsPermission(err):
+		return fmt.Errorf("%w: %s", ErrPermissionDenied, ref)
+	}
+
+	return err
+}
+
+// newLocalInfo returns the info of a file. Like Apache the ETag is made of
+// the modification time and the size.
+func newLocalInfo(p string, fi os.FileInfo) *Info {
+	info := &Info{
+		Path:     p,
+		ETag:     fmt.Sprintf(`"%x%x"`, fi.ModTime().UnixNano(), fi.Size()),
+		Modified: fi.ModTime().UTC(),
+		IsDir:    fi.IsDir(),
+	}
+
+	if !info.IsDir {
+		info.Size = uint64(fi.Size())
+		info.MimeType = mimeType(fi.Name())
+	}
+
+	return info
+}
+
+// mimeType returns the mime type of a file by its extension.
+func mimeType(name string) string {
+	ext := path.Ext(name)
+
+	if ext == ".ipynb" {
+		return "application/x-ipynb+json"
+	}
+
+	if t := mime.TypeByExtension(ext); t != "" {
+		return t
+	}
+
+	return "application/octet-stream"
+}
@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newLocal returns a storage rooted at a temporary directory with a notebook
+// and a symlink to a file outside of the root.
+func newLocal(t *testing.T) (*Local, func()) {
+	dir, err := ioutil.TempDir("", "ocis-jupyter")
+	assert.Nil(t, err)
+
+	root := filepath.Join(dir, "root")
+
+	assert.Nil(t, os.MkdirAll(filepath.Join(root, "Notebooks"), 0700))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(root, "Notebooks", "analysis.ipynb"), []byte(testContent), 0600))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "secret.txt"), []byte("secret"), 0600))
+	assert.Nil(t, os.Symlink(filepath.Join(dir, "secret.txt"), filepath.Join(root, "Notebooks", "secret.txt")))
+	assert.Nil(t, os.Symlink(filepath.Join(root, "Notebooks"), filepath.Join(root, "Shared")))
+
+	return NewLocal(root), func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func TestLocal(t *testing.T) {
+	l, cleanup := newLocal(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ref := Reference{Path: "/Notebooks/analysis.ipynb"}
+
+	b, err := l.Read(ctx, ref)
+	assert.Nil(t, err)
+	assert.Equal(t, testContent, string(b))
+
+	info, err := l.Stat(ctx, ref)
+	assert.Nil(t, err)
+	assert.Equal(t, "/Notebooks/analysis.ipynb", info.Path)
+	assert.Equal(t, uint64(len(testContent)), info.Size)
+	assert.Equal(t, "application/x-ipynb+json", info.MimeType)
+	assert.NotEmpty(t, info.ETag)
+	assert.False(t, info.IsDir)
+
+	b, err = l.Read(ctx, Reference{Path: "/Shared/analysis.ipynb"})
+	assert.Nil(t, err)
+	assert.Equal(t, testContent, string(b))
+
+	etag, err := l.Write(ctx, Reference{Path: "Notebooks/copy.ipynb"}, []byte(testContent), "")
+	assert.Nil(t, err)
+	assert.NotEmpty(t, etag)
+
+	infos, err := l.List(ctx, Reference{Path: "/Notebooks"})
+	assert.Nil(t, err)
+
+	if assert.Len(t, infos, 2) {
+		assert.Equal(t, "/Notebooks/analysis.ipynb", infos[0].Path)
+		assert.Equal(t, "/Notebooks/copy.ipynb", infos[1].Path)
+	}
+
+	infos, err = l.List(ctx, Reference{Path: "/"})
+	assert.Nil(t, err)
+
+	if assert.Len(t, infos, 2) {
+		assert.Equal(t, "/Notebooks", infos[0].Path)
+		assert.True(t, infos[0].IsDir)
+		assert.Equal(t, "/Shared", infos[1].Path)
+		assert.True(t, infos[1].IsDir)
+	}
+
+	err = l.Move(ctx, Reference{Path: "/Notebooks/copy.ipynb"}, ref)
+	assert.True(t, errors.Is(err, ErrExists))
+
+	err = l.Move(ctx, Reference{Path: "/Notebooks/copy.ipynb"}, Reference{Path: "/moved.ipynb"})
+	assert.Nil(t, err)
+
+	err = l.Delete(ctx, Reference{Path: "/moved.ipynb"})
+	assert.Nil(t, err)
+
+	err = l.Delete(ctx, Reference{Path: "/moved.ipynb"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	err = l.Delete(ctx, Reference{Path: "/"})
+	assert.True(t, errors.Is(err, ErrInvalidReference))
+
+	err = l.MakeDir(ctx, Reference{Path: "/Archive"})
+	assert.Nil(t, err)
+
+	info, err = l.Stat(ctx, Reference{Path: "/Archive"})
+	assert.Nil(t, err)
+	assert.True(t, info.IsDir)
+
+	err = l.MakeDir(ctx, Reference{Path: "/Archive"})
+	assert.True(t, errors.Is(err, ErrExists))
+
+	err = l.MakeDir(ctx, Reference{Path: "/Missing/Archive"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = l.Versions(ctx, ref)
+	assert.Equal(t, ErrNotSupported, err)
+
+	_, err = l.ReadVersion(ctx, ref, "1")
+	assert.Equal(t, ErrNotSupported, err)
+
+	_, err = l.Write(ctx, Reference{Path: "/Missing/copy.ipynb"}, []byte(testContent), "")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = l.Read(ctx, Reference{Path: "/missing.ipynb"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = l.Read(ctx, Reference{ResourceID: "a:b"})
+	assert.True(t, errors.Is(err, ErrInvalidReference))
+}
+
+func TestLocal_Write(t *testing.T) {
+	l, cleanup := newLocal(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ref := Reference{Path: "/Notebooks/analysis.ipynb"}
+
+	info, err := l.Stat(ctx, ref)
+	assert.Nil(t, err)
+
+	etag, err := l.Write(ctx, ref, []byte(testContent+"\n"), info.ETag)
+	assert.Nil(t, err)
+	assert.NotEqual(t, info.ETag, etag)
+
+	_, err = l.Write(ctx, ref, []byte(testContent), info.ETag)
+	assert.True(t, errors.Is(err, ErrConflict))
+
+	var conflict *ConflictError
+
+	if assert.True(t, errors.As(err, &conflict)) {
+		assert.Equal(t, etag, conflict.Current.ETag)
+	}
+
+	b, err := l.Read(ctx, ref)
+	assert.Nil(t, err)
+	assert.Equal(t, testContent+"\n", string(b))
+
+	_, err = l.Write(ctx, Reference{Path: "/Notebooks"}, []byte(testContent), "")
+	assert.True(t, errors.Is(err, ErrInvalidReference))
+}
+
+func TestLocal_OutsideRoot(t *testing.T) {
+	l, cleanup := newLocal(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := l.Read(ctx, Reference{Path: "/Notebooks/secret.txt"})
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+
+	_, err = l.Read(ctx, Reference{Path: "/../secret.txt"})
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+
+	_, err = l.Read(ctx, Reference{Path: "Notebooks/../../secret.txt"})
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+
+	_, err = l.Write(ctx, Reference{Path: "/Notebooks/secret.txt"}, []byte("changed"), "")
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+
+	infos, err := l.List(ctx, Reference{Path: "/Notebooks"})
+	assert.Nil(t, err)
+	assert.Len(t, infos, 1)
+
+	err = l.Delete(ctx, Reference{Path: "/Notebooks/secret.txt"})
+	assert.Nil(t, err)
+
+	_, err = os.Stat(filepath.Join(filepath.Dir(l.root), "secret.txt"))
+	assert.Nil(t, err)
+}
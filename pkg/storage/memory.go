@@ -0,0 +1,416 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Memory keeps files in memory, e.g. for tests or demos. Folders exist if
+// they were created or contain files. Every write keeps the replaced content
+// as version.
+type Memory struct {
+	mu    sync.RWMutex
+	files map[string]*memoryFile
+	dirs  map[string]bool
+	seq   int
+}
+
+// memoryFile is a file of the memory storage.
+type memoryFile struct {
+	content  []byte
+	modified time.Time
+	seq      int
+	versions []*memoryVersion
+}
+
+// memoryVersion is an earlier version of a file.
+type memoryVersion struct {
+	content  []byte
+	modified time.Time
+	seq      int
+}
+
+// NewMemory initializes a new empty storage.
+func NewMemory() *Memory {
+	return &Memory{
+		files: map[string]*memoryFile{},
+		dirs:  map[string]bool{},
+	}
+}
+
+// Stat returns the info of a file.
+func (m *Memory) Stat(ctx context.Context, ref Reference) (*Info, error) {
+	p, err := memoryPath(ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if f, ok := m.files[p]; ok {
+		return f.info(p), nil
+	}
+
+	if m.isDir(p) {
+		return &Info{Path: p, IsDir: true}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+}
+
+// Read returns the content of a file.
+func (m *Memory) Read(ctx context.Context, ref Reference) ([]byte, error) {
+	p, err := memoryPath(ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	f, ok := m.files[p]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	}
+
+	return append([]byte(nil), f.content...), nil
+}
+
+// Write replaces the content of a file, it returns the new ETag. With an ETag
+// given the file is only replaced if it did not change in the meantime.
+func (m *Memory) Write(ctx context.Context, ref Reference, content []byte, etag string) (string, error) {
+	p, err := memoryPath(ref)
+
+	if err != nil {
+		return "", err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, exists := m.files[p]
+
+	if !exists && m.isDir(p) {
+		return "", fmt.Errorf("%w: %s is a folder", ErrInvalidReference, ref)
+	}
+
+	if etag != "" {
+		if !exists {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, ref)
+		}
+
+		if current := f.info(p); current.ETag != etag {
+			return "", &ConflictError{Ref: ref, Current: current}
+		}
+	}
+
+	m.seq++
+
+	next := &memoryFile{
+		content:  append([]byte(nil), content...),
+		modified: time.Now().UTC(),
+		seq:      m.seq,
+	}
+
+	if exists {
+		next.versions = append([]*memoryVersion{{
+			content:  f.content,
+			modified: f.modified,
+			seq:      f.seq,
+		}}, f.versions...)
+	}
+
+	m.files[p] = next
+	return next.info(p).ETag, nil
+}
+
+// List returns the infos of the files and folders in a folder.
+func (m *Memory) List(ctx context.Context, ref Reference) ([]*Info, error) {
+	p, err := memoryPath(ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if !m.isDir(p) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	}
+
+	prefix := strings.TrimSuffix(p, "/") + "/"
+	folders := map[string]bool{}
+	infos := []*Info{}
+
+	for name, f := range m.files {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		rel := strings.TrimPrefix(name, prefix)
+
+		if i := strings.Index(rel, "/"); i >= 0 {
+			folders[path.Join(p, rel[:i])] = true
+			continue
+		}
+
+		infos = append(infos, f.info(name))
+	}
+
+	for dir := range m.dirs {
+		if !strings.HasPrefix(dir, prefix) {
+			continue
+		}
+
+		rel := strings.TrimPrefix(dir, prefix)
+
+		if i := strings.Index(rel, "/"); i >= 0 {
+			rel = rel[:i]
+		}
+
+		folders[path.Join(p, rel)] = true
+	}
+
+	for folder := range folders {
+		infos = append(infos, &Info{Path: folder, IsDir: true})
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Path < infos[j].Path
+	})
+
+	return infos, nil
+}
+
+// MakeDir creates a folder.
+func (m *Memory) MakeDir(ctx context.Context, ref Reference) error {
+	p, err := memoryPath(ref)
+
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.files[p]; ok || m.isDir(p) {
+		return fmt.Errorf("%w: %s", ErrExists, ref)
+	}
+
+	m.dirs[p] = true
+	return nil
+}
+
+// Delete removes a file or a folder with all files in it.
+func (m *Memory) Delete(ctx context.Context, ref Reference) error {
+	p, err := memoryPath(ref)
+
+	if err != nil {
+		return err
+	}
+
+	if p == "/" {
+		return fmt.Errorf("%w: the root can not be deleted", ErrInvalidReference)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names, dirs := m.match(p)
+
+	if len(names) == 0 && len(dirs) == 0 {
+		return fmt.Errorf("%w: %s", ErrNotFound, ref)
+	}
+
+	for _, name := range names {
+		delete(m.files, name)
+	}
+
+	for _, dir := range dirs {
+		delete(m.dirs, dir)
+	}
+
+	return nil
+}
+
+// Move renames a file or a folder with all files in it.
+func (m *Memory) Move(ctx context.Context, from, to Reference) error {
+	src, err := memoryPath(from)
+
+	if err != nil {
+		return err
+	}
+
+	dst, err := memoryPath(to)
+
+	if err != nil {
+		return err
+	}
+
+	if src == "/" || dst == "/" {
+		return fmt.Errorf("%w: the root can not be moved", ErrInvalidReference)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names, dirs := m.match(src)
+
+	if len(names) == 0 && len(dirs) == 0 {
+		return fmt.Errorf("%w: %s", ErrNotFound, from)
+	}
+
+	if _, ok := m.files[dst]; ok || m.isDir(dst) {
+		return fmt.Errorf("%w: %s", ErrExists, to)
+	}
+
+	if strings.HasPrefix(dst, src+"/") {
+		return fmt.Errorf("%w: %s is within %s", ErrInvalidReference, to, from)
+	}
+
+	for _, name := range names {
+		m.files[dst+strings.TrimPrefix(name, src)] = m.files[name]
+		delete(m.files, name)
+	}
+
+	for _, dir := range dirs {
+		m.dirs[dst+strings.TrimPrefix(dir, src)] = true
+		delete(m.dirs, dir)
+	}
+
+	return nil
+}
+
+// Versions returns the earlier versions of a file, the latest first.
+func (m *Memory) Versions(ctx context.Context, ref Reference) ([]*Version, error) {
+	p, err := memoryPath(ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	f, ok := m.files[p]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	}
+
+	versions := make([]*Version, 0, len(f.versions))
+
+	for _, v := range f.versions {
+		versions = append(versions, &Version{
+			Key:      strconv.Itoa(v.seq),
+			Size:     uint64(len(v.content)),
+			ETag:     memoryETag(v.seq),
+			Modified: v.modified,
+		})
+	}
+
+	return versions, nil
+}
+
+// ReadVersion returns the content of an earlier version of a file.
+func (m *Memory) ReadVersion(ctx context.Context, ref Reference, key string) ([]byte, error) {
+	p, err := memoryPath(ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	f, ok := m.files[p]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	}
+
+	for _, v := range f.versions {
+		if strconv.Itoa(v.seq) == key {
+			return append([]byte(nil), v.content...), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: version %s of %s", ErrNotFound, key, ref)
+}
+
+// isDir returns true if the folder was created or files are stored within.
+func (m *Memory) isDir(p string) bool {
+	if p == "/" || m.dirs[p] {
+		return true
+	}
+
+	for name := range m.files {
+		if strings.HasPrefix(name, p+"/") {
+			return true
+		}
+	}
+
+	for dir := range m.dirs {
+		if strings.HasPrefix(dir, p+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// match returns the names of the file or of the files and folders within
+// the folder.
+func (m *Memory) match(p string) ([]string, []string) {
+	names, dirs := []string{}, []string{}
+
+	for name := range m.files {
+		if name == p || strings.HasPrefix(name, p+"/") {
+			names = append(names, name)
+		}
+	}
+
+	for dir := range m.dirs {
+		if dir == p || strings.HasPrefix(dir, p+"/") {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return names, dirs
+}
+
+// info returns the info of the file.
+func (f *memoryFile) info(p string) *Info {
+	return &Info{
+		Path:     p,
+		Size:     uint64(len(f.content)),
+		ETag:     memoryETag(f.seq),
+		MimeType: mimeType(p),
+		Modified: f.modified,
+	}
+}
+
+// memoryETag returns the ETag of a write.
+func memoryETag(seq int) string {
+	return `"` + strconv.Itoa(seq) + `"`
+}
+
+// memoryPath returns the clean path of a file.
+func memoryPath(ref Reference) (string, error) {
+	if ref.Path == "" {
+		return "", fmt.Errorf("%w: resource ids are not supported by the memory storage", ErrInvalidReference)
+	}
+
+	return cleanPath(ref.Path), nil
+}
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	m := NewMemory()
+	ctx := context.Background()
+	ref := Reference{Path: "/Notebooks/analysis.ipynb"}
+
+	etag, err := m.Write(ctx, ref, []byte(testContent), "")
+	assert.Nil(t, err)
+
+	b, err := m.Read(ctx, ref)
+	assert.Nil(t, err)
+	assert.Equal(t, testContent, string(b))
+
+	info, err := m.Stat(ctx, ref)
+	assert.Nil(t, err)
+	assert.Equal(t, etag, info.ETag)
+	assert.Equal(t, uint64(len(testContent)), info.Size)
+	assert.Equal(t, "application/x-ipynb+json", info.MimeType)
+
+	info, err = m.Stat(ctx, Reference{Path: "/Notebooks"})
+	assert.Nil(t, err)
+	assert.True(t, info.IsDir)
+
+	_, err = m.Write(ctx, Reference{Path: "/Notebooks/Old/legacy.ipynb"}, []byte(testContent), "")
+	assert.Nil(t, err)
+
+	infos, err := m.List(ctx, Reference{Path: "/Notebooks/"})
+	assert.Nil(t, err)
+
+	if assert.Len(t, infos, 2) {
+		assert.Equal(t, "/Notebooks/Old", infos[0].Path)
+		assert.True(t, infos[0].IsDir)
+		assert.Equal(t, "/Notebooks/analysis.ipynb", infos[1].Path)
+	}
+
+	err = m.Move(ctx, Reference{Path: "/Notebooks/Old"}, Reference{Path: "/Archive"})
+	assert.Nil(t, err)
+
+	_, err = m.Read(ctx, Reference{Path: "/Archive/legacy.ipynb"})
+	assert.Nil(t, err)
+
+	err = m.Move(ctx, Reference{Path: "/Archive/legacy.ipynb"}, ref)
+	assert.True(t, errors.Is(err, ErrExists))
+
+	err = m.Delete(ctx, Reference{Path: "/Archive"})
+	assert.Nil(t, err)
+
+	_, err = m.Stat(ctx, Reference{Path: "/Archive"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	err = m.Delete(ctx, Reference{Path: "/Archive"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	err = m.MakeDir(ctx, Reference{Path: "/Archive/2020"})
+	assert.Nil(t, err)
+
+	err = m.MakeDir(ctx, Reference{Path: "/Archive/2020"})
+	assert.True(t, errors.Is(err, ErrExists))
+
+	infos, err = m.List(ctx, Reference{Path: "/Archive"})
+	assert.Nil(t, err)
+
+	if assert.Len(t, infos, 1) {
+		assert.Equal(t, "/Archive/2020", infos[0].Path)
+		assert.True(t, infos[0].IsDir)
+	}
+
+	err = m.Move(ctx, Reference{Path: "/Archive"}, Reference{Path: "/Attic"})
+	assert.Nil(t, err)
+
+	info, err = m.Stat(ctx, Reference{Path: "/Attic/2020"})
+	assert.Nil(t, err)
+	assert.True(t, info.IsDir)
+
+	err = m.Delete(ctx, Reference{Path: "/Attic"})
+	assert.Nil(t, err)
+
+	_, err = m.Stat(ctx, Reference{Path: "/Attic/2020"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = m.Read(ctx, Reference{ResourceID: "a:b"})
+	assert.True(t, errors.Is(err, ErrInvalidReference))
+}
+
+func TestMemory_Versions(t *testing.T) {
+	m := NewMemory()
+	ctx := context.Background()
+	ref := Reference{Path: "/analysis.ipynb"}
+
+	first, err := m.Write(ctx, ref, []byte("1"), "")
+	assert.Nil(t, err)
+
+	second, err := m.Write(ctx, ref, []byte("22"), first)
+	assert.Nil(t, err)
+
+	_, err = m.Write(ctx, ref, []byte("333"), first)
+	assert.True(t, errors.Is(err, ErrConflict))
+
+	var conflict *ConflictError
+
+	if assert.True(t, errors.As(err, &conflict)) {
+		assert.Equal(t, second, conflict.Current.ETag)
+	}
+
+	_, err = m.Write(ctx, ref, []byte("333"), second)
+	assert.Nil(t, err)
+
+	versions, err := m.Versions(ctx, ref)
+	assert.Nil(t, err)
+
+	if assert.Len(t, versions, 2) {
+		assert.Equal(t, second, versions[0].ETag)
+		assert.Equal(t, uint64(2), versions[0].Size)
+		assert.Equal(t, first, versions[1].ETag)
+
+		b, err := m.ReadVersion(ctx, ref, versions[1].Key)
+		assert.Nil(t, err)
+		assert.Equal(t, "1", string(b))
+	}
+
+	_, err = m.ReadVersion(ctx, ref, "42")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
+)
+
+// Factory initializes a driver from the configuration. It returns neither a
+// driver nor an error if the driver is not configured, stored notebooks are
+// not available then.
+type Factory func(cfg *config.Config) (Driver, error)
+
+// builtinDrivers defines the factories of the drivers of this package.
+var builtinDrivers = map[string]Factory{
+	DriverCS3: func(cfg *config.Config) (Driver, error) {
+		if cfg.Reva.Address == "" {
+			return nil, nil
+		}
+
+		return NewCS3(cfg.Reva.Address, nil), nil
+	},
+	DriverWebDAV: func(cfg *config.Config) (Driver, error) {
+		if cfg.Storage.WebDAV.URL == "" {
+			return nil, nil
+		}
+
+		client := http.DefaultClient
+
+		if cfg.Storage.WebDAV.Insecure {
+			client = &http.Client{
+				Transport: &http.Transport{
+					TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+				},
+			}
+		}
+
+		return NewWebDAV(cfg.Storage.WebDAV.URL, client), nil
+	},
+	DriverLocal: func(cfg *config.Config) (Driver, error) {
+		if cfg.Storage.Local.Root == "" {
+			return nil, nil
+		}
+
+		return NewLocal(cfg.Storage.Local.Root), nil
+	},
+	DriverMemory: func(cfg *config.Config) (Driver, error) {
+		return NewMemory(), nil
+	},
+}
+
+// Registry maps the names of drivers to their factories.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// NewRegistry returns a registry with the built-in drivers.
+func NewRegistry() *Registry {
+	r := &Registry{
+		factories: map[string]Factory{},
+	}
+
+	for name, f := range builtinDrivers {
+		r.Register(name, f)
+	}
+
+	return r
+}
+
+// Register adds the factory of a driver, replacing an existing one.
+func (r *Registry) Register(name string, f Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.factories[name] = f
+}
+
+// Names returns the names of the registered drivers.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.factories))
+
+	for name := range r.factories {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// New initializes the driver of the given name.
+func (r *Registry) New(name string, cfg *config.Config) (Driver, error) {
+	r.mu.RLock()
+	f, ok := r.factories[name]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownDriver, name)
+	}
+
+	return f(cfg)
+}
+
+// DefaultRegistry is used to initialize the configured driver.
+var DefaultRegistry = NewRegistry()
+
+// Register adds the factory of a driver to the default registry, e.g. from
+// an init function of the package implementing it.
+func Register(name string, f Factory) {
+	DefaultRegistry.Register(name, f)
+}
+
+// New initializes the driver configured by Storage.Driver from the default
+// registry.
+func New(cfg *config.Config) (Driver, error) {
+	return DefaultRegistry.New(cfg.Storage.Driver, cfg)
+}
@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/config"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	assert.Equal(t, []string{DriverCS3, DriverLocal, DriverMemory, DriverWebDAV}, r.Names())
+
+	cfg := config.New()
+
+	d, err := r.New(DriverMemory, cfg)
+	assert.Nil(t, err)
+	assert.IsType(t, &Memory{}, d)
+
+	d, err = r.New(DriverLocal, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, d)
+
+	cfg.Storage.Local.Root = "/tmp"
+
+	d, err = r.New(DriverLocal, cfg)
+	assert.Nil(t, err)
+	assert.IsType(t, &Local{}, d)
+
+	_, err = r.New("s3", cfg)
+	assert.True(t, errors.Is(err, ErrUnknownDriver))
+
+	memory := NewMemory()
+	r.Register("s3", func(cfg *config.Config) (Driver, error) {
+		return memory, nil
+	})
+
+	d, err = r.New("s3", cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, memory, d)
+}
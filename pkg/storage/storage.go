@@ -0,0 +1,224 @@
+// Package storage reads and writes notebooks in the storage of oCIS. Files
+// are accessed on behalf of the user of a request, whose reva token or bearer
+// token is passed on to the storage.
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/v2/metadata"
+)
+
+const (
+	// DriverCS3 is the name of the storage driver for the CS3 gateway.
+	DriverCS3 = "cs3"
+
+	// DriverWebDAV is the name of the storage driver for WebDAV endpoints.
+	DriverWebDAV = "webdav"
+
+	// DriverLocal is the name of the storage driver for local directories.
+	DriverLocal = "local"
+
+	// DriverMemory is the name of the storage driver keeping files in memory.
+	DriverMemory = "memory"
+
+	// TokenHeader is the header and metadata key of the reva access token.
+	TokenHeader = "x-access-token"
+
+	// AuthorizationHeader is the header and metadata key of bearer tokens.
+	AuthorizationHeader = "Authorization"
+)
+
+var (
+	// ErrNotFound defines the error if a file does not exist.
+	ErrNotFound = errors.New("file not found")
+
+	// ErrPermissionDenied defines the error if the user may not access a file.
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrMissingToken defines the error if a request carries no access token.
+	ErrMissingToken = errors.New("missing access token")
+
+	// ErrInvalidReference defines the error if a file is neither referenced
+	// by path nor by a valid resource id.
+	ErrInvalidReference = errors.New("invalid file reference")
+
+	// ErrConflict defines the error if a file changed since it was read.
+	ErrConflict = errors.New("file changed in the meantime")
+
+	// ErrExists defines the error if a file is moved onto an existing one.
+	ErrExists = errors.New("file already exists")
+
+	// ErrNotSupported defines the error if a driver lacks an operation.
+	ErrNotSupported = errors.New("not supported by the storage driver")
+
+	// ErrUnknownDriver defines the error if no driver is registered by a name.
+	ErrUnknownDriver = errors.New("unknown storage driver")
+)
+
+// Driver gives access to stored notebooks. The service only uses this
+// interface, so it does not depend on the storage in use.
+type Driver interface {
+	// Stat returns the info of a file.
+	Stat(ctx context.Context, ref Reference) (*Info, error)
+
+	// Read returns the content of a file.
+	Read(ctx context.Context, ref Reference) ([]byte, error)
+
+	// Write replaces the content of a file and returns its new ETag. With an
+	// ETag given the file is only replaced if it still has this ETag,
+	// otherwise a ConflictError is returned. Storages without conditional
+	// uploads, like the CS3 gateway, compare the ETag before the upload and
+	// only serialize the writes of this service, so a write of another
+	// client between the comparison and the upload is overwritten.
+	Write(ctx context.Context, ref Reference, content []byte, etag string) (string, error)
+
+	// List returns the infos of the files in a folder.
+	List(ctx context.Context, ref Reference) ([]*Info, error)
+
+	// MakeDir creates a folder, it fails with ErrExists if the file exists.
+	MakeDir(ctx context.Context, ref Reference) error
+
+	// Delete removes a file.
+	Delete(ctx context.Context, ref Reference) error
+
+	// Move renames a file, it fails with ErrExists if the target exists.
+	Move(ctx context.Context, from, to Reference) error
+
+	// Versions returns the earlier versions of a file, the latest first.
+	Versions(ctx context.Context, ref Reference) ([]*Version, error)
+
+	// ReadVersion returns the content of an earlier version of a file.
+	ReadVersion(ctx context.Context, ref Reference, key string) ([]byte, error)
+}
+
+// ConflictError defines the error if a file is written based on a version
+// which is outdated. It carries the current version of the file.
+type ConflictError struct {
+	Ref     Reference
+	Current *Info
+}
+
+// Error implements the error interface.
+func (e *ConflictError) Error() string {
+	if e.Current == nil {
+		return fmt.Sprintf("%s: %s", ErrConflict, e.Ref)
+	}
+
+	return fmt.Sprintf("%s: %s, current etag %s", ErrConflict, e.Ref, e.Current.ETag)
+}
+
+// Unwrap returns ErrConflict, so the error matches it with errors.Is.
+func (e *ConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+// Reference identifies a file either by its path or by its resource id.
+type Reference struct {
+	Path string
+
+	// ResourceID is given as "<storage id>:<opaque id>", optionally base64
+	// encoded like the file ids of the ocis-web.
+	ResourceID string
+}
+
+// IsEmpty returns true if the reference identifies no file.
+func (r Reference) IsEmpty() bool {
+	return r.Path == "" && r.ResourceID == ""
+}
+
+// String returns the path or the resource id of the reference.
+func (r Reference) String() string {
+	if r.Path != "" {
+		return r.Path
+	}
+
+	return r.ResourceID
+}
+
+// Info describes a stored file.
+type Info struct {
+	ID       string
+	Path     string
+	Size     uint64
+	ETag     string
+	MimeType string
+	Modified time.Time
+	IsDir    bool
+}
+
+// Name returns the base name of the file.
+func (i *Info) Name() string {
+	return path.Base(i.Path)
+}
+
+// Version describes an earlier version of a file.
+type Version struct {
+	Key      string
+	Size     uint64
+	ETag     string
+	Modified time.Time
+}
+
+// ParseResourceID splits a resource id into the storage and opaque id.
+func ParseResourceID(id string) (string, string, error) {
+	for _, enc := range []*base64.Encoding{base64.URLEncoding, base64.StdEncoding, base64.RawURLEncoding, base64.RawStdEncoding} {
+		if b, err := enc.DecodeString(id); err == nil && strings.Contains(string(b), ":") {
+			id = string(b)
+			break
+		}
+	}
+
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", ErrInvalidReference
+	}
+
+	return parts[0], parts[1], nil
+}
+
+type (
+	tokenKey         struct{}
+	authorizationKey struct{}
+)
+
+// ContextWithToken returns a context carrying the access token of a user.
+func ContextWithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// TokenFromContext returns the access token of the user of a request. It is
+// either set with ContextWithToken or sent as metadata of a gRPC request.
+func TokenFromContext(ctx context.Context) (string, bool) {
+	if token, ok := ctx.Value(tokenKey{}).(string); ok && token != "" {
+		return token, true
+	}
+
+	token, ok := metadata.Get(ctx, TokenHeader)
+	return token, ok && token != ""
+}
+
+// ContextWithAuthorization returns a context carrying the authorization
+// header of a request, e.g. a bearer token.
+func ContextWithAuthorization(ctx context.Context, authorization string) context.Context {
+	return context.WithValue(ctx, authorizationKey{}, authorization)
+}
+
+// AuthorizationFromContext returns the authorization of the user of a
+// request. It is either set with ContextWithAuthorization or sent as metadata
+// of a gRPC request.
+func AuthorizationFromContext(ctx context.Context) (string, bool) {
+	if authorization, ok := ctx.Value(authorizationKey{}).(string); ok && authorization != "" {
+		return authorization, true
+	}
+
+	authorization, ok := metadata.Get(ctx, strings.ToLower(AuthorizationHeader))
+	return authorization, ok && authorization != ""
+}
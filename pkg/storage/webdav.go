@@ -0,0 +1,416 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// propfindBody requests the properties the info of a file is made of.
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:" xmlns:oc="http://owncloud.org/ns">
+  <d:prop>
+    <d:resourcetype/>
+    <d:getcontentlength/>
+    <d:getcontenttype/>
+    <d:getetag/>
+    <d:getlastmodified/>
+    <oc:fileid/>
+  </d:prop>
+</d:propfind>`
+
+// WebDAV reads and writes files through the WebDAV endpoint of oCIS, e.g.
+// https://localhost:9200/remote.php/dav/files. Paths are relative to the
+// endpoint, so they start with the name of the user.
+type WebDAV struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewWebDAV initializes a new client for the given endpoint.
+func NewWebDAV(endpoint string, client *http.Client) *WebDAV {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &WebDAV{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   client,
+	}
+}
+
+// Stat returns the info of a file.
+func (w *WebDAV) Stat(ctx context.Context, ref Reference) (*Info, error) {
+	infos, err := w.propfind(ctx, ref, "0")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(infos) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	}
+
+	return infos[0], nil
+}
+
+// List returns the infos of the files in a folder.
+func (w *WebDAV) List(ctx context.Context, ref Reference) ([]*Info, error) {
+	infos, err := w.propfind(ctx, ref, "1")
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*Info, 0, len(infos))
+
+	for _, info := range infos {
+		if info.Path != cleanPath(ref.Path) {
+			res = append(res, info)
+		}
+	}
+
+	return res, nil
+}
+
+// Read returns the content of a file.
+func (w *WebDAV) Read(ctx context.Context, ref Reference) ([]byte, error) {
+	rsp, err := w.do(ctx, http.MethodGet, ref, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rsp.Body.Close()
+
+	if err := checkResponse(rsp, ref, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadAll(rsp.Body)
+}
+
+// Write replaces the content of a file, it returns the new ETag. With an ETag
+// given the file is only replaced if it did not change in the meantime.
+func (w *WebDAV) Write(ctx context.Context, ref Reference, content []byte, etag string) (string, error) {
+	header := http.Header{}
+
+	if etag != "" {
+		header.Set("If-Match", etag)
+	}
+
+	rsp, err := w.do(ctx, http.MethodPut, ref, bytes.NewReader(content), header)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode == http.StatusPreconditionFailed {
+		current, err := w.Stat(ctx, ref)
+
+		if err != nil {
+			return "", err
+		}
+
+		return "", &ConflictError{Ref: ref, Current: current}
+	}
+
+	if err := checkResponse(rsp, ref, http.StatusOK, http.StatusCreated, http.StatusNoContent); err != nil {
+		return "", err
+	}
+
+	if etag := rsp.Header.Get("ETag"); etag != "" {
+		return etag, nil
+	}
+
+	info, err := w.Stat(ctx, ref)
+
+	if err != nil {
+		return "", err
+	}
+
+	return info.ETag, nil
+}
+
+// MakeDir creates a folder. WebDAV servers answer MKCOL on an existing file
+// with 405 and on a missing parent folder with 409.
+func (w *WebDAV) MakeDir(ctx context.Context, ref Reference) error {
+	rsp, err := w.do(ctx, "MKCOL", ref, nil, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer rsp.Body.Close()
+
+	switch rsp.StatusCode {
+	case http.StatusMethodNotAllowed:
+		return fmt.Errorf("%w: %s", ErrExists, ref)
+	case http.StatusConflict:
+		return fmt.Errorf("%w: parent of %s", ErrNotFound, ref)
+	}
+
+	return checkResponse(rsp, ref, http.StatusCreated)
+}
+
+// Delete removes a file or a folder with all files in it.
+func (w *WebDAV) Delete(ctx context.Context, ref Reference) error {
+	rsp, err := w.do(ctx, http.MethodDelete, ref, nil, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer rsp.Body.Close()
+
+	return checkResponse(rsp, ref, http.StatusOK, http.StatusNoContent)
+}
+
+// Move renames a file or a folder.
+func (w *WebDAV) Move(ctx context.Context, from, to Reference) error {
+	if to.Path == "" {
+		return fmt.Errorf("%w: resource ids are not supported by WebDAV", ErrInvalidReference)
+	}
+
+	destination, err := w.url(to)
+
+	if err != nil {
+		return err
+	}
+
+	rsp, err := w.do(ctx, "MOVE", from, nil, http.Header{
+		"Destination": []string{destination},
+		"Overwrite":   []string{"F"},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode == http.StatusPreconditionFailed {
+		return fmt.Errorf("%w: %s", ErrExists, to)
+	}
+
+	return checkResponse(rsp, from, http.StatusCreated, http.StatusNoContent)
+}
+
+// Versions is not supported by WebDAV, the versions of oCIS are served by
+// another endpoint.
+func (w *WebDAV) Versions(ctx context.Context, ref Reference) ([]*Version, error) {
+	return nil, ErrNotSupported
+}
+
+// ReadVersion is not supported by the WebDAV storage.
+func (w *WebDAV) ReadVersion(ctx context.Context, ref Reference, key string) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// propfind returns the infos of a file and, depending on the depth, of the
+// files in it.
+func (w *WebDAV) propfind(ctx context.Context, ref Reference, depth string) ([]*Info, error) {
+	rsp, err := w.do(ctx, "PROPFIND", ref, strings.NewReader(propfindBody), http.Header{
+		"Depth":        []string{depth},
+		"Content-Type": []string{"application/xml; charset=utf-8"},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rsp.Body.Close()
+
+	if err := checkResponse(rsp, ref, http.StatusMultiStatus); err != nil {
+		return nil, err
+	}
+
+	var ms multistatus
+
+	if err := xml.NewDecoder(rsp.Body).Decode(&ms); err != nil {
+		return nil, fmt.Errorf("%s: invalid PROPFIND response: %w", ref, err)
+	}
+
+	base, err := url.Parse(w.endpoint)
+
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*Info, 0, len(ms.Responses))
+
+	for _, r := range ms.Responses {
+		href, err := url.Parse(r.Href)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid href %q: %w", ref, r.Href, err)
+		}
+
+		if info := r.info(strings.TrimPrefix(href.Path, base.Path)); info != nil {
+			infos = append(infos, info)
+		}
+	}
+
+	return infos, nil
+}
+
+// do sends a request for the file, on behalf of the user of the context.
+func (w *WebDAV) do(ctx context.Context, method string, ref Reference, body io.Reader, header http.Header) (*http.Response, error) {
+	if ref.Path == "" {
+		return nil, fmt.Errorf("%w: resource ids are not supported by WebDAV", ErrInvalidReference)
+	}
+
+	authorization, hasAuthorization := AuthorizationFromContext(ctx)
+	token, hasToken := TokenFromContext(ctx)
+
+	if !hasAuthorization && !hasToken {
+		return nil, ErrMissingToken
+	}
+
+	u, err := w.url(ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	if hasAuthorization {
+		req.Header.Set(AuthorizationHeader, authorization)
+	}
+
+	if hasToken {
+		req.Header.Set(TokenHeader, token)
+	}
+
+	return w.client.Do(req)
+}
+
+// url returns the URL of a file.
+func (w *WebDAV) url(ref Reference) (string, error) {
+	u, err := url.Parse(w.endpoint)
+
+	if err != nil {
+		return "", err
+	}
+
+	u.Path = path.Join(u.Path, cleanPath(ref.Path))
+	return u.String(), nil
+}
+
+// checkResponse converts unexpected response status codes to an error.
+func checkResponse(rsp *http.Response, ref Reference, expected ...int) error {
+	for _, code := range expected {
+		if rsp.StatusCode == code {
+			return nil
+		}
+	}
+
+	switch rsp.StatusCode {
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrNotFound, ref)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: %s", ErrPermissionDenied, ref)
+	}
+
+	return fmt.Errorf("%s: unexpected status %s", ref, rsp.Status)
+}
+
+// cleanPath returns the path with a leading and without a trailing slash.
+func cleanPath(p string) string {
+	return path.Clean("/" + p)
+}
+
+// multistatus is the body of PROPFIND responses.
+type multistatus struct {
+	Responses []response `xml:"DAV: response"`
+}
+
+type response struct {
+	Href      string     `xml:"DAV: href"`
+	Propstats []propstat `xml:"DAV: propstat"`
+}
+
+type propstat struct {
+	Status string `xml:"DAV: status"`
+	Prop   prop   `xml:"DAV: prop"`
+}
+
+type prop struct {
+	ResourceType struct {
+		Collection *struct{} `xml:"DAV: collection"`
+	} `xml:"DAV: resourcetype"`
+	ContentLength string `xml:"DAV: getcontentlength"`
+	ContentType   string `xml:"DAV: getcontenttype"`
+	ETag          string `xml:"DAV: getetag"`
+	LastModified  string `xml:"DAV: getlastmodified"`
+	FileID        string `xml:"http://owncloud.org/ns fileid"`
+}
+
+// info returns the info of the file of the response, properties which were
+// not found are left empty.
+func (r response) info(p string) *Info {
+	info := &Info{
+		Path: cleanPath(p),
+	}
+
+	found := false
+
+	for _, ps := range r.Propstats {
+		if !strings.Contains(ps.Status, " 200 ") {
+			continue
+		}
+
+		found = true
+
+		if ps.Prop.ResourceType.Collection != nil {
+			info.IsDir = true
+		}
+
+		if ps.Prop.ContentLength != "" {
+			info.Size, _ = strconv.ParseUint(ps.Prop.ContentLength, 10, 64)
+		}
+
+		if ps.Prop.ContentType != "" {
+			info.MimeType = ps.Prop.ContentType
+		}
+
+		if ps.Prop.ETag != "" {
+			info.ETag = ps.Prop.ETag
+		}
+
+		if ps.Prop.LastModified != "" {
+			if t, err := http.ParseTime(ps.Prop.LastModified); err == nil {
+				info.Modified = t.UTC()
+			}
+		}
+
+		if ps.Prop.FileID != "" {
+			info.ID = ps.Prop.FileID
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	return info
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/webdav"
+)
+
+// newWebDAV starts a WebDAV server for requests with the bearer token,
+// mounted like the files endpoint of oCIS. Unlike the handler of x/net it
+// checks the If-Match header of uploads.
+func newWebDAV(t *testing.T) *httptest.Server {
+	fs := webdav.NewMemFS()
+	ctx := context.Background()
+
+	assert.Nil(t, fs.Mkdir(ctx, "/einstein", 0700))
+	assert.Nil(t, fs.Mkdir(ctx, "/einstein/Notebooks", 0700))
+
+	f, err := fs.OpenFile(ctx, "/einstein/Notebooks/analysis.ipynb", os.O_CREATE|os.O_WRONLY, 0600)
+	assert.Nil(t, err)
+	_, err = f.Write([]byte(testContent))
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+
+	handler := &webdav.Handler{
+		Prefix:     "/remote.php/dav/files",
+		FileSystem: fs,
+		LockSystem: webdav.NewMemLS(),
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(AuthorizationHeader) != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if etag := r.Header.Get("If-Match"); r.Method == http.MethodPut && etag != "" {
+			fi, err := fs.Stat(r.Context(), strings.TrimPrefix(r.URL.Path, handler.Prefix))
+
+			if err != nil || etag != fmt.Sprintf(`"%x%x"`, fi.ModTime().UnixNano(), fi.Size()) {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+		}
+
+		handler.ServeHTTP(w, r)
+	}))
+}
+
+func TestWebDAV(t *testing.T) {
+	server := newWebDAV(t)
+	defer server.Close()
+
+	w := NewWebDAV(server.URL+"/remote.php/dav/files/", nil)
+	ctx := ContextWithAuthorization(context.Background(), "Bearer "+testToken)
+	ref := Reference{Path: "/einstein/Notebooks/analysis.ipynb"}
+
+	b, err := w.Read(ctx, ref)
+	assert.Nil(t, err)
+	assert.Equal(t, testContent, string(b))
+
+	info, err := w.Stat(ctx, ref)
+	assert.Nil(t, err)
+	assert.Equal(t, "/einstein/Notebooks/analysis.ipynb", info.Path)
+	assert.Equal(t, "analysis.ipynb", info.Name())
+	assert.Equal(t, uint64(len(testContent)), info.Size)
+	assert.NotEmpty(t, info.ETag)
+	assert.False(t, info.IsDir)
+
+	etag, err := w.Write(ctx, Reference{Path: "/einstein/Notebooks/copy.ipynb"}, []byte(testContent), "")
+	assert.Nil(t, err)
+	assert.NotEmpty(t, etag)
+
+	etag, err = w.Write(ctx, ref, []byte(testContent+"\n"), info.ETag)
+	assert.Nil(t, err)
+	assert.NotEqual(t, info.ETag, etag)
+
+	_, err = w.Write(ctx, ref, []byte(testContent), info.ETag)
+	assert.True(t, errors.Is(err, ErrConflict))
+
+	var conflict *ConflictError
+
+	if assert.True(t, errors.As(err, &conflict)) {
+		assert.Equal(t, etag, conflict.Current.ETag)
+		assert.Equal(t, uint64(len(testContent)+1), conflict.Current.Size)
+	}
+
+	infos, err := w.List(ctx, Reference{Path: "/einstein/Notebooks/"})
+	assert.Nil(t, err)
+
+	if assert.Len(t, infos, 2) {
+		names := []string{infos[0].Name(), infos[1].Name()}
+		assert.ElementsMatch(t, []string{"analysis.ipynb", "copy.ipynb"}, names)
+	}
+
+	err = w.Move(ctx, Reference{Path: "/einstein/Notebooks/copy.ipynb"}, ref)
+	assert.True(t, errors.Is(err, ErrExists))
+
+	err = w.Move(ctx, Reference{Path: "/einstein/Notebooks/copy.ipynb"}, Reference{Path: "/einstein/moved.ipynb"})
+	assert.Nil(t, err)
+
+	err = w.Delete(ctx, Reference{Path: "/einstein/moved.ipynb"})
+	assert.Nil(t, err)
+
+	_, err = w.Stat(ctx, Reference{Path: "/einstein/moved.ipynb"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	err = w.MakeDir(ctx, Reference{Path: "/einstein/Archive"})
+	assert.Nil(t, err)
+
+	info, err = w.Stat(ctx, Reference{Path: "/einstein/Archive"})
+	assert.Nil(t, err)
+	assert.True(t, info.IsDir)
+
+	err = w.MakeDir(ctx, Reference{Path: "/einstein/Archive"})
+	assert.True(t, errors.Is(err, ErrExists))
+
+	err = w.MakeDir(ctx, Reference{Path: "/einstein/Missing/Archive"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = w.Versions(ctx, ref)
+	assert.Equal(t, ErrNotSupported, err)
+
+	_, err = w.ReadVersion(ctx, ref, "1")
+	assert.Equal(t, ErrNotSupported, err)
+
+	_, err = w.Read(ctx, Reference{Path: "/einstein/missing.ipynb"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = w.Stat(ctx, Reference{Path: "/einstein/missing.ipynb"})
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = w.Read(ContextWithAuthorization(context.Background(), "Bearer other"), ref)
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+
+	_, err = w.Read(context.Background(), ref)
+	assert.True(t, errors.Is(err, ErrMissingToken))
+
+	_, err = w.Read(ctx, Reference{ResourceID: "a:b"})
+	assert.True(t, errors.Is(err, ErrInvalidReference))
+}
@@ -0,0 +1,273 @@
+package trust
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultCapacity is the number of signatures a store keeps, like the
+// signature database of Jupyter.
+const DefaultCapacity = 65535
+
+// Store keeps the signatures of trusted notebooks.
+type Store interface {
+	// Check returns true if the signature is known.
+	Check(algorithm, signature string) (bool, error)
+
+	// Store adds the signature.
+	Store(algorithm, signature string) error
+
+	// Remove deletes the signature.
+	Remove(algorithm, signature string) error
+}
+
+// NewStore returns a file store for the given path, or an in-memory store if
+// the path is empty.
+func NewStore(path string) Store {
+	if path == "" {
+		return NewMemoryStore()
+	}
+
+	return NewFileStore(path)
+}
+
+// entry is a single stored signature.
+type entry struct {
+	Algorithm string    `json:"algorithm"`
+	Signature string    `json:"signature"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// entries maps algorithm and signature to the stored entry.
+type entries map[string]*entry
+
+func key(algorithm, signature string) string {
+	return algorithm + ":" + signature
+}
+
+// add stores a signature and culls the least recently seen ones if the
+// capacity is exceeded.
+func (e entries) add(algorithm, signature string, capacity int) {
+	e[key(algorithm, signature)] = &entry{
+		Algorithm: algorithm,
+		Signature: signature,
+		LastSeen:  time.Now().UTC(),
+	}
+
+	if capacity <= 0 || len(e) <= capacity {
+		return
+	}
+
+	all := make([]*entry, 0, len(e))
+
+	for _, v := range e {
+		all = append(all, v)
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].LastSeen.Before(all[j].LastSeen)
+	})
+
+	for _, v := range all[:len(all)-capacity] {
+		delete(e, key(v.Algorithm, v.Signature))
+	}
+}
+
+// MemoryStore keeps signatures in memory only, they are lost on restart.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries entries
+}
+
+// NewMemoryStore initializes a new in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: entries{},
+	}
+}
+
+// Check implements the Store interface.
+func (s *MemoryStore) Check(algorithm, signature string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.entries[key(algorithm, signature)]
+	return ok, nil
+}
+
+// Store implements the Store interface.
+func (s *MemoryStore) Store(algorithm, signature string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries.add(algorithm, signature, DefaultCapacity)
+	return nil
+}
+
+// Remove implements the Store interface.
+func (s *MemoryStore) Remove(algorithm, signature string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key(algorithm, signature))
+	return nil
+}
+
+// FileStore keeps signatures in a JSON file. The file is read again if it
+// was changed by another process, e.g. by the trust command.
+type FileStore struct {
+	mu       sync.Mutex
+	path     string
+	modified time.Time
+	entries  entries
+}
+
+// NewFileStore initializes a new store for the given file, which is created
+// with the first signature.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{
+		path:    path,
+		entries: entries{},
+	}
+}
+
+// Check implements the Store interface.
+func (s *FileStore) Check(algorithm, signature string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return false, err
+	}
+
+	_, ok := s.entries[key(algorithm, signature)]
+	return ok, nil
+}
+
+// Store implements the Store interface.
+func (s *FileStore) Store(algorithm, signature string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	s.entries.add(algorithm, signature, DefaultCapacity)
+	return s.save()
+}
+
+// Remove implements the Store interface.
+func (s *FileStore) Remove(algorithm, signature string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(); err != nil {
+		return err
+	}
+
+	if _, ok := s.entries[key(algorithm, signature)]; !ok {
+		return nil
+	}
+
+	delete(s.entries, key(algorithm, signature))
+	return s.save()
+}
+
+// load reads the file if it changed since it was read last.
+func (s *FileStore) load() error {
+	info, err := os.Stat(s.path)
+
+	if os.IsNotExist(err) {
+		s.entries = entries{}
+		s.modified = time.Time{}
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if info.ModTime().Equal(s.modified) {
+		return nil
+	}
+
+	b, err := ioutil.ReadFile(s.path)
+
+	if err != nil {
+		return err
+	}
+
+	var list []*entry
+
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+
+	s.entries = make(entries, len(list))
+
+	for _, e := range list {
+		s.entries[key(e.Algorithm, e.Signature)] = e
+	}
+
+	s.modified = info.ModTime()
+	return nil
+}
+
+// save replaces the file atomically.
+func (s *FileStore) save() error {
+	list := make([]*entry, 0, len(s.entries))
+
+	for _, e := range s.entries {
+		list = append(list, e)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].LastSeen.Before(list[j].LastSeen)
+	})
+
+	b, err := json.MarshalIndent(list, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(s.path)
+
+	if err != nil {
+		return err
+	}
+
+	s.modified = info.ModTime()
+	return nil
+}
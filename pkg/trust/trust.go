@@ -0,0 +1,221 @@
+// Package trust implements notebook signatures compatible with Jupyter. A
+// notebook is trusted if the HMAC of its content is found in the signature
+// store, which is only the case after an administrator trusted it through
+// the Trust request or the trust command. Saving a notebook does not sign it.
+package trust
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+// Algorithm is the digest algorithm of signatures, in the notation of Jupyter.
+const Algorithm = "sha256"
+
+var (
+	// ErrNoSecret defines the error if notebooks are signed without a secret.
+	ErrNoSecret = errors.New("no signing secret configured")
+
+	// transientKeys are removed by nbformat on read, they are not signed.
+	transientKeys = []string{"signature", "orig_nbformat", "orig_nbformat_minor"}
+)
+
+// Signer computes and checks notebook signatures.
+type Signer struct {
+	secret []byte
+	store  Store
+}
+
+// NewSigner initializes a new signer. Signatures are kept in the given store.
+func NewSigner(secret string, store Store) *Signer {
+	return &Signer{
+		secret: []byte(secret),
+		store:  store,
+	}
+}
+
+// Compute returns the signature of the notebook.
+func (s *Signer) Compute(nb *notebook.Notebook) (string, error) {
+	if len(s.secret) == 0 {
+		return "", ErrNoSecret
+	}
+
+	b, err := nb.Marshal()
+
+	if err != nil {
+		return "", err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var doc map[string]interface{}
+
+	if err := dec.Decode(&doc); err != nil {
+		return "", err
+	}
+
+	stripTransient(doc)
+
+	mac := hmac.New(sha256.New, s.secret)
+	digest(mac, doc)
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Sign stores the signature of the notebook, so it is trusted from now on.
+// It returns the signature and whether the notebook was trusted before.
+func (s *Signer) Sign(nb *notebook.Notebook) (string, bool, error) {
+	signature, err := s.Compute(nb)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	trusted, err := s.store.Check(Algorithm, signature)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	if err := s.store.Store(Algorithm, signature); err != nil {
+		return "", false, err
+	}
+
+	return signature, trusted, nil
+}
+
+// Check returns true if the notebook is trusted. Without a secret no
+// notebook is trusted.
+func (s *Signer) Check(nb *notebook.Notebook) (bool, error) {
+	if len(s.secret) == 0 {
+		return false, nil
+	}
+
+	signature, err := s.Compute(nb)
+
+	if err != nil {
+		return false, err
+	}
+
+	return s.store.Check(Algorithm, signature)
+}
+
+// Unsign removes the signature of the notebook from the store.
+func (s *Signer) Unsign(nb *notebook.Notebook) error {
+	signature, err := s.Compute(nb)
+
+	if err != nil {
+		return err
+	}
+
+	return s.store.Remove(Algorithm, signature)
+}
+
+// stripTransient removes the keys which nbformat drops while reading a
+// notebook, the signature would depend on them otherwise.
+func stripTransient(doc map[string]interface{}) {
+	if metadata, ok := doc["metadata"].(map[string]interface{}); ok {
+		for _, key := range transientKeys {
+			delete(metadata, key)
+		}
+	}
+
+	cells, _ := doc["cells"].([]interface{})
+
+	for _, c := range cells {
+		if cell, ok := c.(map[string]interface{}); ok {
+			if metadata, ok := cell["metadata"].(map[string]interface{}); ok {
+				delete(metadata, "trusted")
+			}
+		}
+	}
+}
+
+// digest feeds the document to the hash the way yield_everything of nbformat
+// does: object keys in sorted order followed by their values, strings as
+// they are and other values in their Python notation. Multiline strings give
+// the same digest whether they are stored as string or list of lines.
+func digest(h hash.Hash, v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+
+		for key := range v {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			_, _ = io.WriteString(h, key)
+			digest(h, v[key])
+		}
+	case []interface{}:
+		for _, e := range v {
+			digest(h, e)
+		}
+	case string:
+		_, _ = io.WriteString(h, v)
+	case json.Number:
+		_, _ = io.WriteString(h, pythonNumber(v))
+	case bool:
+		if v {
+			_, _ = io.WriteString(h, "True")
+		} else {
+			_, _ = io.WriteString(h, "False")
+		}
+	case nil:
+		_, _ = io.WriteString(h, "None")
+	}
+}
+
+// pythonNumber formats a JSON number like str() of the value decoded by Python.
+func pythonNumber(n json.Number) string {
+	s := n.String()
+
+	if !strings.ContainsAny(s, ".eE") {
+		if s == "-0" {
+			return "0"
+		}
+
+		return s
+	}
+
+	f, err := n.Float64()
+
+	if err != nil {
+		return s
+	}
+
+	// Python switches to the exponent notation at other thresholds than Go.
+	exp := 0
+
+	if f != 0 {
+		e := strconv.FormatFloat(f, 'e', -1, 64)
+		exp, _ = strconv.Atoi(e[strings.IndexByte(e, 'e')+1:])
+	}
+
+	if exp < -4 || exp >= 16 {
+		return strconv.FormatFloat(f, 'e', -1, 64)
+	}
+
+	s = strconv.FormatFloat(f, 'f', -1, 64)
+
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+
+	return s
+}
@@ -0,0 +1,148 @@
+package trust
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+func load(t *testing.T) *notebook.Notebook {
+	b, err := ioutil.ReadFile("testdata/signed.ipynb")
+	assert.Nil(t, err)
+
+	nb, err := notebook.Parse(b)
+	assert.Nil(t, err)
+
+	return nb
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "trust")
+	assert.Nil(t, err)
+
+	return dir
+}
+
+func TestSigner_Compute(t *testing.T) {
+	nb := load(t)
+
+	// Computed with the signing routine of nbformat for the secret "secret".
+	expected := "6915976ee8586f28c9de4a27ddcddbe70a5696459fd412365fe238525d6c8ed0"
+
+	signature, err := NewSigner("secret", NewMemoryStore()).Compute(nb)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, signature)
+
+	// Transient keys and the representation of multiline strings do not matter.
+	nb.Metadata.Delete("signature")
+	assert.Nil(t, json.Unmarshal([]byte(`["x = ", "0.5"]`), &nb.Cells[1].Source))
+
+	signature, err = NewSigner("secret", NewMemoryStore()).Compute(nb)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, signature)
+
+	nb.Cells[1].Source = notebook.NewMultilineString("x = 1")
+
+	signature, err = NewSigner("secret", NewMemoryStore()).Compute(nb)
+	assert.Nil(t, err)
+	assert.NotEqual(t, expected, signature)
+
+	_, err = NewSigner("", NewMemoryStore()).Compute(nb)
+	assert.Equal(t, ErrNoSecret, err)
+}
+
+func TestSigner_Sign(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	stores := map[string]Store{
+		"memory": NewMemoryStore(),
+		"file":   NewFileStore(filepath.Join(dir, "trust", "signatures.json")),
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			nb := load(t)
+			s := NewSigner("secret", store)
+
+			trusted, err := s.Check(nb)
+			assert.Nil(t, err)
+			assert.False(t, trusted)
+
+			_, before, err := s.Sign(nb)
+			assert.Nil(t, err)
+			assert.False(t, before)
+
+			trusted, err = s.Check(nb)
+			assert.Nil(t, err)
+			assert.True(t, trusted)
+
+			_, before, err = s.Sign(nb)
+			assert.Nil(t, err)
+			assert.True(t, before)
+
+			trusted, err = NewSigner("other", store).Check(nb)
+			assert.Nil(t, err)
+			assert.False(t, trusted)
+
+			assert.Nil(t, s.Unsign(nb))
+
+			trusted, err = s.Check(nb)
+			assert.Nil(t, err)
+			assert.False(t, trusted)
+		})
+	}
+}
+
+func TestFileStore_Reload(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "signatures.json")
+	server, command := NewFileStore(path), NewFileStore(path)
+
+	ok, err := server.Check(Algorithm, "abc")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	assert.Nil(t, command.Store(Algorithm, "abc"))
+
+	ok, err = server.Check(Algorithm, "abc")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestEntries_Cull(t *testing.T) {
+	e := entries{}
+
+	for _, s := range []string{"a", "b", "c"} {
+		e.add(Algorithm, s, 2)
+	}
+
+	assert.Len(t, e, 2)
+	assert.NotContains(t, e, key(Algorithm, "a"))
+}
+
+func TestPythonNumber(t *testing.T) {
+	tests := map[string]string{
+		"4":       "4",
+		"-0":      "0",
+		"1.0":     "1.0",
+		"0.5":     "0.5",
+		"1e20":    "1e+20",
+		"1e15":    "1000000000000000.0",
+		"0.00001": "1e-05",
+		"0.0001":  "0.0001",
+		"2.5E3":   "2500.0",
+	}
+
+	for in, expected := range tests {
+		assert.Equal(t, expected, pythonNumber(json.Number(in)), in)
+	}
+}
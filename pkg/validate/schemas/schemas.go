@@ -0,0 +1,5 @@
+// Package schemas embeds the official nbformat JSON schemas, one file per
+// nbformat v4 minor version.
+package schemas
+
+//go:generate go run github.com/UnnoTed/fileb0x embed.yml
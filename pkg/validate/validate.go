@@ -0,0 +1,238 @@
+// Package validate checks notebooks against the official nbformat JSON
+// schemas. The schema is chosen by the minor version of the notebook and every
+// violation is located by a JSON pointer into the document.
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/validate/schemas"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+var (
+	// discriminators are the schema locations which select the branch of a
+	// oneOf in the nbformat schema, branches of a different cell or output
+	// type are not reported.
+	discriminators = []string{
+		"/properties/cell_type/enum",
+		"/properties/output_type/enum",
+	}
+
+	// compiled caches the compiled schemas by minor version.
+	compiled = map[int]*jsonschema.Schema{}
+
+	// mutex guards compiled.
+	mutex sync.Mutex
+)
+
+// Error defines a single schema violation.
+type Error struct {
+	// Pointer is the JSON pointer of the invalid value, e.g. /cells/12/outputs/0/data.
+	Pointer string
+
+	// SchemaPointer is the location of the failed keyword within the schema.
+	SchemaPointer string
+
+	// Message describes the violation.
+	Message string
+}
+
+// Error implements the error interface.
+func (e Error) Error() string {
+	if e.Pointer == "" {
+		return e.Message
+	}
+
+	return e.Pointer + ": " + e.Message
+}
+
+// Result defines the outcome of a validation.
+type Result struct {
+	// Schema is the name of the schema the notebook was checked against.
+	Schema string
+
+	// Errors lists all violations, it is empty for a valid notebook.
+	Errors []Error
+}
+
+// Valid returns true if the notebook matches the schema.
+func (r *Result) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// Validate checks an encoded nbformat v4 notebook against the schema of its
+// minor version. Minor versions newer than notebook.Minor are checked against
+// the latest known schema. An error is returned if the document is not JSON
+// or not an nbformat v4 notebook at all.
+func Validate(b []byte) (*Result, error) {
+	major, minor, err := notebook.Version(b)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if major != notebook.Major {
+		return nil, fmt.Errorf("%w: %d", notebook.ErrUnsupportedVersion, major)
+	}
+
+	if minor < 0 || minor > notebook.Minor {
+		minor = notebook.Minor
+	}
+
+	schema, err := Schema(minor)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	res := &Result{
+		Schema: SchemaName(minor),
+		Errors: []Error{},
+	}
+
+	if err := schema.Validate(doc); err != nil {
+		verr, ok := err.(*jsonschema.ValidationError)
+
+		if !ok {
+			return nil, err
+		}
+
+		res.Errors = collect(verr, res.Errors)
+	}
+
+	return res, nil
+}
+
+// SchemaName returns the file name of the schema for an nbformat v4 minor version.
+func SchemaName(minor int) string {
+	return fmt.Sprintf("nbformat.v%d.%d.schema.json", notebook.Major, minor)
+}
+
+// Schema returns the compiled schema for an nbformat v4 minor version.
+func Schema(minor int) (*jsonschema.Schema, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if schema, ok := compiled[minor]; ok {
+		return schema, nil
+	}
+
+	name := SchemaName(minor)
+	content, err := schemas.ReadFile(name)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft4
+
+	if err := compiler.AddResource(name, bytes.NewReader(content)); err != nil {
+		return nil, err
+	}
+
+	schema, err := compiler.Compile(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compiled[minor] = schema
+	return schema, nil
+}
+
+// collect flattens the tree of validation errors into its leaves.
+func collect(verr *jsonschema.ValidationError, errs []Error) []Error {
+	causes := verr.Causes
+
+	if strings.HasSuffix(verr.KeywordLocation, "/oneOf") {
+		var mismatch []*jsonschema.ValidationError
+		causes, mismatch = discriminate(causes)
+
+		if len(causes) == 0 && len(mismatch) > 0 {
+			values := make([]string, 0, len(mismatch))
+
+			for _, m := range mismatch {
+				values = append(values, strings.TrimPrefix(m.Message, "value must be "))
+			}
+
+			return append(errs, Error{
+				Pointer:       mismatch[0].InstanceLocation,
+				SchemaPointer: verr.KeywordLocation,
+				Message:       "value must be one of " + strings.Join(values, ", "),
+			})
+		}
+	}
+
+	if len(causes) == 0 {
+		return append(errs, Error{
+			Pointer:       verr.InstanceLocation,
+			SchemaPointer: verr.KeywordLocation,
+			Message:       verr.Message,
+		})
+	}
+
+	for _, cause := range causes {
+		errs = collect(cause, errs)
+	}
+
+	return errs
+}
+
+// discriminate splits the branches of a failed oneOf into those matching the
+// cell or output type and the failed type checks of the other branches.
+func discriminate(branches []*jsonschema.ValidationError) ([]*jsonschema.ValidationError, []*jsonschema.ValidationError) {
+	matching := make([]*jsonschema.ValidationError, 0, len(branches))
+	mismatch := make([]*jsonschema.ValidationError, 0, len(branches))
+
+	for _, branch := range branches {
+		if m := find(branch); m != nil {
+			mismatch = append(mismatch, m)
+			continue
+		}
+
+		matching = append(matching, branch)
+	}
+
+	if len(mismatch) == 0 {
+		return branches, nil
+	}
+
+	return matching, mismatch
+}
+
+// find returns the failed discriminator check within a branch, if any.
+func find(verr *jsonschema.ValidationError) *jsonschema.ValidationError {
+	for _, suffix := range discriminators {
+		if strings.HasSuffix(verr.KeywordLocation, suffix) {
+			return verr
+		}
+	}
+
+	for _, cause := range verr.Causes {
+		if strings.HasSuffix(cause.KeywordLocation, "/oneOf") {
+			continue
+		}
+
+		if m := find(cause); m != nil {
+			return m
+		}
+	}
+
+	return nil
+}
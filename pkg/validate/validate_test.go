@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anaswaratrajan/ocis-jupyter/pkg/notebook"
+)
+
+func TestSchema(t *testing.T) {
+	for minor := 0; minor <= notebook.Minor; minor++ {
+		schema, err := Schema(minor)
+		assert.Nil(t, err)
+		assert.NotNil(t, schema)
+	}
+
+	_, err := Schema(notebook.Minor + 1)
+	assert.NotNil(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		doc            string
+		expectedSchema string
+		expectedErrors []Error
+	}{
+		{
+			"valid v4.4",
+			`{"cells": [{"cell_type": "code", "execution_count": 1, "metadata": {}, "source": "1", "outputs": [
+				{"output_type": "execute_result", "execution_count": 1, "metadata": {}, "data": {"text/plain": "1", "application/json": {"a": 1}}}
+			]}], "metadata": {}, "nbformat": 4, "nbformat_minor": 4}`,
+			"nbformat.v4.4.schema.json",
+			[]Error{},
+		},
+		{
+			"newer minor",
+			`{"cells": [], "metadata": {}, "nbformat": 4, "nbformat_minor": 9}`,
+			"nbformat.v4.5.schema.json",
+			[]Error{},
+		},
+		{
+			"missing cell id",
+			`{"cells": [{"cell_type": "markdown", "metadata": {}, "source": ""}], "metadata": {}, "nbformat": 4, "nbformat_minor": 5}`,
+			"nbformat.v4.5.schema.json",
+			[]Error{
+				{"/cells/0", "/properties/cells/items/$ref/oneOf/1/$ref/required", "missing properties: 'id'"},
+			},
+		},
+		{
+			"cell id before v4.5",
+			`{"cells": [{"id": "a", "cell_type": "raw", "metadata": {}, "source": ""}], "metadata": {}, "nbformat": 4, "nbformat_minor": 4}`,
+			"nbformat.v4.4.schema.json",
+			[]Error{
+				{"/cells/0", "/properties/cells/items/$ref/oneOf/0/$ref/additionalProperties", "additionalProperties 'id' not allowed"},
+			},
+		},
+		{
+			"broken output data",
+			`{"cells": [{"cell_type": "code", "execution_count": null, "metadata": {}, "source": [], "outputs": [
+				{"output_type": "display_data", "metadata": {}, "data": {"text/plain": 1}}
+			]}], "metadata": {}, "nbformat": 4, "nbformat_minor": 4}`,
+			"nbformat.v4.4.schema.json",
+			[]Error{
+				{"/cells/0/outputs/0/data/text~1plain", "/properties/cells/items/$ref/oneOf/2/$ref/properties/outputs/items/$ref/oneOf/1/$ref/properties/data/$ref/additionalProperties/$ref/oneOf/0/type", "expected string, but got number"},
+				{"/cells/0/outputs/0/data/text~1plain", "/properties/cells/items/$ref/oneOf/2/$ref/properties/outputs/items/$ref/oneOf/1/$ref/properties/data/$ref/additionalProperties/$ref/oneOf/1/type", "expected array, but got number"},
+			},
+		},
+		{
+			"unknown cell type",
+			`{"cells": [{"cell_type": "foo", "metadata": {}, "source": ""}], "metadata": {}, "nbformat": 4, "nbformat_minor": 4}`,
+			"nbformat.v4.4.schema.json",
+			[]Error{
+				{"/cells/0/cell_type", "/properties/cells/items/$ref/oneOf", `value must be one of "raw", "markdown", "code"`},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Validate([]byte(tt.doc))
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expectedSchema, res.Schema)
+			assert.Equal(t, tt.expectedErrors, res.Errors)
+			assert.Equal(t, len(tt.expectedErrors) == 0, res.Valid())
+		})
+	}
+}
+
+func TestValidate_Errors(t *testing.T) {
+	_, err := Validate([]byte(`{"nbformat": 3}`))
+	assert.True(t, errors.Is(err, notebook.ErrUnsupportedVersion))
+
+	_, err = Validate([]byte(`{"cells": []}`))
+	assert.True(t, errors.Is(err, notebook.ErrMissingVersion))
+
+	_, err = Validate([]byte(`{"nbformat": 4,`))
+	assert.NotNil(t, err)
+}
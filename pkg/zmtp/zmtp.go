@@ -0,0 +1,435 @@
+// Package zmtp implements the parts of the ZeroMQ Message Transport Protocol
+// 3.0 which are needed to talk to Jupyter kernels: TCP connections with the
+// NULL security mechanism between DEALER and ROUTER or PUB and SUB sockets.
+// A connection carries the messages between exactly two peers, routing and
+// reconnecting like full ZeroMQ sockets is left to the caller.
+package zmtp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Socket types of the peers.
+const (
+	Dealer = "DEALER"
+	Router = "ROUTER"
+	Pub    = "PUB"
+	Sub    = "SUB"
+	Req    = "REQ"
+	Rep    = "REP"
+)
+
+const (
+	flagMore    = 0x01
+	flagLong    = 0x02
+	flagCommand = 0x04
+
+	greetingSize = 64
+
+	// subscribe starts the subscription messages of SUB sockets.
+	subscribe = 0x01
+)
+
+var (
+	// ErrInvalidGreeting defines the error if the peer does not speak
+	// ZMTP 3 with the NULL mechanism.
+	ErrInvalidGreeting = errors.New("invalid zmtp greeting")
+
+	// ErrIncompatible defines the error if the socket types of the peers
+	// do not match, e.g. PUB and DEALER.
+	ErrIncompatible = errors.New("incompatible socket types")
+
+	// ErrRejected defines the error if the peer rejected the handshake.
+	ErrRejected = errors.New("handshake rejected")
+
+	// ErrInvalidAddress defines the error if an address is not a tcp://
+	// endpoint.
+	ErrInvalidAddress = errors.New("invalid zmtp address")
+
+	// MaxFrameSize limits the size of received frames.
+	MaxFrameSize uint64 = 1 << 30
+
+	// compatible lists the socket types each socket type can talk to.
+	compatible = map[string][]string{
+		Dealer: {Dealer, Router, Rep},
+		Router: {Dealer, Router, Req},
+		Pub:    {Sub},
+		Sub:    {Pub},
+		Req:    {Rep, Router},
+		Rep:    {Req, Dealer},
+	}
+)
+
+// Conn is a connection to a ZeroMQ peer.
+type Conn struct {
+	conn       net.Conn
+	reader     *bufio.Reader
+	socketType string
+	peerType   string
+
+	// mu serializes the messages sent by several goroutines.
+	mu sync.Mutex
+}
+
+// Dial connects to the given tcp:// address and performs the handshake.
+func Dial(ctx context.Context, address, socketType string) (*Conn, error) {
+	host, err := hostPort(address)
+
+	if err != nil {
+		return nil, err
+	}
+
+	d := net.Dialer{}
+	c, err := d.DialContext(ctx, "tcp", host)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = c.SetDeadline(deadline)
+	}
+
+	conn, err := NewConn(c, socketType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_ = c.SetDeadline(time.Time{})
+
+	return conn, nil
+}
+
+// NewConn performs the handshake on an established connection, which is
+// closed if the handshake fails.
+func NewConn(c net.Conn, socketType string) (*Conn, error) {
+	conn := &Conn{
+		conn:       c,
+		reader:     bufio.NewReader(c),
+		socketType: socketType,
+	}
+
+	if err := conn.handshake(); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// PeerType returns the socket type of the peer.
+func (c *Conn) PeerType() string {
+	return c.peerType
+}
+
+// Subscribe subscribes a SUB connection to the messages whose first frame
+// starts with the prefix, an empty prefix subscribes to all messages.
+func (c *Conn) Subscribe(prefix []byte) error {
+	return c.Send(append([]byte{subscribe}, prefix...))
+}
+
+// Send sends a message of one or more frames.
+func (c *Conn) Send(frames ...[]byte) error {
+	var buf bytes.Buffer
+
+	for i, frame := range frames {
+		var flags byte
+
+		if i < len(frames)-1 {
+			flags |= flagMore
+		}
+
+		writeFrame(&buf, flags, frame)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, err := c.conn.Write(buf.Bytes())
+	return err
+}
+
+// Recv receives the next message. Commands of the peer are skipped.
+func (c *Conn) Recv() ([][]byte, error) {
+	var frames [][]byte
+
+	for {
+		flags, frame, err := c.readFrame()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if flags&flagCommand != 0 {
+			continue
+		}
+
+		frames = append(frames, frame)
+
+		if flags&flagMore == 0 {
+			return frames, nil
+		}
+	}
+}
+
+// Close closes the connection.
+func (c *Conn) Close() error {
+	return c.conn.Close()
+}
+
+// handshake exchanges the greetings and READY commands of both peers.
+func (c *Conn) handshake() error {
+	if _, err := c.conn.Write(greeting()); err != nil {
+		return err
+	}
+
+	peer := make([]byte, greetingSize)
+
+	if _, err := io.ReadFull(c.reader, peer); err != nil {
+		return err
+	}
+
+	if peer[0] != 0xff || peer[9] != 0x7f || peer[10] < 3 {
+		return ErrInvalidGreeting
+	}
+
+	if mechanism := string(bytes.TrimRight(peer[12:32], "\x00")); mechanism != "NULL" {
+		return fmt.Errorf("%w: mechanism %s", ErrInvalidGreeting, mechanism)
+	}
+
+	var ready bytes.Buffer
+	writeFrame(&ready, flagCommand, command("READY", map[string]string{
+		"Socket-Type": c.socketType,
+	}))
+
+	if _, err := c.conn.Write(ready.Bytes()); err != nil {
+		return err
+	}
+
+	flags, frame, err := c.readFrame()
+
+	if err != nil {
+		return err
+	}
+
+	if flags&flagCommand == 0 {
+		return fmt.Errorf("%w: missing READY command", ErrInvalidGreeting)
+	}
+
+	name, properties, err := parseCommand(frame)
+
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "READY":
+	case "ERROR":
+		return fmt.Errorf("%w: %s", ErrRejected, properties[""])
+	default:
+		return fmt.Errorf("%w: unexpected %s command", ErrInvalidGreeting, name)
+	}
+
+	c.peerType = properties["Socket-Type"]
+
+	for _, t := range compatible[c.socketType] {
+		if t == c.peerType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s and %s", ErrIncompatible, c.socketType, c.peerType)
+}
+
+// readFrame reads a single frame with its flags.
+func (c *Conn) readFrame() (byte, []byte, error) {
+	flags, err := c.reader.ReadByte()
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var size uint64
+
+	if flags&flagLong != 0 {
+		b := make([]byte, 8)
+
+		if _, err := io.ReadFull(c.reader, b); err != nil {
+			return 0, nil, err
+		}
+
+		size = binary.BigEndian.Uint64(b)
+	} else {
+		b, err := c.reader.ReadByte()
+
+		if err != nil {
+			return 0, nil, err
+		}
+
+		size = uint64(b)
+	}
+
+	if size > MaxFrameSize {
+		return 0, nil, fmt.Errorf("frame of %d bytes exceeds the maximum size", size)
+	}
+
+	frame := make([]byte, size)
+
+	if _, err := io.ReadFull(c.reader, frame); err != nil {
+		return 0, nil, err
+	}
+
+	return flags, frame, nil
+}
+
+// Listener accepts connections of ZeroMQ peers.
+type Listener struct {
+	listener   net.Listener
+	socketType string
+}
+
+// Listen listens on the given tcp:// address.
+func Listen(address, socketType string) (*Listener, error) {
+	host, err := hostPort(address)
+
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := net.Listen("tcp", host)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Listener{
+		listener:   l,
+		socketType: socketType,
+	}, nil
+}
+
+// Accept waits for the next peer and performs the handshake.
+func (l *Listener) Accept() (*Conn, error) {
+	c, err := l.listener.Accept()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConn(c, l.socketType)
+}
+
+// Addr returns the address the listener listens on.
+func (l *Listener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+// Close stops listening.
+func (l *Listener) Close() error {
+	return l.listener.Close()
+}
+
+// greeting returns the greeting of ZMTP 3.0 with the NULL mechanism.
+func greeting() []byte {
+	b := make([]byte, greetingSize)
+	b[0], b[9] = 0xff, 0x7f
+	b[10], b[11] = 3, 0
+	copy(b[12:32], "NULL")
+
+	return b
+}
+
+// command returns the body of a command with its properties.
+func command(name string, properties map[string]string) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteByte(byte(len(name)))
+	buf.WriteString(name)
+
+	for key, value := range properties {
+		size := make([]byte, 4)
+		binary.BigEndian.PutUint32(size, uint32(len(value)))
+
+		buf.WriteByte(byte(len(key)))
+		buf.WriteString(key)
+		buf.Write(size)
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// parseCommand returns the name and the properties of a command. The reason
+// of ERROR commands is returned as property with an empty name.
+func parseCommand(b []byte) (string, map[string]string, error) {
+	invalid := fmt.Errorf("%w: malformed command", ErrInvalidGreeting)
+
+	if len(b) == 0 || len(b) < 1+int(b[0]) {
+		return "", nil, invalid
+	}
+
+	name, b := string(b[1:1+int(b[0])]), b[1+int(b[0]):]
+	properties := map[string]string{}
+
+	if name == "ERROR" {
+		if len(b) > 0 && len(b) >= 1+int(b[0]) {
+			properties[""] = string(b[1 : 1+int(b[0])])
+		}
+
+		return name, properties, nil
+	}
+
+	for len(b) > 0 {
+		if len(b) < 1+int(b[0])+4 {
+			return "", nil, invalid
+		}
+
+		key, rest := string(b[1:1+int(b[0])]), b[1+int(b[0]):]
+		size := binary.BigEndian.Uint32(rest)
+
+		if uint64(len(rest)-4) < uint64(size) {
+			return "", nil, invalid
+		}
+
+		properties[key] = string(rest[4 : 4+size])
+		b = rest[4+size:]
+	}
+
+	return name, properties, nil
+}
+
+// writeFrame appends a frame with the given flags to buf.
+func writeFrame(buf *bytes.Buffer, flags byte, frame []byte) {
+	if len(frame) > 255 {
+		size := make([]byte, 8)
+		binary.BigEndian.PutUint64(size, uint64(len(frame)))
+
+		buf.WriteByte(flags | flagLong)
+		buf.Write(size)
+	} else {
+		buf.WriteByte(flags)
+		buf.WriteByte(byte(len(frame)))
+	}
+
+	buf.Write(frame)
+}
+
+// hostPort returns the host and port of a tcp:// address.
+func hostPort(address string) (string, error) {
+	if !strings.HasPrefix(address, "tcp://") {
+		return "", fmt.Errorf("%w: %s", ErrInvalidAddress, address)
+	}
+
+	return strings.TrimPrefix(address, "tcp://"), nil
+}
@@ -0,0 +1,151 @@
+package zmtp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// serve accepts a single connection and passes it to handle.
+func serve(t *testing.T, socketType string, handle func(c *Conn, err error)) string {
+	l, err := Listen("tcp://127.0.0.1:0", socketType)
+	assert.Nil(t, err)
+
+	go func() {
+		defer l.Close()
+
+		handle(l.Accept())
+	}()
+
+	return "tcp://" + l.Addr().String()
+}
+
+func dial(t *testing.T, address, socketType string) (*Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return Dial(ctx, address, socketType)
+}
+
+func TestConn_DealerRouter(t *testing.T) {
+	long := bytes.Repeat([]byte("x"), 1000)
+
+	address := serve(t, Router, func(c *Conn, err error) {
+		if !assert.Nil(t, err) {
+			return
+		}
+
+		defer c.Close()
+
+		assert.Equal(t, Dealer, c.PeerType())
+
+		// Echo every message with a prefix.
+		for {
+			frames, err := c.Recv()
+
+			if err != nil {
+				return
+			}
+
+			_ = c.Send(append([][]byte{[]byte("echo")}, frames...)...)
+		}
+	})
+
+	c, err := dial(t, address, Dealer)
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	defer c.Close()
+
+	assert.Equal(t, Router, c.PeerType())
+	assert.Nil(t, c.Send([]byte("<IDS|MSG>"), []byte{}, long))
+
+	frames, err := c.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("echo"), []byte("<IDS|MSG>"), {}, long}, frames)
+}
+
+func TestConn_PubSub(t *testing.T) {
+	subscribed := make(chan []byte, 1)
+
+	address := serve(t, Pub, func(c *Conn, err error) {
+		if !assert.Nil(t, err) {
+			return
+		}
+
+		defer c.Close()
+
+		frames, err := c.Recv()
+		assert.Nil(t, err)
+		subscribed <- frames[0]
+
+		_ = c.Send([]byte("kernel.status"), []byte("idle"))
+	})
+
+	c, err := dial(t, address, Sub)
+
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	defer c.Close()
+
+	assert.Nil(t, c.Subscribe(nil))
+	assert.Equal(t, []byte{subscribe}, <-subscribed)
+
+	frames, err := c.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("kernel.status"), []byte("idle")}, frames)
+}
+
+func TestDial_Errors(t *testing.T) {
+	address := serve(t, Pub, func(c *Conn, err error) {
+		assert.True(t, errors.Is(err, ErrIncompatible))
+	})
+
+	_, err := dial(t, address, Dealer)
+	assert.True(t, errors.Is(err, ErrIncompatible))
+
+	_, err = dial(t, "ipc:///tmp/kernel", Dealer)
+	assert.True(t, errors.Is(err, ErrInvalidAddress))
+
+	// A peer which does not speak ZMTP.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	defer l.Close()
+
+	go func() {
+		c, err := l.Accept()
+
+		if err == nil {
+			_, _ = c.Write(append([]byte("HTTP/1.1 400 Bad Request\r\n\r\n"), make([]byte, greetingSize)...))
+			_ = c.Close()
+		}
+	}()
+
+	_, err = dial(t, "tcp://"+l.Addr().String(), Dealer)
+	assert.True(t, errors.Is(err, ErrInvalidGreeting))
+}
+
+func TestParseCommand(t *testing.T) {
+	name, properties, err := parseCommand(command("READY", map[string]string{"Socket-Type": Router, "Identity": ""}))
+	assert.Nil(t, err)
+	assert.Equal(t, "READY", name)
+	assert.Equal(t, map[string]string{"Socket-Type": Router, "Identity": ""}, properties)
+
+	name, properties, err = parseCommand([]byte("\x05ERROR\x06denied"))
+	assert.Nil(t, err)
+	assert.Equal(t, "ERROR", name)
+	assert.Equal(t, "denied", properties[""])
+
+	_, _, err = parseCommand([]byte("\x05READY\x0bSocket-Type\x00\x00\x00\x09ROUTER"))
+	assert.True(t, errors.Is(err, ErrInvalidGreeting))
+}